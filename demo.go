@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/will-rowe/herald/src/herald"
+	"github.com/will-rowe/herald/src/minknow/device"
+	"github.com/will-rowe/herald/src/minknow/minknowtest"
+	"github.com/will-rowe/herald/src/minknow/protocol"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// demoService is the MinKNOW service the demo stands in for
+const demoService = "Minknow test"
+
+// getDemoLocation returns where the demo db is stored, which
+// is kept apart from the db for real runs
+func getDemoLocation() string {
+	return filepath.Join(dbLocation, "demo")
+}
+
+// startDemo will start a fake GridION on the address of the
+// MinKNOW service. A run sent to one of its positions is
+// sequenced over a few minutes, and X1 has a finished
+// protocol run that can be imported.
+func startDemo() (*minknowtest.Server, error) {
+	service, ok := services.GetService(demoService)
+	if !ok {
+		return nil, fmt.Errorf("no %v service to demo", demoService)
+	}
+	fake, err := minknowtest.NewServer(service.GetAddress())
+	if err != nil {
+		return nil, fmt.Errorf("could not start the demo MinKNOW: %v", err)
+	}
+	for _, name := range []string{"X1", "X2", "X3", "X4", "X5"} {
+		fake.Position(name).SetAutoStart(minknowtest.SequencingScript(20, 15*time.Second, 5000))
+	}
+	ended := time.Now().Add(-24 * time.Hour)
+	startTime, _ := ptypes.TimestampProto(ended.Add(-48 * time.Hour))
+	endTime, _ := ptypes.TimestampProto(ended)
+	fake.Position("X1").AddProtocolRun(&protocol.ProtocolRunInfo{
+		RunId:      "x1-protocol-0",
+		ProtocolId: "sequencing/sequencing_MIN106_DNA:FLO-MIN106:SQK-LSK109",
+		OutputPath: "/data/demo/demo sample/demo_X1_FAO00001",
+		State:      protocol.ProtocolState_PROTOCOL_COMPLETED,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		UserInfo: &protocol.ProtocolRunUserInfo{
+			SampleId:        &wrappers.StringValue{Value: "demo sample"},
+			ProtocolGroupId: &wrappers.StringValue{Value: "demo"},
+		},
+		FlowCell: &device.GetFlowCellInfoResponse{HasFlowCell: true, FlowCellId: "FAO00001", ProductCode: "FLO-MIN106"},
+	})
+
+	// follow the demo runs more closely than real ones
+	services.AcquisitionSnapshotInterval = 15 * time.Second
+	herald.AcquisitionRetry = 5 * time.Second
+	return fake, nil
+}
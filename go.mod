@@ -7,10 +7,14 @@ require (
 	github.com/golang/protobuf v1.4.3
 	github.com/google/go-github v17.0.0+incompatible
 	github.com/mitchellh/go-homedir v1.1.0
+	github.com/pkg/sftp v1.13.0
 	git.mills.io/prologic/bitcask v0.3.10
 	github.com/spf13/viper v1.7.1
 	github.com/will-rowe/archer v0.1.1
 	github.com/zserge/lorca v0.1.9
+	golang.org/x/crypto v0.0.0-20210220033148-5ea612d1eb83
+	golang.org/x/sys v0.0.0-20210225134936-a50acf3fe073
 	google.golang.org/grpc v1.36.0
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -25,10 +25,60 @@ const getConfigJSONdump = async function() {
     return configJSONdump
 }
 
-// getSampleJSONdump returns a stringified protobuf dump of a sample from the storage
-const getSampleJSONdump = async function(sampleLabel) {
-    var sampleJSONdump = `${await window.printSampleToJSONstring(sampleLabel)}`
-    return sampleJSONdump
+// getRecordDump returns a stringified protobuf dump of a run or sample from the storage in the requested format
+const getRecordDump = async function(recordType, label, format) {
+    var recordDump = ''
+    try {
+        recordDump = `${await window.printRecord(recordType, label, format)}`
+    } catch (e) {
+        printErrorMsg(e)
+    }
+    return recordDump
+}
+
+// renderRecordDump will dump a run or sample in the format selected in the provided select box and render it into the provided element
+const renderRecordDump = async function(recordType, label, formatID, elementID) {
+    var format = document.getElementById(formatID).value
+    var recordDump = await getRecordDump(recordType, label, format)
+    document.getElementById(elementID).innerText = recordDump
+}
+
+// renderHistory will get the history of a run or sample via Go and render it into the provided element
+const renderHistory = async function(recordType, label, kind, elementID) {
+    var historyHTML = ''
+    try {
+        var history = await getHistory(recordType, label, kind)
+        history.forEach(function(entry) {
+            var source = entry.kind
+            if (entry.author !== '') {
+                source = entry.author
+            }
+            if (entry.service !== '') {
+                source += ' (' + entry.service + ')'
+            }
+            historyHTML +=
+                '<div class="mt-1"><p class="m-0"><strong>' +
+                source +
+                '</strong> <span class="text-small text-muted">' +
+                entry.timestamp +
+                '</span></p><p class="m-0">' +
+                entry.text +
+                '</p>'
+            if (entry.attachments !== null) {
+                entry.attachments.forEach(function(attachment) {
+                    historyHTML +=
+                        '<p class="text-small text-muted m-0"><i class="fas fa-paperclip"></i> ' +
+                        attachment +
+                        '</p>'
+                })
+            }
+            historyHTML += '</div>'
+        })
+    } catch (e) {
+        printErrorMsg(e)
+        return
+    }
+    document.getElementById(elementID).innerHTML = historyHTML
 }
 
 ////////////////////////////////////////////////////////////////////
@@ -119,17 +169,63 @@ refreshPage.addEventListener('click', async() => {
 announceButton.addEventListener('click', async() => {
     console.log('announcing samples')
 
-    // call the Go announceSamples method
+    // call the Go announceSamples method, reporting progress while the requests are in flight
+    announceButton.disabled = true
+    var progressTimer = setInterval(renderAnnouncementProgress, 500)
     try {
         await announceSamples()
     } catch (e) {
         printErrorMsg(e)
         return
+    } finally {
+        clearInterval(progressTimer)
+        document.getElementById('stagingAnnouncementProgress').innerHTML = ''
+        pageRefresh()
     }
-    pageRefresh()
     printSuccessMsg('announcements sent')
 })
 
+// add an event listener to the importMinknowRuns button
+const importMinknowRunsButton = document.getElementById('importMinknowRuns')
+importMinknowRunsButton.addEventListener('click', async() => {
+    console.log('importing the MinKNOW protocol runs')
+    importMinknowRunsButton.disabled = true
+    var imported
+    try {
+        imported = await importMinknowRuns()
+    } catch (e) {
+        printErrorMsg(e)
+        return
+    } finally {
+        importMinknowRunsButton.disabled = false
+    }
+    printSuccessMsg('imported ' + imported.created.length + ' new runs and updated ' +
+        imported.updated.length + ' runs from MinKNOW')
+    fullPageRender()
+})
+
+// renderAnnouncementProgress will list the records being announced and their service requests
+async function renderAnnouncementProgress() {
+    var progress = await getAnnouncementProgress()
+    var html = ''
+    for (var i = 0; i < progress.length; i++) {
+        var p = progress[i]
+        html += '<p class="m-0">' + p.recordType + ' ' + p.label + ': ' +
+            p.sent.length + '/' + p.services + ' sent'
+        if (p.inFlight.length !== 0) {
+            html += ', sending to ' + p.inFlight.join(', ')
+        }
+        if (p.failed.length !== 0) {
+            html += ', failed: ' + p.failed.join(', ')
+        }
+        if (p.held.length !== 0) {
+            html += ', waiting for: ' + p.held.join(', ')
+        }
+        html += '</p>'
+    }
+    document.getElementById('stagingAnnouncementProgress').innerHTML = html
+}
+
 // add an event listener to wipeDatabase button
 wipeDatabase.addEventListener('click', async() => {
     console.log('wiping database')
@@ -159,6 +255,7 @@ const addRunModal = document.getElementById('addRunModal')
 const addRunModalOpen = document.getElementById('addRunModalOpen')
 addRunModalOpen.addEventListener('click', function() {
     addRunModal.style.display = 'block'
+    updatePositionDropDown()
 })
 
 // addSampleModal
@@ -402,6 +499,11 @@ addRunForm.addEventListener('submit', async() => {
             formLabel_outputFAST5location.value,
             formLabel_outputFASTQlocation.value,
             formLabel_primerScheme.value,
+            parseInt(formLabel_primerSchemeVersion.value, 10) || 0,
+            formLabel_barcodeKit.value,
+            formLabel_minknowPosition.value,
+            formLabel_flowCellType.value,
+            parseFloat(formLabel_runHours.value) || 0,
             document.getElementById('formLabel_runComment').value,
             tags,
             existingRun
@@ -412,6 +514,7 @@ addRunForm.addEventListener('submit', async() => {
     }
 
     // reset the form, refresh the page, close the modal and report success
+    runTable.row.add([runName.value]).draw(true)
     addRunForm.reset()
     addRunFormReset()
     pageRefresh()
@@ -446,8 +549,34 @@ const updateRunDropDown = async() => {
         newOpt.text = runName
         expDropDown.options.add(newOpt)
     }
+    await updateBarcodeDropDown()
+}
+
+// updateBarcodeDropDown will get the barcodes for the selected run via Go and populate the sample form
+const barcodeDropDown = document.getElementById('formLabel_sampleBarcode')
+const updateBarcodeDropDown = async() => {
+    removeOptions(barcodeDropDown)
+    if (expDropDown.value.length === 0) {
+        return
+    }
+    var barcodes = []
+    try {
+        barcodes = await getRunBarcodes(expDropDown.value)
+    } catch (e) {
+        printErrorMsg(e)
+        return
+    }
+    barcodes.forEach(function(value) {
+        var opt = document.createElement('option')
+        opt.text = value
+        opt.value = value
+        barcodeDropDown.options.add(opt)
+    })
 }
 
+// update the barcodes when a different run is selected
+expDropDown.addEventListener('change', updateBarcodeDropDown)
+
 // add an event listener to the addSampleForm submit button
 addSampleForm.addEventListener('submit', async() => {
     console.log('creating sample')
@@ -541,14 +670,53 @@ $('#sampleTable tbody').on('click', 'button', function() {
     var data = row.data()
     var sampleLabel = data[0]
     document.getElementById('sampleModal_samplename').innerHTML = sampleLabel
-    getSampleJSONdump(sampleLabel).then(sampleProtobufDump => {
-        // get the sample protobuf dump
-        document.getElementById('sampleModal_content').innerHTML =
-            '<pre>' + sampleProtobufDump + '</pre>'
+    var sampleFormat = document.getElementById('sampleModal_format')
+    sampleFormat.onchange = function() {
+        renderRecordDump('sample', sampleLabel, 'sampleModal_format', 'sampleModal_content')
+    }
+    renderRecordDump('sample', sampleLabel, 'sampleModal_format', 'sampleModal_content').then(() => {
+        // get the sample history
+        var historyKind = document.getElementById('sampleModal_historyKind')
+        historyKind.value = ''
+        renderHistory('sample', sampleLabel, '', 'sampleModal_history')
+        historyKind.onchange = function() {
+            renderHistory('sample', sampleLabel, historyKind.value, 'sampleModal_history')
+        }
 
         // display modal
         document.getElementById('sampleDetailsModal').style.display = 'block'
 
+        // set up add comment button
+        document.getElementById('sampleModal_addComment').onclick = async() => {
+            var commentBox = document.getElementById('sampleModal_comment')
+            var attachmentBox = document.getElementById('sampleModal_attachment')
+            var attachments = []
+            if (attachmentBox.value.length !== 0) {
+                attachments.push(attachmentBox.value)
+            }
+            try {
+                await addComment('sample', sampleLabel, commentBox.value, attachments)
+            } catch (e) {
+                printErrorMsg(e)
+                return
+            }
+            commentBox.value = ''
+            attachmentBox.value = ''
+            renderHistory('sample', sampleLabel, historyKind.value, 'sampleModal_history')
+            printSuccessMsg('comment added')
+        }
+
+        // set up the prioritise button (moves a queued sample to the front of the announcement queue)
+        document.getElementById('sampleModal_prioritise').onclick = async() => {
+            try {
+                await setAnnouncementPriority('sample', sampleLabel, 1)
+            } catch (e) {
+                printErrorMsg(e)
+                return
+            }
+            printSuccessMsg('sample will be announced first')
+        }
+
         // set up delete button
         document
             .getElementById('sampleModal_delete')
@@ -574,6 +742,55 @@ $('#sampleTable tbody').on('click', 'button', function() {
     })
 })
 
+// set up the run table
+var runTable = $('#runTable').DataTable({
+    columnDefs: [{
+        targets: 1,
+        data: null,
+        searchable: false,
+        orderable: false,
+        defaultContent: '<button class="button button-outline">Manage</button>'
+    }]
+})
+
+// set up the run manage button
+$('#runTable tbody').on('click', 'button', function() {
+    var runLabel = runTable.row($(this).parents('tr')).data()[0]
+    document.getElementById('runModal_runname').innerHTML = runLabel
+    document.getElementById('runModal_format').onchange = function() {
+        renderRecordDump('run', runLabel, 'runModal_format', 'runModal_content')
+    }
+    renderRecordDump('run', runLabel, 'runModal_format', 'runModal_content').then(() => {
+        document.getElementById('runDetailsModal').style.display = 'block'
+    })
+})
+
+// buildRunTable will get the run names via Go and then populate the run table
+const buildRunTable = async() => {
+    console.log('building run table from run names in storage')
+    runTable.clear().draw(true)
+    var runCount = `${await window.getRunCount()}`
+    for (var i = 0; i < runCount; i++) {
+        var runLabel = `${await window.getRunName(i)}`
+        runTable.row.add([runLabel]).draw(true)
+    }
+}
+
+// buildFormatDropDowns will populate the record dump format select boxes
+const buildFormatDropDowns = async() => {
+    var formats = await getDumpFormats()
+    var formatDropDowns = document.getElementsByClassName('dumpFormat')
+    for (let i = 0; i < formatDropDowns.length; i++) {
+        removeOptions(formatDropDowns[i])
+        formats.forEach(function(value) {
+            var opt = document.createElement('option')
+            opt.text = value
+            opt.value = value
+            formatDropDowns[i].options.add(opt)
+        })
+    }
+}
+
 // buildTable will get the database keys via Go and then populate the table
 const buildTable = async() => {
     console.log('building table from sample labels in storage')
@@ -598,10 +815,21 @@ const buildTable = async() => {
 // buildRunForm will get the primer schemes via Go and then populate the run form
 const buildRunForm = async() => {
     console.log('getting primer schemes')
+    var schemeDropDown = document.getElementById('formLabel_primerScheme')
+    removeOptions(schemeDropDown)
+    var noSchemeOpt = document.createElement('option')
+    noSchemeOpt.text = 'none'
+    noSchemeOpt.value = ''
+    schemeDropDown.options.add(noSchemeOpt)
     var s = `${await window.getPrimerSchemes()}`
-    var schemes = s.split(',')
+    var schemes = s.split(',').filter(scheme => scheme.length !== 0)
     schemes.forEach(addScheme)
 
+    // select the first scheme by default, runs without one can pick none
+    if (schemes.length !== 0) {
+        schemeDropDown.selectedIndex = 1
+    }
+
     function addScheme(value, index, array) {
         console.log(value, index, array)
         var opt = document.createElement('option')
@@ -609,8 +837,98 @@ const buildRunForm = async() => {
         opt.value = value
         document.getElementById('formLabel_primerScheme').options.add(opt)
     }
+    await updateSchemeVersionDropDown()
+
+    console.log('getting barcode kits')
+    var barcodeKitDropDown = document.getElementById('formLabel_barcodeKit')
+    removeOptions(barcodeKitDropDown)
+    var noKitOpt = document.createElement('option')
+    noKitOpt.text = 'none (unbarcoded)'
+    noKitOpt.value = ''
+    barcodeKitDropDown.options.add(noKitOpt)
+    var kits = await getBarcodeKits()
+    kits.forEach(function(value) {
+        var opt = document.createElement('option')
+        opt.text = value
+        opt.value = value
+        barcodeKitDropDown.options.add(opt)
+    })
+
+    console.log('getting flow cell types')
+    var flowCellDropDown = document.getElementById('formLabel_flowCellType')
+    removeOptions(flowCellDropDown)
+    var noFlowCellOpt = document.createElement('option')
+    noFlowCellOpt.text = 'unknown'
+    noFlowCellOpt.value = ''
+    flowCellDropDown.options.add(noFlowCellOpt)
+    var flowCellTypes = await getFlowCellTypes()
+    flowCellTypes.forEach(function(value) {
+        var opt = document.createElement('option')
+        opt.text = value
+        opt.value = value
+        flowCellDropDown.options.add(opt)
+    })
+
+    console.log('getting minknow positions')
+    await updatePositionDropDown()
 }
 
+// updatePositionDropDown will get the MinKNOW flow cell positions via Go and populate the run form
+const updatePositionDropDown = async() => {
+    var positionDropDown = document.getElementById('formLabel_minknowPosition')
+    var selected = positionDropDown.value
+    removeOptions(positionDropDown)
+    var noPositionOpt = document.createElement('option')
+    noPositionOpt.text = 'none'
+    noPositionOpt.value = ''
+    positionDropDown.options.add(noPositionOpt)
+    var positions = []
+    try {
+        positions = await getMinknowPositions()
+    } catch (e) {
+        console.log(e)
+        return
+    }
+    positions.forEach(function(position) {
+        var opt = document.createElement('option')
+        opt.text = position.name + ' (' + position.state + ')'
+        opt.value = position.name
+        positionDropDown.options.add(opt)
+    })
+    positionDropDown.value = selected
+    if (positionDropDown.selectedIndex === -1) {
+        positionDropDown.value = ''
+    }
+}
+
+// updateSchemeVersionDropDown will get the versions of the selected primer scheme via Go and populate the run form
+const updateSchemeVersionDropDown = async() => {
+    var versionDropDown = document.getElementById('formLabel_primerSchemeVersion')
+    removeOptions(versionDropDown)
+    if (formLabel_primerScheme.value.length === 0) {
+        return
+    }
+    var versions = []
+    try {
+        versions = await getPrimerSchemeVersions(formLabel_primerScheme.value)
+    } catch (e) {
+        printErrorMsg(e)
+        return
+    }
+
+    // add the versions, selecting the latest by default
+    versions.forEach(function(value) {
+        var opt = document.createElement('option')
+        opt.text = value
+        opt.value = value
+        versionDropDown.options.add(opt)
+    })
+    versionDropDown.selectedIndex = versions.length - 1
+}
+
+// update the scheme versions when a different primer scheme is selected
+formLabel_primerScheme.addEventListener('change', updateSchemeVersionDropDown)
+
 ////////////////////////////////////////////////////////////////////
 // PAGE RENDERING
 // setup the time stamps
@@ -686,6 +1004,115 @@ const updatePieChart = async() => {
     myPieChart.update()
 }
 
+// set up the empty sequencing charts
+var readsChart = new Chart(document.getElementById('readsChart'), {
+    type: 'line',
+    data: {
+        labels: [],
+        datasets: [{
+            label: 'reads',
+            data: [],
+            borderColor: '#333',
+            fill: false
+        }, {
+            label: 'pass',
+            data: [],
+            borderColor: '#35cebe',
+            fill: false
+        }, {
+            label: 'fail',
+            data: [],
+            borderColor: '#a0a0a0',
+            fill: false
+        }]
+    },
+    options: {
+        responsive: true
+    }
+})
+var basesChart = new Chart(document.getElementById('basesChart'), {
+    type: 'line',
+    data: {
+        labels: [],
+        datasets: [{
+            label: 'bases',
+            data: [],
+            borderColor: '#35cebe',
+            fill: false
+        }]
+    },
+    options: {
+        responsive: true
+    }
+})
+
+// updateSequencingRuns will list the runs being sequenced, keeping the current selection
+const updateSequencingRuns = async() => {
+    var runDropDown = document.getElementById('sequencingRun')
+    var selected = runDropDown.value
+    removeOptions(runDropDown)
+    var runs = await getSequencingRuns()
+    for (var i = 0; i < runs.length; i++) {
+        var opt = document.createElement('option')
+        opt.text = runs[i]
+        opt.value = runs[i]
+        runDropDown.options.add(opt)
+    }
+
+    // keep showing a run once it has finished
+    if (selected !== '' && !runs.includes(selected)) {
+        var finishedOpt = document.createElement('option')
+        finishedOpt.text = selected + ' (finished)'
+        finishedOpt.value = selected
+        runDropDown.options.add(finishedOpt)
+    }
+    if (selected !== '') {
+        runDropDown.value = selected
+    }
+    await updateSequencingCharts()
+}
+
+// updateSequencingCharts will draw the acquisition snapshots for the selected run
+const updateSequencingCharts = async() => {
+    var runLabel = document.getElementById('sequencingRun').value
+    var status = document.getElementById('sequencingStatus')
+    var progress = {
+        times: [],
+        readCount: [],
+        passReadCount: [],
+        failReadCount: [],
+        bases: []
+    }
+    if (runLabel === '') {
+        status.innerText = 'no runs are being sequenced'
+    } else {
+        try {
+            progress = await getAcquisitionProgress(runLabel)
+        } catch (e) {
+            printErrorMsg(e)
+            return
+        }
+        if (progress.acquisitionRunID === '') {
+            status.innerText = 'waiting for an acquisition on position ' + progress.position
+        } else {
+            status.innerText = 'acquisition ' + progress.acquisitionRunID + ' on position ' +
+                progress.position + ' is ' + progress.state
+        }
+    }
+    readsChart.data.labels = progress.times
+    readsChart.data.datasets[0].data = progress.readCount
+    readsChart.data.datasets[1].data = progress.passReadCount
+    readsChart.data.datasets[2].data = progress.failReadCount
+    readsChart.update()
+    basesChart.data.labels = progress.times
+    basesChart.data.datasets[0].data = progress.bases
+    basesChart.update()
+}
+document.getElementById('sequencingRun').addEventListener('change', updateSequencingCharts)
+
+// redraw the sequencing charts as snapshots are recorded
+setInterval(updateSequencingRuns, 60000)
+
 ////////////////////////////////////////////////////////////////////
 // PAGE SETUP
 
@@ -733,6 +1160,9 @@ const pageRefresh = async() => {
     // update the pie chart
     await updatePieChart()
 
+    // update the sequencing charts
+    await updateSequencingRuns()
+
     // print a new timestamp
     printTimeStamps()
 }
@@ -741,5 +1171,7 @@ const pageRefresh = async() => {
 const fullPageRender = async() => {
     await pageRefresh()
     await buildTable()
+    await buildRunTable()
     await buildRunForm()
+    await buildFormatDropDowns()
 }
\ No newline at end of file
@@ -3,7 +3,10 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"html"
 	"log"
 	"net"
 	"net/http"
@@ -22,12 +25,25 @@ import (
 // dbLocation is where the db is stored - it is set at compile time to be platform specific
 var dbLocation string
 
+// demo runs Herald against a fake MinKNOW, using a separate db
+var demo = flag.Bool("demo", false, "run against a fake MinKNOW, using a separate demo database")
+
+// jsString returns the provided string as a quoted JavaScript
+// string, so that it can be passed to the UI with ui.Eval.
+func jsString(s string) string {
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return `""`
+	}
+	return string(quoted)
+}
+
 // getSampleServiceTagsHTML collects the registered services for the
 // provided record type and returns the HTML block to display them
 // to the user.
 func getServiceTagsHTML(recordType string) string {
 	serviceTagsHTML := "<label>Service requests</label>"
-	for serviceName, service := range services.ServiceRegister {
+	for serviceName, service := range services.GetServices() {
 		if recordType == service.GetRecordType().String() {
 			serviceTagsHTML += fmt.Sprintf("<input type=\"checkbox\" id=\"formLabel_%v\" value=\"%v\"><label class=\"label-inline\" for=\"formLabel_%v\"> - %v</label><div class=\"clearfix\"></div>", serviceName, serviceName, serviceName, serviceName)
 		}
@@ -35,24 +51,56 @@ func getServiceTagsHTML(recordType string) string {
 	return serviceTagsHTML
 }
 
-// getServiceStatusHTML checks the status of all registered
-// services and returns the HTML block to display the
-// service names and statuses.
-func getServiceStatusHTML() string {
-	currentTime := time.Now()
+// getServiceStatusHTML uses the health monitor history of the
+// registered services and returns the HTML block to display the
+// service names, statuses, uptime and latency.
+func getServiceStatusHTML(health []*services.ServiceHealth) string {
 	serviceStatusHTML := ""
-	for serviceName, service := range services.ServiceRegister {
-		if service.CheckAccess() == true {
-			serviceStatusHTML += fmt.Sprintf("<div class=\"mt-1\"><div class=\"float-left\"><i class=\"far fa-check-circle\" style=\"color: #35cebe;\"></i></div><div class=\"float-left ml-1\"><p class=\"m-0\"><strong>%s</strong> <span class=\"text-muted\">service</span></p><p class=\"text-small text-muted\">checked at %d:%d</p></div><div class=\"clearfix\"></div></div>", serviceName, currentTime.Hour(), currentTime.Minute())
-		} else {
-			serviceStatusHTML += fmt.Sprintf("<div class=\"mt-1\"><div class=\"float-left\"><i class=\"far fa-times-circle\" style=\"color: red;\"></i></div><div class=\"float-left ml-1\"><p class=\"m-0\"><strong>%s</strong> <span class=\"text-muted\">service</span></p><p class=\"text-small text-muted\">checked at %d:%d</p></div><div class=\"clearfix\"></div></div>", serviceName, currentTime.Hour(), currentTime.Minute())
+	for _, serviceHealth := range health {
+		icon := "<i class=\"far fa-question-circle text-muted\"></i>"
+		switch serviceHealth.State {
+		case services.HealthUp:
+			icon = "<i class=\"far fa-check-circle\" style=\"color: #35cebe;\"></i>"
+		case services.HealthDown:
+			icon = "<i class=\"far fa-times-circle\" style=\"color: red;\"></i>"
+		}
+		details := "waiting for first check"
+		if serviceHealth.LastCheck != nil {
+			details = fmt.Sprintf("checked at %s, %.0f%% uptime", serviceHealth.LastCheck.Time.Format("15:04"), serviceHealth.Uptime*100)
+			if serviceHealth.AverageLatency != 0 {
+				details = fmt.Sprintf("%s, %v latency", details, serviceHealth.AverageLatency.Round(time.Millisecond))
+			}
 		}
+		serviceStatusHTML += fmt.Sprintf("<div class=\"mt-1\"><div class=\"float-left\">%s</div><div class=\"float-left ml-1\"><p class=\"m-0\"><strong>%s</strong> <span class=\"text-muted\">service</span></p><p class=\"text-small text-muted\">%s</p></div><div class=\"clearfix\"></div></div>", icon, html.EscapeString(serviceHealth.Service), html.EscapeString(details))
 	}
 	return serviceStatusHTML
 }
 
+// getMinknowPositionsHTML returns the HTML block to display
+// the flow cell positions offered by MinKNOW, along with the
+// address Herald uses for each one.
+func getMinknowPositionsHTML(positions []*services.MinknowPosition, err error) string {
+	if err != nil {
+		return "<div class=\"mt-1\"><div class=\"float-left\"><i class=\"far fa-times-circle\" style=\"color: red;\"></i></div><div class=\"float-left ml-1\"><p class=\"m-0\"><strong>MinKNOW</strong> <span class=\"text-muted\">positions</span></p><p class=\"text-small text-muted\">MinKNOW could not be reached</p></div><div class=\"clearfix\"></div></div>"
+	}
+	positionsHTML := ""
+	for _, position := range positions {
+		icon := "<i class=\"far fa-times-circle\" style=\"color: red;\"></i>"
+		if position.State == "running" {
+			icon = "<i class=\"far fa-check-circle\" style=\"color: #35cebe;\"></i>"
+		}
+		details := position.State
+		if len(position.Address) != 0 {
+			details = fmt.Sprintf("%s on %s", details, position.Address)
+		}
+		positionsHTML += fmt.Sprintf("<div class=\"mt-1\"><div class=\"float-left\">%s</div><div class=\"float-left ml-1\"><p class=\"m-0\"><strong>%s</strong> <span class=\"text-muted\">position</span></p><p class=\"text-small text-muted\">%s</p></div><div class=\"clearfix\"></div></div>", icon, html.EscapeString(position.Name), html.EscapeString(details))
+	}
+	return positionsHTML
+}
+
 // main is the app entrypoint
 func main() {
+	flag.Parse()
 
 	// setup lorca
 	args := []string{}
@@ -65,9 +113,20 @@ func main() {
 	}
 	defer ui.Close()
 
+	// start the fake MinKNOW for the demo
+	storeLocation := dbLocation
+	if *demo {
+		fake, err := startDemo()
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer fake.Stop()
+		storeLocation = getDemoLocation()
+	}
+
 	// create the HERALD
 	var heraldObj *herald.Herald
-	if heraldObj, err = herald.InitHerald(dbLocation); err != nil {
+	if heraldObj, err = herald.InitHerald(storeLocation); err != nil {
 		ui.Eval(fmt.Sprintf(`console.log('failed to init herald: %v')`, err))
 	}
 	defer heraldObj.Destroy()
@@ -78,29 +137,49 @@ func main() {
 	ui.Bind("createSample", heraldObj.CreateSample)
 	ui.Bind("deleteSample", heraldObj.DeleteSample)
 	ui.Bind("announceSamples", heraldObj.AnnounceSamples)
+	ui.Bind("setAnnouncementPriority", heraldObj.SetAnnouncementPriority)
 	ui.Bind("wipeStorage", heraldObj.WipeStorage)
 	ui.Bind("getUser", heraldObj.GetUser)
 	ui.Bind("editConfig", heraldObj.EditConfig)
+	ui.Bind("addComment", heraldObj.AddComment)
 	// counters
 	ui.Bind("getRunCount", heraldObj.GetRunCount)
 	ui.Bind("getSampleCount", heraldObj.GetSampleCount)
 	ui.Bind("getUntaggedCount", heraldObj.GetUntaggedCount)
 	ui.Bind("getTaggedIncompleteCount", heraldObj.GetTaggedIncompleteCount)
 	ui.Bind("getTaggedCompleteCount", heraldObj.GetTaggedCompleteCount)
+	ui.Bind("getFailedCount", heraldObj.GetFailedCount)
 	ui.Bind("getAnnouncementQueueSize", heraldObj.GetAnnouncementQueueSize)
 	ui.Bind("getAnnouncementCount", heraldObj.GetAnnouncementCount)
+	ui.Bind("getAnnouncementProgress", heraldObj.GetAnnouncementProgress)
 	// table / modals / forms
 	ui.Bind("getRunName", heraldObj.GetLabel)
 	ui.Bind("getSampleLabel", heraldObj.GetSampleLabel)
 	ui.Bind("getSampleCreation", heraldObj.GetSampleCreation)
 	ui.Bind("getSampleRun", heraldObj.GetSampleRun)
 	ui.Bind("printSampleToJSONstring", heraldObj.PrintSampleToJSONstring)
+	ui.Bind("printRecord", heraldObj.PrintRecord)
+	ui.Bind("getHistory", heraldObj.GetHistory)
 	ui.Bind("printConfigToJSONstring", heraldObj.PrintConfigToJSONstring)
 
 	// Bind helper functions to the UI
 	ui.Bind("checkDirExists", helpers.CheckDirExists)
-	ui.Bind("getServiceStatusHTML", getServiceStatusHTML)
+	ui.Bind("getServiceStatusHTML", func() string {
+		return getServiceStatusHTML(heraldObj.GetServiceHealth())
+	})
+	ui.Bind("getServiceHealth", heraldObj.GetServiceHealth)
+	ui.Bind("getServiceHealthEvents", heraldObj.GetServiceHealthEvents)
+	ui.Bind("cancelServiceJob", heraldObj.CancelServiceJob)
 	ui.Bind("getPrimerSchemes", heraldObj.GetPrimerSchemes)
+	ui.Bind("getDumpFormats", heraldObj.GetDumpFormats)
+	ui.Bind("getPrimerSchemeVersions", heraldObj.GetPrimerSchemeVersions)
+	ui.Bind("getBarcodeKits", heraldObj.GetBarcodeKits)
+	ui.Bind("getRunBarcodes", heraldObj.GetRunBarcodes)
+	ui.Bind("getMinknowPositions", heraldObj.GetMinknowPositions)
+	ui.Bind("getFlowCellTypes", services.GetFlowCellTypes)
+	ui.Bind("getSequencingRuns", heraldObj.GetSequencingRuns)
+	ui.Bind("getAcquisitionProgress", heraldObj.GetAcquisitionProgress)
+	ui.Bind("importMinknowRuns", heraldObj.ImportMinknowRuns)
 
 	// Setup a JS function to init the HERALD and populate all storage data fields in the app
 	ui.Bind("loadRuntimeInfo", func() error {
@@ -144,7 +223,8 @@ func main() {
 		} else {
 			ui.Eval(`document.getElementById('status_network').innerHTML = '<i class="far fa-times-circle" style="color: red;"></i>'`)
 		}
-		ui.Eval(fmt.Sprintf(`document.getElementById('serviceStatus').innerHTML = '%s'`, getServiceStatusHTML()))
+		ui.Eval(fmt.Sprintf(`document.getElementById('serviceStatus').innerHTML = %s`, jsString(getServiceStatusHTML(heraldObj.GetServiceHealth()))))
+		ui.Eval(fmt.Sprintf(`document.getElementById('minknowPositions').innerHTML = %s`, jsString(getMinknowPositionsHTML(heraldObj.GetCachedMinknowPositions()))))
 
 		return nil
 	})
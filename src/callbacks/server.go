@@ -0,0 +1,180 @@
+// Package callbacks offers a gRPC and HTTP server that services use to report the progress and outcome of their jobs back to Herald
+package callbacks
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ReportPath is the HTTP path that services POST reports to.
+const ReportPath = "/v1/report"
+
+var (
+	// ErrNoToken is returned if a server is created without a shared token.
+	ErrNoToken = errors.New("a shared token is required for the callback server")
+
+	// ErrUnauthenticated is returned if a report does not carry the shared token.
+	ErrUnauthenticated = errors.New("invalid or missing callback token")
+)
+
+// Handler is called with each authenticated report,
+// it returns the status of the record once updated.
+type Handler func(request *ReportRequest) (string, error)
+
+// Server receives reports from services over gRPC
+// and HTTP and passes them to a Handler.
+type Server struct {
+	UnimplementedCallbacksServer
+	token      string       // the shared token services must present
+	handler    Handler      // processes the reports
+	grpcServer *grpc.Server // the gRPC server (nil if not started)
+	httpServer *http.Server // the HTTP server (nil if not started)
+	grpcAddr   net.Addr     // the address the gRPC server is listening on
+	httpAddr   net.Addr     // the address the HTTP server is listening on
+	wg         sync.WaitGroup
+}
+
+// NewServer returns a callback server that
+// authenticates reports with the shared token.
+func NewServer(token string, handler Handler) (*Server, error) {
+	if len(token) == 0 {
+		return nil, ErrNoToken
+	}
+	if handler == nil {
+		return nil, fmt.Errorf("no handler provided for the callback server")
+	}
+	return &Server{
+		token:   token,
+		handler: handler,
+	}, nil
+}
+
+// Start will start listening for reports. An empty
+// address will not start the corresponding server.
+func (s *Server) Start(grpcAddress, httpAddress string) error {
+	if len(grpcAddress) != 0 {
+		lis, err := net.Listen("tcp", grpcAddress)
+		if err != nil {
+			return fmt.Errorf("could not start gRPC callback server: %v", err)
+		}
+		s.grpcAddr = lis.Addr()
+		s.grpcServer = grpc.NewServer()
+		RegisterCallbacksServer(s.grpcServer, s)
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.grpcServer.Serve(lis)
+		}()
+	}
+	if len(httpAddress) != 0 {
+		lis, err := net.Listen("tcp", httpAddress)
+		if err != nil {
+			s.Stop()
+			return fmt.Errorf("could not start HTTP callback server: %v", err)
+		}
+		s.httpAddr = lis.Addr()
+		mux := http.NewServeMux()
+		mux.Handle(ReportPath, s)
+		s.httpServer = &http.Server{Handler: mux}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.httpServer.Serve(lis)
+		}()
+	}
+	return nil
+}
+
+// GetGRPCAddress returns the address the gRPC server
+// is listening on, or an empty string if not started.
+func (s *Server) GetGRPCAddress() string {
+	if s.grpcAddr == nil {
+		return ""
+	}
+	return s.grpcAddr.String()
+}
+
+// GetHTTPAddress returns the address the HTTP server
+// is listening on, or an empty string if not started.
+func (s *Server) GetHTTPAddress() string {
+	if s.httpAddr == nil {
+		return ""
+	}
+	return s.httpAddr.String()
+}
+
+// Stop will stop the servers and wait for them to finish.
+func (s *Server) Stop() {
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+	}
+	if s.httpServer != nil {
+		s.httpServer.Close()
+	}
+	s.wg.Wait()
+}
+
+// Report is the gRPC method for services to report back,
+// the token is sent as "authorization: Bearer <token>"
+// in the request metadata.
+func (s *Server) Report(ctx context.Context, request *ReportRequest) (*ReportResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if !s.checkToken(md.Get("authorization")) {
+		return nil, status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+	}
+	recordStatus, err := s.handler(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &ReportResponse{Status: recordStatus}, nil
+}
+
+// ServeHTTP lets services report back by POSTing a JSON
+// ReportRequest, with the token sent as an
+// "Authorization: Bearer <token>" header.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "reports must be POSTed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.checkToken(r.Header.Values("Authorization")) {
+		http.Error(w, ErrUnauthenticated.Error(), http.StatusUnauthorized)
+		return
+	}
+	request := &ReportRequest{}
+	if err := jsonpb.Unmarshal(r.Body, request); err != nil {
+		http.Error(w, fmt.Sprintf("could not decode report: %v", err), http.StatusBadRequest)
+		return
+	}
+	recordStatus, err := s.handler(request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	(&jsonpb.Marshaler{}).Marshal(w, &ReportResponse{Status: recordStatus})
+}
+
+// checkToken returns true if one of the authorization
+// values holds the shared token.
+func (s *Server) checkToken(values []string) bool {
+	for _, value := range values {
+		token := strings.TrimSpace(strings.TrimPrefix(value, "Bearer "))
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testToken = "test token"
+
+// testHandler records the reports it receives
+type testHandler struct {
+	reports []*ReportRequest
+}
+
+func (h *testHandler) handle(request *ReportRequest) (string, error) {
+	if len(request.GetLabel()) == 0 {
+		return "", fmt.Errorf("no label provided")
+	}
+	h.reports = append(h.reports, request)
+	return "tagsComplete", nil
+}
+
+// TestServer checks reports are received over gRPC and HTTP and that the token is checked
+func TestServer(t *testing.T) {
+	if _, err := NewServer("", (&testHandler{}).handle); err != ErrNoToken {
+		t.Fatal("server was created without a token")
+	}
+	handler := &testHandler{}
+	server, err := NewServer(testToken, handler.handle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Start("127.0.0.1:0", "127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	report := &ReportRequest{Label: "sample 1", ServiceName: "test service", State: JobState_complete, Result: "/data/out"}
+
+	// check gRPC reports
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, server.GetGRPCAddress(), grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := NewCallbacksClient(conn)
+	if _, err := client.Report(ctx, report); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("report without a token was not rejected: %v", err)
+	}
+	authCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+testToken)
+	resp, err := client.Report(authCtx, report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetStatus() != "tagsComplete" {
+		t.Fatalf("unexpected status returned: %v", resp.GetStatus())
+	}
+	if _, err := client.Report(authCtx, &ReportRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("handler error was not returned: %v", err)
+	}
+
+	// check HTTP reports
+	url := fmt.Sprintf("http://%v%v", server.GetHTTPAddress(), ReportPath)
+	body := `{"label": "sample 2", "serviceName": "test service", "state": "failed", "message": "out of disk"}`
+	for token, expected := range map[string]int{"wrong token": http.StatusUnauthorized, testToken: http.StatusOK} {
+		req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != expected {
+			t.Fatalf("expected HTTP status %d, got %d", expected, resp.StatusCode)
+		}
+	}
+	resp2, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp2.Body.Close()
+	if resp2.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("GET was not rejected: %d", resp2.StatusCode)
+	}
+
+	// check the handler received the authenticated reports
+	if len(handler.reports) != 2 {
+		t.Fatalf("expected 2 reports, got %d", len(handler.reports))
+	}
+	if handler.reports[1].GetState() != JobState_failed || handler.reports[1].GetMessage() != "out of disk" {
+		t.Fatalf("HTTP report was not decoded: %v", handler.reports[1])
+	}
+}
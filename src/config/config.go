@@ -2,6 +2,8 @@ package config
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -33,20 +35,32 @@ var (
 	// DefaultServerlog file path.
 	DefaultServerlog = fmt.Sprintf("%s/herald-server.log", DefaultConfigDir)
 
+	// DefaultPluginDirectory is where Herald looks for plugin executables.
+	DefaultPluginDirectory = fmt.Sprintf("%s/herald-plugins", DefaultConfigDir)
+
 	// DefaultManifestURL for the ARTIC primer schemes.
 	DefaultManifestURL = "https://raw.githubusercontent.com/artic-network/primer-schemes/master/schemes_manifest.json"
 
+	// DefaultCallbackAddress is where the gRPC callback server listens for services reporting back.
+	DefaultCallbackAddress = "127.0.0.1:60744"
+
+	// DefaultCallbackHTTPAddress is where the HTTP callback server listens for services reporting back.
+	DefaultCallbackHTTPAddress = "127.0.0.1:60745"
+
 	// ErrInvalidPath is used when the config file path is bad or doesn't exist.
 	ErrInvalidPath = fmt.Errorf("invalid config filepath")
 
 	// DefaultConfig is the basic info, filled on first run of Herald.
 	DefaultConfig = &Config{
-		Filepath:         DefaultConfigPath,
-		Fileformat:       DefaultConfigType,
-		User:             &User{},
-		Version:          version.VERSION,
-		Serverlog:        DefaultServerlog,
-		ArticManifestURL: DefaultManifestURL,
+		Filepath:            DefaultConfigPath,
+		Fileformat:          DefaultConfigType,
+		User:                &User{},
+		Version:             version.VERSION,
+		Serverlog:           DefaultServerlog,
+		ArticManifestURL:    DefaultManifestURL,
+		CallbackAddress:     DefaultCallbackAddress,
+		CallbackHTTPAddress: DefaultCallbackHTTPAddress,
+		PluginDirectory:     DefaultPluginDirectory,
 	}
 )
 
@@ -80,6 +94,34 @@ func (config *Config) GetJSONDump() string {
 	return string(buf.Bytes())
 }
 
+// GetServiceLimit returns the request limits set for a
+// service, or nil if there are none.
+//
+// NOTE: viper lowercases map keys when it reads the
+// config, so service names are matched ignoring case.
+func (config *Config) GetServiceLimit(serviceName string) *ServiceLimits {
+	for name, limits := range config.GetServiceLimits() {
+		if strings.EqualFold(name, serviceName) {
+			return limits
+		}
+	}
+	return nil
+}
+
+// GetServiceConnection returns the connection settings
+// for a service, or nil if there are none.
+//
+// NOTE: service names are matched ignoring case, as
+// with GetServiceLimit.
+func (config *Config) GetServiceConnection(serviceName string) *ServiceConnection {
+	for name, connection := range config.GetServiceConnections() {
+		if strings.EqualFold(name, serviceName) {
+			return connection
+		}
+	}
+	return nil
+}
+
 // InitConfig reads in the config file
 // or generates a new one if not found.
 //
@@ -138,6 +180,16 @@ func generateDefault(path string) error {
 	if len(path) != 0 {
 		DefaultConfig.Filepath = fmt.Sprintf("%s/%s.%s", path, DefaultConfigName, DefaultConfigType)
 		DefaultConfig.Serverlog = fmt.Sprintf("%s/herald-server.log", path)
+		DefaultConfig.PluginDirectory = fmt.Sprintf("%s/herald-plugins", path)
+	}
+
+	// generate a token for services to report back with
+	if len(DefaultConfig.CallbackToken) == 0 {
+		token, err := generateToken()
+		if err != nil {
+			return err
+		}
+		DefaultConfig.CallbackToken = token
 	}
 
 	// write the default config to disk
@@ -173,6 +225,15 @@ func dumpConfig() (*Config, error) {
 	return c, nil
 }
 
+// generateToken returns a random hex token.
+func generateToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 // getHome is used to find the DefaultConfigDir.
 func getHome() string {
 	homeDir, err := homedir.Dir()
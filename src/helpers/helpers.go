@@ -51,6 +51,38 @@ func GlobFiles(searchDir string, patterns []string) ([]string, error) {
 	return matches, nil
 }
 
+// WalkFiles will take a search directory and a pattern,
+// returning all matching filenames in the directory and
+// any of its subdirectories in a slice. Patterns are
+// matched against the base name of each file.
+// E.g. files, err := WalkFiles(/tmp, []string{"*.fastq", "*.fastq.gz"})
+func WalkFiles(searchDir string, patterns []string) ([]string, error) {
+	matches := []string{}
+	err := filepath.Walk(searchDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		for _, pattern := range patterns {
+			match, err := filepath.Match(pattern, info.Name())
+			if err != nil {
+				return err
+			}
+			if match {
+				matches = append(matches, path)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
 // DeduplicateStringSlice returns a slice with duplicate entries removed
 func DeduplicateStringSlice(s []string) []string {
 	seen := make(map[string]struct{}, len(s))
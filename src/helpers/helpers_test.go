@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 )
 
@@ -14,6 +16,26 @@ func TestHelpers(t *testing.T) {
 	}
 }
 
+// TestWalkFiles
+func TestWalkFiles(t *testing.T) {
+	if err := os.MkdirAll("tmp/barcode01", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("tmp")
+	for _, file := range []string{"tmp/reads.fastq", "tmp/barcode01/reads.fastq.gz", "tmp/barcode01/reads.txt"} {
+		if err := ioutil.WriteFile(file, []byte{}, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := WalkFiles("tmp", []string{"*.fastq", "*.fastq.gz"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("WalkFiles found %d files, expected 2", len(files))
+	}
+}
+
 // Test release version check
 //func TestCheckRelease(t *testing.T) {
 //	if _, _, _, err := CheckLatestRelease(); err != nil {
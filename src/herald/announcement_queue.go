@@ -0,0 +1,156 @@
+package herald
+
+import (
+	"container/list"
+	"fmt"
+	"sort"
+
+	"github.com/will-rowe/herald/src/records"
+)
+
+// announcementQueue holds the announcements waiting to be
+// made. Announcements are indexed by record type and label,
+// so a record is only queued once and can be removed in
+// constant time. Each priority level has its own FIFO list
+// and higher priority announcements are returned first.
+type announcementQueue struct {
+	elements   map[string]*list.Element // the queued announcements, keyed by record type and label
+	levels     map[int32]*list.List     // a FIFO list for each priority level in use
+	priorities []int32                  // the priority levels in use, highest first
+}
+
+// newAnnouncementQueue returns an empty announcement queue.
+func newAnnouncementQueue() *announcementQueue {
+	return &announcementQueue{
+		elements: make(map[string]*list.Element),
+		levels:   make(map[int32]*list.List),
+	}
+}
+
+// queueKey returns the key for a record in the announcement queue.
+func queueKey(recordType records.RecordType, label string) string {
+	return fmt.Sprintf("%v/%v", recordType, label)
+}
+
+// Len returns the number of queued announcements.
+func (q *announcementQueue) Len() int {
+	return len(q.elements)
+}
+
+// Reset will empty the queue.
+func (q *announcementQueue) Reset() {
+	q.elements = make(map[string]*list.Element)
+	q.levels = make(map[int32]*list.List)
+	q.priorities = nil
+}
+
+// Push will add an announcement to the queue, behind any
+// announcements of the same priority that were enqueued
+// before it. If the record is already queued, the queued
+// announcement is kept and false is returned.
+func (q *announcementQueue) Push(item *records.Announcement) bool {
+	key := queueKey(item.GetRecordType(), item.GetLabel())
+	if _, ok := q.elements[key]; ok {
+		return false
+	}
+	level := q.getLevel(item.GetPriority())
+
+	// walk back from the end to keep the level in enqueue order (new items go straight to the back)
+	for mark := level.Back(); mark != nil; mark = mark.Prev() {
+		if !enqueuedBefore(item, mark.Value.(*records.Announcement)) {
+			q.elements[key] = level.InsertAfter(item, mark)
+			return true
+		}
+	}
+	q.elements[key] = level.PushFront(item)
+	return true
+}
+
+// Get returns the queued announcement for a record,
+// or nil if the record is not queued.
+func (q *announcementQueue) Get(recordType records.RecordType, label string) *records.Announcement {
+	element, ok := q.elements[queueKey(recordType, label)]
+	if !ok {
+		return nil
+	}
+	return element.Value.(*records.Announcement)
+}
+
+// Remove will remove the announcement for a record from the
+// queue, returning false if the record was not queued.
+func (q *announcementQueue) Remove(recordType records.RecordType, label string) bool {
+	key := queueKey(recordType, label)
+	element, ok := q.elements[key]
+	if !ok {
+		return false
+	}
+	priority := element.Value.(*records.Announcement).GetPriority()
+	level := q.levels[priority]
+	level.Remove(element)
+	delete(q.elements, key)
+
+	// drop the priority level once it is empty
+	if level.Len() == 0 {
+		delete(q.levels, priority)
+		for i, p := range q.priorities {
+			if p == priority {
+				q.priorities = append(q.priorities[:i], q.priorities[i+1:]...)
+				break
+			}
+		}
+	}
+	return true
+}
+
+// SetPriority will change the priority of a queued announcement,
+// moving it behind the announcements already at the new priority.
+func (q *announcementQueue) SetPriority(recordType records.RecordType, label string, priority int32) error {
+	item := q.Get(recordType, label)
+	if item == nil {
+		return fmt.Errorf("%v is not in the announcement queue: %v", recordType, label)
+	}
+	if item.GetPriority() == priority {
+		return nil
+	}
+	q.Remove(recordType, label)
+	item.Priority = priority
+	q.Push(item)
+	return nil
+}
+
+// Items returns a snapshot of the queued announcements, in
+// priority order and then enqueue order.
+func (q *announcementQueue) Items() []*records.Announcement {
+	items := make([]*records.Announcement, 0, len(q.elements))
+	for _, priority := range q.priorities {
+		for element := q.levels[priority].Front(); element != nil; element = element.Next() {
+			items = append(items, element.Value.(*records.Announcement))
+		}
+	}
+	return items
+}
+
+// getLevel returns the list for a priority level, creating it if needed.
+func (q *announcementQueue) getLevel(priority int32) *list.List {
+	if level, ok := q.levels[priority]; ok {
+		return level
+	}
+	level := list.New()
+	q.levels[priority] = level
+	q.priorities = append(q.priorities, priority)
+	sort.Slice(q.priorities, func(i, j int) bool { return q.priorities[i] > q.priorities[j] })
+	return level
+}
+
+// enqueuedBefore returns true if announcement a was enqueued
+// before announcement b, using the label to break ties.
+func enqueuedBefore(a, b *records.Announcement) bool {
+	ta, tb := a.GetEnqueued(), b.GetEnqueued()
+	if ta.GetSeconds() != tb.GetSeconds() {
+		return ta.GetSeconds() < tb.GetSeconds()
+	}
+	if ta.GetNanos() != tb.GetNanos() {
+		return ta.GetNanos() < tb.GetNanos()
+	}
+	return a.GetLabel() < b.GetLabel()
+}
@@ -0,0 +1,93 @@
+package herald
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/will-rowe/herald/src/records"
+)
+
+// testAnnouncement returns an announcement enqueued at the provided time
+func testAnnouncement(recordType records.RecordType, label string, enqueued int64) *records.Announcement {
+	return &records.Announcement{
+		RecordType: recordType,
+		Label:      label,
+		Enqueued:   &timestamp.Timestamp{Seconds: enqueued},
+	}
+}
+
+// checkOrder checks the queue returns the labels in the expected order
+func checkOrder(t *testing.T, q *announcementQueue, expected ...string) {
+	items := q.Items()
+	if len(items) != len(expected) || q.Len() != len(expected) {
+		t.Fatalf("expected %d queued items, got %d (Len %d)", len(expected), len(items), q.Len())
+	}
+	for i, item := range items {
+		if item.GetLabel() != expected[i] {
+			t.Fatalf("item %d is %v, expected %v", i, item.GetLabel(), expected[i])
+		}
+	}
+}
+
+// TestAnnouncementQueue checks the ordering, deduplication and removal
+func TestAnnouncementQueue(t *testing.T) {
+	q := newAnnouncementQueue()
+
+	// check items come out in enqueue order, regardless of push order
+	for i, label := range []string{"b", "c", "a"} {
+		if !q.Push(testAnnouncement(records.RecordType_sample, label, int64(i+1)%3)) {
+			t.Fatalf("could not push %v", label)
+		}
+	}
+	checkOrder(t, q, "a", "b", "c")
+
+	// check a record is only queued once, but the same label can be queued for a different record type
+	if q.Push(testAnnouncement(records.RecordType_sample, "a", 10)) {
+		t.Fatal("duplicate record was queued")
+	}
+	if !q.Push(testAnnouncement(records.RecordType_run, "a", 10)) {
+		t.Fatal("run with the same label as a sample was not queued")
+	}
+	checkOrder(t, q, "a", "b", "c", "a")
+
+	// check priority ordering
+	if err := q.SetPriority(records.RecordType_sample, "c", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.SetPriority(records.RecordType_run, "a", 1); err != nil {
+		t.Fatal(err)
+	}
+	checkOrder(t, q, "c", "a", "a", "b")
+	if q.Items()[1].GetRecordType() != records.RecordType_run {
+		t.Fatal("priority was not applied to the run")
+	}
+	if err := q.SetPriority(records.RecordType_sample, "missing", 1); err == nil {
+		t.Fatal("priority was set for a record that is not queued")
+	}
+
+	// check removal
+	if !q.Remove(records.RecordType_sample, "c") {
+		t.Fatal("could not remove queued sample")
+	}
+	if q.Remove(records.RecordType_sample, "c") {
+		t.Fatal("removed a sample that is not queued")
+	}
+	if q.Get(records.RecordType_sample, "c") != nil {
+		t.Fatal("removed sample is still queued")
+	}
+	checkOrder(t, q, "a", "a", "b")
+	if len(q.priorities) != 2 {
+		t.Fatalf("empty priority level was not dropped: %v", q.priorities)
+	}
+
+	// check a removed record can be queued again
+	if !q.Push(testAnnouncement(records.RecordType_sample, "c", 20)) {
+		t.Fatal("could not requeue a removed sample")
+	}
+	checkOrder(t, q, "a", "a", "b", "c")
+
+	// check the reset
+	q.Reset()
+	checkOrder(t, q)
+}
@@ -2,31 +2,40 @@
 package herald
 
 import (
-	"container/list"
+	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/will-rowe/archer/pkg/amplicons"
 	archer "github.com/will-rowe/archer/pkg/api/v1"
 
+	"github.com/will-rowe/herald/src/callbacks"
 	"github.com/will-rowe/herald/src/config"
+	"github.com/will-rowe/herald/src/helpers"
 	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services"
 	"github.com/will-rowe/herald/src/storage"
 )
 
 var (
 	// ErrServiceOffline is returned if the service check return false
 	ErrServiceOffline = errors.New("the requested service is offline")
+
+	// ErrStopping is returned if records are announced while Herald is being destroyed
+	ErrStopping = errors.New("herald is shutting down")
 )
 
 // Herald is the struct for holding runtime data
 type Herald struct {
-	sync.Mutex                         // to make the UI binding thread safe
-	config            *config.Config   // a copy of the config being used by the current Herald instance
-	store             *storage.Storage // the key-value store for the samples
-	announcementQueue *list.List       // a FIFO queue for announcements
-	articManifest     *archer.Manifest // ARTIC primer scheme manifest
+	sync.Mutex                           // to make the UI binding thread safe
+	config            *config.Config     // a copy of the config being used by the current Herald instance
+	store             *storage.Storage   // the key-value store for the samples
+	announcementQueue *announcementQueue // a keyed priority queue for announcements (backed by the queue database)
+	articManifest     *archer.Manifest   // ARTIC primer scheme manifest
 
 	// runtime count info for JS:
 	runCount              int    // the number of runs currently in the store
@@ -35,6 +44,32 @@ type Herald struct {
 	taggedIncompleteCount [2]int // the number of runs ([0]) and samples ([1]) in the store that are tagged with at least one incomplete service requests
 	taggedCompleteCount   [2]int // the number of runs ([0]) and samples ([1]) in the store that are tagged with completed service requests
 	announcementCount     int    // the number of announcements made
+	failedCount           [2]int // the number of runs ([0]) and samples ([1]) in the store that could not be announced
+
+	// the announcement scheduler and service worker pools
+	schedulerStop chan struct{}                    // closed to stop the scheduler
+	schedulerOnce sync.Once                        // makes sure the scheduler is only stopped once
+	schedulerWG   sync.WaitGroup                   // used to wait for the scheduler to finish
+	announceWG    sync.WaitGroup                   // used to wait for any announcements in progress to finish
+	stopping      bool                             // set when Herald is being destroyed, so no more announcements are started
+	announcing    map[string]*inflightAnnouncement // the announcements in progress, keyed by record type and label
+	pools         map[string]*servicePool          // the worker pools for each service
+	requestCtx    context.Context                  // the parent context for service requests
+	cancelRequest context.CancelFunc               // cancels requestCtx, so requests in flight stop when Herald stops
+
+	// the MinKNOW acquisitions being followed for runs
+	acquisitions  map[string]context.CancelFunc // stops following the acquisition, keyed by run label
+	acquisitionWG sync.WaitGroup                // used to wait for the acquisitions to stop being followed
+
+	// the MinKNOW positions, polled in the background for the UI
+	minknowPositions    []*services.MinknowPosition // the positions from the last poll
+	minknowPositionsErr error                       // the error from the last poll
+	positionsWG         sync.WaitGroup              // used to wait for the poller to stop
+
+	// the server that services report back to and the service health monitor
+	callbackServer *callbacks.Server
+	health         *services.HealthMonitor
+	configServices []string // the services registered from the config and plugins
 
 	// easy access label holders for JS
 	sampleDetails [][]string // used to store all the sample labels, creation dates and corresponding run in memory (for JS to access)
@@ -64,30 +99,185 @@ func InitHerald(storeLocation string) (*Herald, error) {
 	}
 
 	// get a new instance
+	requestCtx, cancelRequest := context.WithCancel(context.Background())
 	heraldObj := &Herald{
 		config:            config,
 		store:             store,
-		announcementQueue: list.New(),
+		announcementQueue: newAnnouncementQueue(),
 		articManifest:     manifest,
 		sampleDetails:     make([][]string, 3),
 		storeLocation:     storeLocation,
+		schedulerStop:     make(chan struct{}),
+		announcing:        make(map[string]*inflightAnnouncement),
+		pools:             make(map[string]*servicePool),
+		acquisitions:      make(map[string]context.CancelFunc),
+		requestCtx:        requestCtx,
+		cancelRequest:     cancelRequest,
+		health:            services.NewHealthMonitor(services.DefaultHealthInterval, services.DefaultHealthHistory),
 	}
 
-	// populate runtime info
+	// register any services from the config and plugins, then populate runtime info
+	if err := heraldObj.registerConfigServices(); err != nil {
+		heraldObj.Destroy()
+		return nil, err
+	}
+	heraldObj.registerPlugins()
+	if err := heraldObj.setServiceConnections(); err != nil {
+		heraldObj.Destroy()
+		return nil, err
+	}
 	if err := heraldObj.GetRuntimeInfo(); err != nil {
 		heraldObj.Destroy()
 		return nil, err
 	}
+
+	// start announcing queued records in the background and listen for services reporting back
+	services.SetReporter(heraldObj.ReportServiceResult)
+	if err := heraldObj.resumeWatches(); err != nil {
+		heraldObj.Destroy()
+		return nil, err
+	}
+	if err := heraldObj.startAcquisitionWatches(); err != nil {
+		heraldObj.Destroy()
+		return nil, err
+	}
+	heraldObj.startHealthMonitor()
+	heraldObj.startPositionPoller()
+	heraldObj.startScheduler()
+	heraldObj.startCallbackServer()
 	return heraldObj, nil
 }
 
-// Destroy will properly close down the Herald instance and sync the store to disk
+// Destroy will properly close down the Herald instance, stopping the
+// announcement scheduler, waiting for any announcements in progress
+// and syncing the store to disk
 func (herald *Herald) Destroy() error {
+	herald.Lock()
+	herald.stopping = true
+	herald.Unlock()
+	herald.stopCallbackServer()
+	herald.health.Stop()
+	herald.stopScheduler()
+	herald.cancelRequest()
+	herald.announceWG.Wait()
+	herald.acquisitionWG.Wait()
+	herald.positionsWG.Wait()
+	services.StopWatchers()
+	for _, serviceName := range herald.configServices {
+		services.DeregisterService(serviceName)
+	}
+	herald.configServices = nil
+	services.SetReporter(nil)
 	herald.Lock()
 	defer herald.Unlock()
+	herald.stopPools()
 	return herald.store.CloseStorage()
 }
 
+// registerConfigServices will register the local commands,
+// webhooks and transfers listed in the config as services.
+func (herald *Herald) registerConfigServices() error {
+	for _, commandService := range herald.config.GetCommandServices() {
+		recordType, err := getServiceRecordType(commandService.GetName(), commandService.GetRecordType())
+		if err != nil {
+			return err
+		}
+		service, err := services.NewCommandService(commandService.GetName(), recordType, commandService.GetDependsOn(), commandService.GetCommand(), commandService.GetOutputDirectory())
+		if err != nil {
+			return err
+		}
+		if err := herald.registerConfigService(service); err != nil {
+			return err
+		}
+	}
+	for _, webhookService := range herald.config.GetWebhookServices() {
+		recordType, err := getServiceRecordType(webhookService.GetName(), webhookService.GetRecordType())
+		if err != nil {
+			return err
+		}
+		timeout := time.Duration(webhookService.GetTimeout()) * time.Second
+		service, err := services.NewWebhookService(webhookService.GetName(), recordType, webhookService.GetDependsOn(), webhookService.GetUrl(), webhookService.GetHeaders(), webhookService.GetSecret(), timeout, int(webhookService.GetRetries()))
+		if err != nil {
+			return err
+		}
+		if err := herald.registerConfigService(service); err != nil {
+			return err
+		}
+	}
+	for _, transferService := range herald.config.GetTransferServices() {
+		service, err := services.NewTransferService(transferService.GetName(), transferService.GetDependsOn(), transferService.GetDestination(), transferService.GetKeyFile(), transferService.GetKnownHostsFile())
+		if err != nil {
+			return err
+		}
+		if err := herald.registerConfigService(service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerConfigService will register a service from the
+// config, so that it can be deregistered by Destroy.
+func (herald *Herald) registerConfigService(service services.Service) error {
+	if err := services.RegisterService(service); err != nil {
+		return err
+	}
+	herald.configServices = append(herald.configServices, service.GetServiceName())
+	return nil
+}
+
+// setServiceConnections will apply the connection settings
+// in the config to the services that connect to a gRPC
+// server. Services without settings go back to their
+// defaults, and settings for any other service are an error.
+func (herald *Herald) setServiceConnections() error {
+	registered := services.GetServices()
+	for name, service := range registered {
+		connector, ok := service.(services.Connector)
+		if !ok {
+			continue
+		}
+		var connection *services.Connection
+		if settings := herald.config.GetServiceConnection(name); settings != nil {
+			connection = &services.Connection{
+				Address:    settings.GetAddress(),
+				TLS:        settings.GetTls(),
+				CAFile:     settings.GetCaFile(),
+				CertFile:   settings.GetCertFile(),
+				KeyFile:    settings.GetKeyFile(),
+				ServerName: settings.GetServerName(),
+				Token:      settings.GetToken(),
+				Metadata:   settings.GetMetadata(),
+			}
+		}
+		if err := connector.SetConnection(connection); err != nil {
+			return fmt.Errorf("could not set the connection for %v: %v", name, err)
+		}
+	}
+	for name := range herald.config.GetServiceConnections() {
+		found := false
+		for serviceName, service := range registered {
+			if _, ok := service.(services.Connector); ok && strings.EqualFold(name, serviceName) {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("connection settings given for a service that doesn't connect to a gRPC server: %v", name)
+		}
+	}
+	return nil
+}
+
+// getServiceRecordType returns the record type for a
+// service from the config.
+func getServiceRecordType(serviceName, recordType string) (records.RecordType, error) {
+	value, ok := records.RecordType_value[recordType]
+	if !ok {
+		return 0, fmt.Errorf("unsupported record type for %v: %v", serviceName, recordType)
+	}
+	return records.RecordType(value), nil
+}
+
 // WipeStorage will clear all samples and runs from storage and reset the runtime info
 func (herald *Herald) WipeStorage() error {
 	herald.Lock()
@@ -128,6 +318,54 @@ func (herald *Herald) GetPrimerSchemes() []string {
 	return schemeNames
 }
 
+// GetBarcodeKits will return the names of the
+// barcoding kits that can be used for a run.
+func (herald *Herald) GetBarcodeKits() []string {
+	return records.GetBarcodeKitNames()
+}
+
+// GetRunBarcodes will return the barcodes that
+// can be used for samples in a run.
+func (herald *Herald) GetRunBarcodes(runLabel string) ([]int32, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	run, err := herald.store.GetRun(runLabel)
+	if err != nil {
+		return nil, err
+	}
+	return run.GetBarcodes()
+}
+
+// GetPrimerSchemeVersions will return the available
+// versions of a primer scheme listed in the manifest.
+func (herald *Herald) GetPrimerSchemeVersions(primerScheme string) ([]int32, error) {
+	scheme, ok := herald.articManifest.GetSchemes()[primerScheme]
+	if !ok {
+		return nil, fmt.Errorf("primer scheme not found in manifest: %v", primerScheme)
+	}
+	versions := make([]int32, 0, len(scheme.GetPrimerUrls()))
+	for version := range scheme.GetPrimerUrls() {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+// checkPrimerScheme will check that a primer scheme
+// and version are listed in the manifest.
+func (herald *Herald) checkPrimerScheme(primerScheme string, schemeVersion int32) error {
+	versions, err := herald.GetPrimerSchemeVersions(primerScheme)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		if version == schemeVersion {
+			return nil
+		}
+	}
+	return fmt.Errorf("version %d of primer scheme %v not found in manifest", schemeVersion, primerScheme)
+}
+
 // GetRuntimeInfo makes a pass of the run and sample stores before populating the Herald instance with data:
 // - how many samples are in the storage
 // - notes any samples with tags
@@ -143,13 +381,14 @@ func (herald *Herald) GetRuntimeInfo() error {
 	herald.taggedIncompleteCount = [2]int{0, 0}
 	herald.taggedCompleteCount = [2]int{0, 0}
 	herald.announcementCount = 0
+	herald.failedCount = [2]int{0, 0}
 
 	// get the run and sample counts from the store
 	baselineRunCount := herald.store.GetNumRuns()
 	baselineSampleCount := herald.store.GetNumSamples()
 
 	// restart the queue
-	herald.announcementQueue.Init()
+	herald.announcementQueue.Reset()
 
 	// create run label holder
 	herald.runLabels = make([]string, baselineRunCount)
@@ -211,21 +450,50 @@ func (herald *Herald) GetRuntimeInfo() error {
 	if (baselineSampleCount != sampleIterator) || (baselineSampleCount != herald.sampleCount) {
 		return fmt.Errorf("sample mistmatch between db and in-memory store: %d vs %d", baselineSampleCount, sampleIterator)
 	}
-	return nil
+
+	// clear out any stale announcements
+	return herald.pruneQueue()
 }
 
 // AddRun creates an run record, updates the runtime info and adds the record to storage
 // TODO: this might be bypassed later and instead get JS to encode the form to protobuf directly
-func (herald *Herald) AddRun(runLabel, outDir, fast5Dir, fastqDir, primerScheme, comment string, tags []string, existingRun bool) error {
+func (herald *Herald) AddRun(runLabel, outDir, fast5Dir, fastqDir, primerScheme string, schemeVersion int32, barcodeKit, minknowPosition, flowCellType string, runHours float32, comment string, tags []string, existingRun bool) error {
 	herald.Lock()
 	defer herald.Unlock()
 
+	// check the primer scheme against the manifest (an empty scheme is a run without one)
+	if len(primerScheme) != 0 {
+		if err := herald.checkPrimerScheme(primerScheme, schemeVersion); err != nil {
+			return err
+		}
+	}
+
+	// check the barcode kit is recognised (an empty kit is an unbarcoded run)
+	if len(barcodeKit) != 0 {
+		if _, err := records.GetBarcodeKit(barcodeKit); err != nil {
+			return err
+		}
+	}
+
+	// check the flow cell type and run length can be used to estimate the disk space needed
+	if len(flowCellType) != 0 {
+		if _, ok := services.FlowCellUsages[flowCellType]; !ok {
+			return fmt.Errorf("unknown flow cell type: %v", flowCellType)
+		}
+	}
+	if runHours < 0 {
+		return fmt.Errorf("run length can't be negative: %v", runHours)
+	}
+
 	// create the run
-	newRun := records.InitRun(runLabel, outDir, fast5Dir, fastqDir, primerScheme)
+	newRun := records.InitRun(runLabel, outDir, fast5Dir, fastqDir, primerScheme, schemeVersion, barcodeKit)
+	newRun.MinknowPosition = minknowPosition
+	newRun.FlowCellType = flowCellType
+	newRun.RunHours = runHours
 
 	// add any comment
 	if len(comment) != 0 {
-		if err := newRun.Metadata.AddComment(comment); err != nil {
+		if err := newRun.Metadata.AddUserComment(herald.getAuthor(), comment); err != nil {
 			return err
 		}
 	}
@@ -276,6 +544,7 @@ func (herald *Herald) AddRun(runLabel, outDir, fast5Dir, fastqDir, primerScheme,
 
 	// update the runtime info (grow the label slice, update counts, add to announcement queue etc.)
 	herald.runLabels = append(herald.runLabels, runLabel)
+	herald.watchAcquisition(newRun)
 	return herald.updateCounts(newRun, true)
 }
 
@@ -291,13 +560,28 @@ func (herald *Herald) CreateSample(label string, runName string, barcode int32,
 		return err
 	}
 
+	// check the barcode is in the run's kit and not used by another sample
+	if err := run.CheckBarcode(barcode); err != nil {
+		return err
+	}
+	if err := herald.checkBarcodeUsed(run.Metadata.GetLabel(), barcode); err != nil {
+		return err
+	}
+
 	// TODO: copy the tag history over from the run to the samples (sequence and basecall)?
 	//tags = append(run.Metadata.GetRequestOrder(), tags...)
 
 	// create the sample
 	sample := records.InitSample(label, run.Metadata.GetLabel(), barcode)
 	if len(comment) != 0 {
-		if err := sample.Metadata.AddComment(comment); err != nil {
+		if err := sample.Metadata.AddUserComment(herald.getAuthor(), comment); err != nil {
+			return err
+		}
+	}
+
+	// find the demultiplexed reads for the barcode (these may not exist until the run is basecalled)
+	if barcodeDir, err := run.GetBarcodeDirectory(barcode); err == nil {
+		if err := sample.Metadata.AddComment(fmt.Sprintf("barcode directory found: %v", barcodeDir)); err != nil {
 			return err
 		}
 	}
@@ -341,6 +625,89 @@ func (herald *Herald) DeleteSample(sampleLabel string) error {
 	return herald.updateCounts(sample, false)
 }
 
+// AddComment adds a user comment, with optional attachments,
+// to the history of a run or sample in storage.
+func (herald *Herald) AddComment(recordType, label, text string, attachments []string) error {
+	herald.Lock()
+	defer herald.Unlock()
+
+	// check the attachments exist
+	for _, attachment := range attachments {
+		if !helpers.CheckFileExists(attachment) {
+			return fmt.Errorf("attachment not found: %v", attachment)
+		}
+	}
+
+	// get the record, add the comment and update storage
+	record, metadata, err := herald.getRecord(recordType, label)
+	if err != nil {
+		return err
+	}
+	if err := metadata.AddUserComment(herald.getAuthor(), text, attachments...); err != nil {
+		return err
+	}
+	return herald.updateRecord(record)
+}
+
+// getRecord will get a run or sample from storage, returning
+// the record and its metadata.
+func (herald *Herald) getRecord(recordType, label string) (interface{}, *records.HeraldData, error) {
+	switch recordType {
+	case records.RecordType_run.String():
+		run, err := herald.store.GetRun(label)
+		if err != nil {
+			return nil, nil, err
+		}
+		return run, run.GetMetadata(), nil
+	case records.RecordType_sample.String():
+		sample, err := herald.store.GetSample(label)
+		if err != nil {
+			return nil, nil, err
+		}
+		return sample, sample.GetMetadata(), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported record type: %v", recordType)
+	}
+}
+
+// getAuthor returns the name of the user from the
+// config, for use as the author of user comments.
+func (herald *Herald) getAuthor() string {
+	if name := herald.config.GetUser().GetName(); len(name) != 0 {
+		return name
+	}
+	return "unknown user"
+}
+
+// checkBarcodeUsed returns an error if a sample in
+// storage has already been assigned the barcode for
+// the run.
+func (herald *Herald) checkBarcodeUsed(runLabel string, barcode int32) error {
+	var usedBy string
+	var err error
+	for label := range herald.store.GetSampleLabels() {
+
+		// drain the channel once an error or match is found
+		if err != nil || len(usedBy) != 0 {
+			continue
+		}
+		var sample *records.Sample
+		if sample, err = herald.store.GetSample(string(label)); err != nil {
+			continue
+		}
+		if sample.GetParentRun() == runLabel && sample.GetBarcode() == barcode {
+			usedBy = sample.Metadata.GetLabel()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if len(usedBy) != 0 {
+		return fmt.Errorf("barcode %d is already used in run %v by sample: %v", barcode, runLabel, usedBy)
+	}
+	return nil
+}
+
 // updateRecord will replace an old record with a new one, if it exists
 // in storage.
 func (herald *Herald) updateRecord(record interface{}) error {
@@ -405,11 +772,13 @@ func (herald *Herald) updateCounts(record interface{}, add bool) error {
 
 		// add to the queue for announcing
 		if add {
-			herald.announcementQueue.PushBack(record)
-		} else {
-			herald.announcementQueue.Remove(&list.Element{Value: record})
+			return herald.enqueue(record)
 		}
-		return nil
+		recordType, metadata, err := getRecordInfo(record)
+		if err != nil {
+			return err
+		}
+		return herald.dequeue(recordType, metadata.GetLabel())
 
 	case "tagsComplete":
 		herald.taggedCompleteCount[index] += value
@@ -420,6 +789,11 @@ func (herald *Herald) updateCounts(record interface{}, add bool) error {
 		herald.announcementCount += value
 		return nil
 
+	// this means they could not be announced after repeated attempts, or a service reported its job failed
+	case "announceFailed", "serviceFailed":
+		herald.failedCount[index] += value
+		return nil
+
 	default:
 		return fmt.Errorf("unrecognised status: %v", status)
 	}
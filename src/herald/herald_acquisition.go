@@ -0,0 +1,248 @@
+package herald
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// AcquisitionRetry is how long to wait before reconnecting
+// to MinKNOW if following the acquisition for a run fails.
+var AcquisitionRetry = 30 * time.Second
+
+// MaxAcquisitionSnapshots is the number of snapshots kept on
+// a run. Once a run has more, every other snapshot is dropped
+// (keeping the first and the latest), so the charts still
+// cover the whole acquisition.
+var MaxAcquisitionSnapshots = 500
+
+// AcquisitionProgress is the yield of a run over
+// time, used to draw the sequencing charts.
+type AcquisitionProgress struct {
+	Run              string   `json:"run"`
+	Position         string   `json:"position"`
+	AcquisitionRunID string   `json:"acquisitionRunID"` // the MinKNOW acquisition run ID (empty until the acquisition starts)
+	State            string   `json:"state"`            // the state of the acquisition in the latest snapshot (empty until the acquisition starts)
+	Finished         bool     `json:"finished"`
+	Times            []string `json:"times"` // when each snapshot was taken
+	ReadCount        []int64  `json:"readCount"`
+	PassReadCount    []int64  `json:"passReadCount"`
+	FailReadCount    []int64  `json:"failReadCount"`
+	Bases            []int64  `json:"bases"`
+}
+
+// startAcquisitionWatches will follow the acquisitions for
+// the runs in storage that are waiting to be sequenced or
+// are being sequenced.
+func (herald *Herald) startAcquisitionWatches() error {
+	herald.Lock()
+	defer herald.Unlock()
+
+	// drain the channel before getting the records
+	labels := []string{}
+	for label := range herald.store.GetRunLabels() {
+		labels = append(labels, string(label))
+	}
+	for _, label := range labels {
+		run, err := herald.store.GetRun(label)
+		if err != nil {
+			return err
+		}
+		herald.watchAcquisition(run)
+	}
+	return nil
+}
+
+// watchAcquisition will follow the MinKNOW acquisition for
+// a run in the background, recording snapshots of its
+// progress on the run until the acquisition finishes. Runs
+// without a MinKNOW position (or that have finished) are
+// ignored.
+//
+// NOTE: the caller must hold the Herald lock.
+func (herald *Herald) watchAcquisition(run *records.Run) {
+	label := run.GetMetadata().GetLabel()
+	if len(run.GetMinknowPosition()) == 0 || run.GetAcquisitionFinished() {
+		return
+	}
+	if _, ok := herald.acquisitions[label]; ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(herald.requestCtx)
+	herald.acquisitions[label] = cancel
+	herald.acquisitionWG.Add(1)
+	go func() {
+		defer herald.acquisitionWG.Done()
+		defer func() {
+			herald.Lock()
+			delete(herald.acquisitions, label)
+			herald.Unlock()
+			cancel()
+		}()
+		snapshots := make(chan *records.AcquisitionSnapshot)
+		herald.acquisitionWG.Add(1)
+		go func() {
+			defer herald.acquisitionWG.Done()
+			for {
+				err := herald.followPosition(ctx, run.GetMinknowPosition(), snapshots)
+				if ctx.Err() != nil {
+					return
+				}
+				log.Printf("acquisition for %v: %v", label, err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(AcquisitionRetry):
+				}
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case snapshot := <-snapshots:
+				finished, err := herald.recordAcquisition(label, snapshot)
+				if err != nil {
+					log.Printf("acquisition for %v: %v", label, err)
+				}
+				if finished {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// followPosition will find the MinKNOW service offering a
+// flow cell position and watch its acquisitions.
+func (herald *Herald) followPosition(ctx context.Context, position string, snapshots chan<- *records.AcquisitionSnapshot) error {
+	registered := services.GetServices()
+	names := []string{}
+	for name, service := range registered {
+		if _, ok := service.(services.AcquisitionWatcher); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := registered[name].(services.AcquisitionWatcher).WatchAcquisition(ctx, position, snapshots)
+		if err != services.ErrPositionNotFound {
+			return err
+		}
+	}
+	return fmt.Errorf("no MinKNOW service offers position %v", position)
+}
+
+// recordAcquisition will add a snapshot to a run, returning
+// true once the acquisition for the run has finished (or
+// the run has been removed). A run takes the first
+// acquisition seen that isn't already complete. If another
+// acquisition is then seen on the position, the one for the
+// run finished while Herald wasn't following it.
+func (herald *Herald) recordAcquisition(label string, snapshot *records.AcquisitionSnapshot) (bool, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	run, err := herald.store.GetRun(label)
+	if err != nil {
+		return true, err
+	}
+	if run.GetAcquisitionFinished() {
+		return true, nil
+	}
+	previous := run.GetAcquisitionSnapshots()
+	switch {
+	case len(previous) == 0 && snapshot.GetState() == "completed":
+		return false, nil
+	case len(previous) == 0:
+		if err := run.Metadata.AddComment(fmt.Sprintf("MinKNOW acquisition %v started on position %v.", snapshot.GetAcquisitionRunID(), run.GetMinknowPosition())); err != nil {
+			return false, err
+		}
+	case previous[len(previous)-1].GetAcquisitionRunID() != snapshot.GetAcquisitionRunID():
+
+		// the acquisition finished while Herald wasn't following it
+		run.AcquisitionFinished = true
+		if err := run.Metadata.AddComment(fmt.Sprintf("MinKNOW acquisition %v finished while Herald was not following it.", previous[len(previous)-1].GetAcquisitionRunID())); err != nil {
+			return true, err
+		}
+		return true, herald.updateRecord(run)
+	}
+	run.AcquisitionSnapshots = thinSnapshots(append(run.AcquisitionSnapshots, snapshot), MaxAcquisitionSnapshots)
+	if snapshot.GetState() == "completed" {
+		run.AcquisitionFinished = true
+		comment := fmt.Sprintf("MinKNOW acquisition %v finished", snapshot.GetAcquisitionRunID())
+		if len(snapshot.GetStopReason()) != 0 {
+			comment = fmt.Sprintf("%v (%v)", comment, snapshot.GetStopReason())
+		}
+		if err := run.Metadata.AddComment(fmt.Sprintf("%v: %d reads (%d pass, %d fail), %d bases.", comment, snapshot.GetReadCount(), snapshot.GetPassReadCount(), snapshot.GetFailReadCount(), snapshot.GetBases())); err != nil {
+			return true, err
+		}
+	}
+	return run.GetAcquisitionFinished(), herald.updateRecord(run)
+}
+
+// thinSnapshots will drop every other snapshot, keeping the
+// first and the latest, until there are no more than max.
+func thinSnapshots(snapshots []*records.AcquisitionSnapshot, max int) []*records.AcquisitionSnapshot {
+	for max >= 2 && len(snapshots) > max {
+		thinned := make([]*records.AcquisitionSnapshot, 0, len(snapshots)/2+1)
+		for i := 0; i < len(snapshots)-1; i += 2 {
+			thinned = append(thinned, snapshots[i])
+		}
+		snapshots = append(thinned, snapshots[len(snapshots)-1])
+	}
+	return snapshots
+}
+
+// GetSequencingRuns returns the labels of the runs
+// whose MinKNOW acquisitions are being followed.
+func (herald *Herald) GetSequencingRuns() []string {
+	herald.Lock()
+	defer herald.Unlock()
+	labels := make([]string, 0, len(herald.acquisitions))
+	for label := range herald.acquisitions {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// GetAcquisitionProgress returns the yield of a
+// run from its acquisition snapshots.
+func (herald *Herald) GetAcquisitionProgress(runLabel string) (*AcquisitionProgress, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	run, err := herald.store.GetRun(runLabel)
+	if err != nil {
+		return nil, err
+	}
+	progress := &AcquisitionProgress{
+		Run:           runLabel,
+		Position:      run.GetMinknowPosition(),
+		Finished:      run.GetAcquisitionFinished(),
+		Times:         []string{},
+		ReadCount:     []int64{},
+		PassReadCount: []int64{},
+		FailReadCount: []int64{},
+		Bases:         []int64{},
+	}
+	for _, snapshot := range run.GetAcquisitionSnapshots() {
+		timestamp, err := ptypes.Timestamp(snapshot.GetTime())
+		if err != nil {
+			return nil, err
+		}
+		progress.AcquisitionRunID, progress.State = snapshot.GetAcquisitionRunID(), snapshot.GetState()
+		progress.Times = append(progress.Times, timestamp.Local().Format("15:04"))
+		progress.ReadCount = append(progress.ReadCount, snapshot.GetReadCount())
+		progress.PassReadCount = append(progress.PassReadCount, snapshot.GetPassReadCount())
+		progress.FailReadCount = append(progress.FailReadCount, snapshot.GetFailReadCount())
+		progress.Bases = append(progress.Bases, snapshot.GetBases())
+	}
+	return progress, nil
+}
@@ -0,0 +1,134 @@
+package herald
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/will-rowe/herald/src/config"
+	"github.com/will-rowe/herald/src/minknow/acquisition"
+	"github.com/will-rowe/herald/src/minknow/minknowtest"
+	"github.com/will-rowe/herald/src/records"
+)
+
+// waitForProgress waits until the acquisition progress of a run passes the check, or fails the test
+func waitForProgress(t *testing.T, tmp *Herald, label string, check func(progress *AcquisitionProgress) bool) *AcquisitionProgress {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		progress, err := tmp.GetAcquisitionProgress(label)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if check(progress) {
+			return progress
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for acquisition progress: %+v", progress)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestAcquisitionProgress checks the MinKNOW acquisition for a run is followed until it finishes
+func TestAcquisitionProgress(t *testing.T) {
+	defer func(retry time.Duration) { AcquisitionRetry = retry }(AcquisitionRetry)
+	AcquisitionRetry = 10 * time.Millisecond
+	defer os.RemoveAll("./tmp_acquisition")
+
+	// start a MinKNOW that offers X1, where an acquisition has already finished
+	fake, err := minknowtest.NewServer("", "X1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fake.Stop()
+	_, played := fake.Position("X1").Play(&minknowtest.Script{Steps: []minknowtest.Step{{State: acquisition.AcquisitionState_ACQUISITION_COMPLETED}}})
+	<-played
+
+	tmp, err := InitHerald("./tmp_acquisition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmp.Destroy()
+	tmp.config.ServiceConnections = map[string]*config.ServiceConnection{
+		"minknow test": {Address: fake.Address()},
+	}
+	if err := tmp.setServiceConnections(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		tmp.config.ServiceConnections = nil
+		tmp.setServiceConnections()
+	}()
+
+	// only runs with a position are followed
+	if err := tmp.AddRun("unplaced run", "./tmp_acquisition", "", "", "scov2", 3, "", "", "", 0, "", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AddRun("sequencing run", "./tmp_acquisition", "", "", "scov2", 3, "", "X1", "", 0, "", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if runs := tmp.GetSequencingRuns(); len(runs) != 1 || runs[0] != "sequencing run" {
+		t.Fatalf("unexpected sequencing runs: %v", runs)
+	}
+
+	// check an acquisition that has already finished is ignored, and the next one is followed
+	fake.Position("X1").Play(&minknowtest.Script{Steps: []minknowtest.Step{
+		{After: 200 * time.Millisecond, State: acquisition.AcquisitionState_ACQUISITION_RUNNING, Reads: 10},
+		{After: 500 * time.Millisecond, State: acquisition.AcquisitionState_ACQUISITION_COMPLETED, StopReason: acquisition.AcquisitionStopReason_STOPPED_PROTOCOL_ENDED, Reads: 30, Bases: 9000},
+	}})
+	progress := waitForProgress(t, tmp, "sequencing run", func(progress *AcquisitionProgress) bool { return len(progress.Times) != 0 })
+	if progress.AcquisitionRunID != "x1-acquisition-2" || progress.State != "running" || progress.ReadCount[0] != 10 || progress.Position != "X1" {
+		t.Fatalf("unexpected acquisition progress: %+v", progress)
+	}
+	progress = waitForProgress(t, tmp, "sequencing run", func(progress *AcquisitionProgress) bool { return progress.Finished })
+	if len(progress.Times) != 2 || progress.Bases[1] != 9000 {
+		t.Fatalf("unexpected acquisition progress: %+v", progress)
+	}
+	history, err := tmp.GetHistory("run", "sequencing run", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := history[len(history)-1]; !strings.Contains(last.Text, "finished (protocol ended): 30 reads") {
+		t.Fatalf("unexpected history: %v", last.Text)
+	}
+	for deadline := time.Now().Add(5 * time.Second); len(tmp.GetSequencingRuns()) != 0; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("finished run is still being followed")
+		}
+	}
+
+	// check a run whose acquisition finished while Herald wasn't following it is marked finished
+	if err := tmp.AddRun("missed run", "./tmp_acquisition", "", "", "scov2", 3, "", "", "", 0, "", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, runID := range []string{"first", "second"} {
+		if _, err := tmp.recordAcquisition("missed run", &records.AcquisitionSnapshot{Time: ptypes.TimestampNow(), AcquisitionRunID: runID, State: "running"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if progress, err := tmp.GetAcquisitionProgress("missed run"); err != nil || !progress.Finished || len(progress.Times) != 1 {
+		t.Fatalf("missed acquisition was not marked finished: %+v %v", progress, err)
+	}
+}
+
+// TestThinSnapshots checks the snapshots kept on a run are capped, keeping the first and the latest
+func TestThinSnapshots(t *testing.T) {
+	snapshots := []*records.AcquisitionSnapshot{}
+	for i := 0; i < 4300; i++ {
+		snapshots = thinSnapshots(append(snapshots, &records.AcquisitionSnapshot{ReadCount: int64(i)}), 500)
+		if len(snapshots) > 500 {
+			t.Fatalf("%d snapshots were kept", len(snapshots))
+		}
+	}
+	if len(snapshots) < 250 || snapshots[0].GetReadCount() != 0 || snapshots[len(snapshots)-1].GetReadCount() != 4299 {
+		t.Fatalf("unexpected snapshots: %d kept, from %d to %d", len(snapshots), snapshots[0].GetReadCount(), snapshots[len(snapshots)-1].GetReadCount())
+	}
+	for i := 1; i < len(snapshots); i++ {
+		if snapshots[i].GetReadCount() <= snapshots[i-1].GetReadCount() {
+			t.Fatal("snapshots are out of order")
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package herald
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// startCallbackServer will start the server that services
+// use to report back, if a callback token is set in the
+// config. The server not starting (e.g. because the port
+// is in use) is logged rather than stopping Herald.
+func (herald *Herald) startCallbackServer() {
+	if len(herald.config.GetCallbackToken()) == 0 {
+		return
+	}
+	server, err := callbacks.NewServer(herald.config.GetCallbackToken(), herald.ReportServiceResult)
+	if err != nil {
+		log.Printf("callback server: %v", err)
+		return
+	}
+	if err := server.Start(herald.config.GetCallbackAddress(), herald.config.GetCallbackHTTPAddress()); err != nil {
+		log.Printf("callback server: %v", err)
+		return
+	}
+	herald.callbackServer = server
+}
+
+// stopCallbackServer will stop the callback server if it is running.
+func (herald *Herald) stopCallbackServer() {
+	if herald.callbackServer != nil {
+		herald.callbackServer.Stop()
+		herald.callbackServer = nil
+	}
+}
+
+// resumeWatches will restart the tracking of any jobs that
+// services were watching for records when Herald last
+// stopped.
+func (herald *Herald) resumeWatches() error {
+	herald.Lock()
+	defer herald.Unlock()
+	for _, recordType := range []records.RecordType{records.RecordType_run, records.RecordType_sample} {
+		var keys chan []byte
+		if recordType == records.RecordType_sample {
+			keys = herald.store.GetSampleLabels()
+		} else {
+			keys = herald.store.GetRunLabels()
+		}
+
+		// drain the channel before getting the records
+		labels := []string{}
+		for label := range keys {
+			labels = append(labels, string(label))
+		}
+		for _, label := range labels {
+			_, metadata, err := herald.getRecord(recordType.String(), label)
+			if err != nil {
+				return err
+			}
+			for serviceName, jobID := range metadata.GetJobIDs() {
+				if metadata.GetTags()[serviceName] || metadata.GetStatus() == records.Status_serviceFailed {
+					continue
+				}
+				service, _ := services.GetService(serviceName)
+				if watcher, ok := service.(services.Watcher); ok {
+					watcher.Watch(metadata.GetLabel(), jobID)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// CancelServiceJob will ask a service to cancel the job it
+// is running for a run or sample. The service reports the
+// job as failed once it has stopped.
+func (herald *Herald) CancelServiceJob(recordType, label, serviceName string) error {
+	herald.Lock()
+	_, metadata, err := herald.getRecord(recordType, label)
+	if err != nil {
+		herald.Unlock()
+		return err
+	}
+	jobID, ok := metadata.GetJobIDs()[serviceName]
+	complete := metadata.GetTags()[serviceName]
+	herald.Unlock()
+	if !ok || complete {
+		return fmt.Errorf("no %v job running for %v %v", serviceName, recordType, label)
+	}
+	service, ok := services.GetService(serviceName)
+	if !ok {
+		return fmt.Errorf("service not registered: %v", serviceName)
+	}
+
+	// don't hold the lock, the service may report back straight away
+	ctx, cancel := context.WithTimeout(herald.requestCtx, RequestTimeout)
+	defer cancel()
+	return service.Cancel(ctx, label, jobID)
+}
+
+// ReportServiceResult will update a run or sample with a report
+// from one of its tagged services. A complete job marks the tag
+// as complete (moving the record to tagsComplete once all its
+// tags are complete) and a failed job marks the record as
+// serviceFailed. Any result and manifest are stored in the
+// record. It returns the status of the record after the update.
+func (herald *Herald) ReportServiceResult(report *callbacks.ReportRequest) (string, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	serviceName := report.GetServiceName()
+
+	// work out the record type, falling back to the one the service accepts
+	recordType := report.GetRecordType()
+	if len(recordType) == 0 {
+		service, ok := services.GetService(serviceName)
+		if !ok {
+			return "", fmt.Errorf("no record type provided and service not registered: %v", serviceName)
+		}
+		recordType = service.GetRecordType().String()
+	}
+
+	// get the record and check it is tagged with the service
+	record, metadata, err := herald.getRecord(recordType, report.GetLabel())
+	if err != nil {
+		return "", err
+	}
+	if _, ok := metadata.GetTags()[serviceName]; !ok {
+		return "", fmt.Errorf("%v %v is not tagged with service: %v", recordType, report.GetLabel(), serviceName)
+	}
+	if len(report.GetResult()) != 0 {
+		if err := metadata.SetResult(serviceName, report.GetResult()); err != nil {
+			return "", err
+		}
+	}
+	if len(report.GetJobID()) != 0 {
+		if err := metadata.SetJobID(serviceName, report.GetJobID()); err != nil {
+			return "", err
+		}
+	}
+	if len(report.GetManifest()) != 0 {
+		files := make([]*records.ManifestFile, len(report.GetManifest()))
+		for i, file := range report.GetManifest() {
+			files[i] = &records.ManifestFile{Path: file.GetPath(), Size: file.GetSize(), Checksum: file.GetChecksum()}
+		}
+		if err := metadata.SetManifest(serviceName, report.GetResult(), files); err != nil {
+			return "", err
+		}
+	}
+
+	// update the record
+	comment := fmt.Sprintf("job %v", report.GetState())
+	if report.GetProgress() != 0 {
+		comment = fmt.Sprintf("%v (%.0f%%)", comment, report.GetProgress())
+	}
+	if len(report.GetMessage()) != 0 {
+		comment = fmt.Sprintf("%v: %v", comment, report.GetMessage())
+	}
+	if len(report.GetJobID()) != 0 {
+		comment = fmt.Sprintf("%v, job ID: %v", comment, report.GetJobID())
+	}
+	if len(report.GetResult()) != 0 {
+		comment = fmt.Sprintf("%v, result: %v", comment, report.GetResult())
+	}
+	if len(report.GetManifest()) != 0 {
+		comment = fmt.Sprintf("%v, manifest of %d files", comment, len(report.GetManifest()))
+	}
+	if err := metadata.AddServiceComment(serviceName, comment+"."); err != nil {
+		return "", err
+	}
+	switch report.GetState() {
+	case callbacks.JobState_running:
+		err = herald.updateRecord(record)
+
+	case callbacks.JobState_complete:
+		if err := metadata.SetTag(serviceName, true); err != nil {
+			return "", err
+		}
+
+		// don't resend a request that a service has already completed
+		if item := herald.announcementQueue.Get(records.RecordType(records.RecordType_value[recordType]), report.GetLabel()); item != nil {
+			item.Pending = remove(item.GetPending(), serviceName)
+			if err := herald.saveAnnouncement(item); err != nil {
+				return "", err
+			}
+		}
+		if metadata.CheckTagsComplete() {
+			err = herald.setStatus(record, metadata, records.Status_tagsComplete)
+		} else {
+			err = herald.updateRecord(record)
+		}
+
+	case callbacks.JobState_failed:
+		err = herald.setStatus(record, metadata, records.Status_serviceFailed)
+
+	default:
+		return "", fmt.Errorf("unsupported job state: %v", report.GetState())
+	}
+	if err != nil {
+		return "", err
+	}
+	return metadata.GetStatus().String(), nil
+}
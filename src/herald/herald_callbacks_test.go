@@ -0,0 +1,108 @@
+package herald
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// TestReportServiceResult checks that service reports update the tags, results and status of a record
+func TestReportServiceResult(t *testing.T) {
+	AnnounceInterval, AnnounceAttempts = time.Hour, 5
+	serviceA := &testService{name: "test service a", online: true}
+	serviceB := &testService{name: "test service b", online: true}
+	if err := services.RegisterService(serviceA); err != nil {
+		t.Fatal(err)
+	}
+	if err := services.RegisterService(serviceB); err != nil {
+		t.Fatal(err)
+	}
+	defer services.DeregisterService(serviceA.name)
+	defer services.DeregisterService(serviceB.name)
+	if err := os.MkdirAll("./tmp_report/fastq_pass/barcode01", 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll("./tmp_report/fastq_pass/barcode02", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp_report/")
+
+	// set up two samples tagged with both services and announce them
+	tmp, err := InitHerald("./tmp_report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmp.Destroy()
+	if err := tmp.AddRun("report run", "./tmp_report", "", "./tmp_report/fastq_pass", "scov2", 3, "EXP-NBD104", "", "", 0, "", nil, true); err != nil {
+		t.Fatal(err)
+	}
+	for i, label := range []string{"complete sample", "failed sample"} {
+		if err := tmp.CreateSample(label, "report run", int32(i+1), "", []string{serviceA.name, serviceB.name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tmp.AnnounceSamples(); err != nil {
+		t.Fatal(err)
+	}
+
+	// check reports for unknown records and services are rejected
+	if _, err := tmp.ReportServiceResult(&callbacks.ReportRequest{Label: "missing sample", ServiceName: serviceA.name}); err == nil {
+		t.Fatal("report for a missing sample was accepted")
+	}
+	if _, err := tmp.ReportServiceResult(&callbacks.ReportRequest{Label: "complete sample", ServiceName: "unknown", RecordType: "sample"}); err == nil {
+		t.Fatal("report for an untagged service was accepted")
+	}
+
+	// report progress and then completion from both services
+	reports := []*callbacks.ReportRequest{
+		{Label: "complete sample", ServiceName: serviceA.name, State: callbacks.JobState_running, Progress: 50},
+		{Label: "complete sample", ServiceName: serviceA.name, State: callbacks.JobState_complete, Result: "/data/a"},
+		{Label: "complete sample", ServiceName: serviceB.name, State: callbacks.JobState_complete, Result: "/data/b"},
+	}
+	expected := []string{"announced", "announced", "tagsComplete"}
+	for i, report := range reports {
+		recordStatus, err := tmp.ReportServiceResult(report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recordStatus != expected[i] {
+			t.Fatalf("report %d: expected status %v, got %v", i, expected[i], recordStatus)
+		}
+	}
+	sample, err := tmp.store.GetSample("complete sample")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sample.Metadata.CheckTagsComplete() || sample.Metadata.GetResults()[serviceB.name] != "/data/b" {
+		t.Fatalf("sample was not updated: %v", sample.Metadata)
+	}
+	if tmp.GetTaggedCompleteCount("samples") != 1 || tmp.GetAnnouncementCount() != 1 {
+		t.Fatal("counts were not updated for the completed sample")
+	}
+
+	// cancel a running job
+	if _, err := tmp.ReportServiceResult(&callbacks.ReportRequest{Label: "failed sample", ServiceName: serviceB.name, State: callbacks.JobState_running, JobID: "job 1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.CancelServiceJob("sample", "failed sample", serviceB.name); err != nil {
+		t.Fatal(err)
+	}
+	if len(serviceB.cancels) != 1 || serviceB.cancels[0] != "failed sample/job 1" {
+		t.Fatalf("job was not cancelled: %v", serviceB.cancels)
+	}
+	if err := tmp.CancelServiceJob("sample", "complete sample", serviceA.name); err == nil {
+		t.Fatal("cancelled a job that has completed")
+	}
+
+	// report a failure
+	recordStatus, err := tmp.ReportServiceResult(&callbacks.ReportRequest{Label: "failed sample", ServiceName: serviceA.name, State: callbacks.JobState_failed, Message: "out of disk"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recordStatus != "serviceFailed" || tmp.GetFailedCount("samples") != 1 {
+		t.Fatal("failed job did not update the sample")
+	}
+}
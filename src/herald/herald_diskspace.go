@@ -0,0 +1,76 @@
+package herald
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services"
+)
+
+var (
+	// BlockOnLowDiskSpace stops a run being sent to a service
+	// that starts runs if there isn't the disk space for it,
+	// otherwise a warning is added to the run history.
+	BlockOnLowDiskSpace = true
+
+	// ErrLowDiskSpace is returned by a request that was
+	// blocked by the disk space check.
+	ErrLowDiskSpace = errors.New("not enough disk space for the run")
+)
+
+// checkDiskSpace will check there is room for a run before
+// it is sent to a service that starts runs, adding the
+// result to the run history. An error is only returned if
+// the request should not be sent, a check that can't be
+// made (such as for an unknown flow cell) is a warning.
+//
+// NOTE: the caller must not hold the Herald lock
+func (herald *Herald) checkDiskSpace(ctx context.Context, serviceName string, record records.Record, service services.Service) error {
+	run, ok := record.(*records.Run)
+	if !ok {
+		return nil
+	}
+	checker, ok := service.(services.DiskSpaceChecker)
+	if !ok {
+		return nil
+	}
+	var checkErr error
+	comment := ""
+	needed, err := services.EstimateRunSize(run)
+	if err == nil {
+		var space *services.DiskSpace
+		if space, err = checker.GetDiskSpace(ctx, run); err == nil {
+			comment = fmt.Sprintf("disk space check: the run needs about %v, %v is available on %v", formatBytes(needed), formatBytes(space.Available), space.Location)
+			if space.Reserved != 0 {
+				comment = fmt.Sprintf("%v (%v is kept for stopping the run)", comment, formatBytes(space.Reserved))
+			}
+			switch {
+			case space.Available >= needed+space.Reserved:
+				comment += "."
+			case BlockOnLowDiskSpace:
+				comment += ", the request was not sent."
+				checkErr = fmt.Errorf("%v: needs %v, %v available on %v", ErrLowDiskSpace, formatBytes(needed+space.Reserved), formatBytes(space.Available), space.Location)
+			default:
+				comment += ", the run may fill the disk."
+			}
+		}
+	}
+	if err != nil {
+		comment = fmt.Sprintf("disk space check skipped: %v.", err)
+	}
+	herald.Lock()
+	defer herald.Unlock()
+	if err := herald.updateMetadata(records.RecordType_run, run.GetMetadata().GetLabel(), func(record interface{}, metadata *records.HeraldData) error {
+		return metadata.AddServiceComment(serviceName, comment)
+	}); err != nil {
+		return err
+	}
+	return checkErr
+}
+
+// formatBytes returns a byte count in GB.
+func formatBytes(bytes uint64) string {
+	return fmt.Sprintf("%.1f GB", float64(bytes)/1e9)
+}
@@ -0,0 +1,87 @@
+package herald
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// testDiskService is a service that starts runs, reporting a set amount of disk space
+type testDiskService struct {
+	*testService
+	space *services.DiskSpace
+}
+
+func (s *testDiskService) GetDiskSpace(ctx context.Context, run *records.Run) (*services.DiskSpace, error) {
+	return s.space, nil
+}
+
+// TestDiskSpaceCheck checks runs are blocked, or warned about, when there isn't the disk space for them
+func TestDiskSpaceCheck(t *testing.T) {
+	AnnounceInterval, AnnounceAttempts = time.Hour, 5
+	defer func() { BlockOnLowDiskSpace = true }()
+	service := &testDiskService{
+		testService: &testService{name: "test sequencer", online: true},
+		space:       &services.DiskSpace{Location: "/data", Available: 50e9, Reserved: 5e9},
+	}
+	if err := services.RegisterService(service); err != nil {
+		t.Fatal(err)
+	}
+	defer services.DeregisterService(service.name)
+	defer os.RemoveAll("./tmp_diskspace")
+	tmp, err := InitHerald("./tmp_diskspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmp.Destroy()
+
+	// check a 10 hour MinION run (100 GB) is blocked
+	if err := tmp.AddRun("disk run", "./tmp_diskspace", "", "", "scov2", 3, "", "X1", "FLO-MIN106", 10, "", []string{service.name}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AnnounceSamples(); err == nil || !strings.Contains(err.Error(), ErrLowDiskSpace.Error()) {
+		t.Fatalf("expected the run to be blocked, got %v", err)
+	}
+	if service.requests != 0 {
+		t.Fatal("request was sent without the disk space for the run")
+	}
+	history, err := tmp.GetHistory("run", "disk run", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, entry := range history {
+		if entry.Service == service.name && entry.Text == "disk space check: the run needs about 100.0 GB, 50.0 GB is available on /data (5.0 GB is kept for stopping the run), the request was not sent." {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("blocked disk space check was not added to the run history")
+	}
+
+	// check the run is sent with a warning when blocking is turned off
+	BlockOnLowDiskSpace = false
+	if err := tmp.AnnounceSamples(); err != nil {
+		t.Fatal(err)
+	}
+	if service.requests != 1 {
+		t.Fatal("request was not sent once blocking was turned off")
+	}
+	if history, err = tmp.GetHistory("run", "disk run", ""); err != nil {
+		t.Fatal(err)
+	}
+	found = false
+	for _, entry := range history {
+		if strings.HasSuffix(entry.Text, "the run may fill the disk.") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("disk space warning was not added to the run history")
+	}
+}
@@ -0,0 +1,48 @@
+package herald
+
+import (
+	"log"
+
+	"github.com/will-rowe/herald/src/services"
+)
+
+// startHealthMonitor will start probing the services in the
+// background. When a service comes back up, the queue is
+// drained (ignoring any backoff) so that held announcements
+// are sent straight away rather than waiting for the
+// scheduler.
+func (herald *Herald) startHealthMonitor() {
+	herald.health.Start()
+	events := herald.health.Subscribe()
+	herald.schedulerWG.Add(1)
+	go func() {
+		defer herald.schedulerWG.Done()
+		for event := range events {
+			log.Printf("service %v is %v (was %v)", event.Service, event.To, event.From)
+			if event.From != services.HealthDown || event.To != services.HealthUp {
+				continue
+			}
+			herald.Lock()
+			queueSize := herald.announcementQueue.Len()
+			herald.Unlock()
+			if queueSize == 0 {
+				continue
+			}
+			if err := herald.drainQueue(true); err != nil {
+				log.Printf("scheduled announcement: %v", err)
+			}
+		}
+	}()
+}
+
+// GetServiceHealth returns the health history of
+// each registered service.
+func (herald *Herald) GetServiceHealth() []*services.ServiceHealth {
+	return herald.health.GetAllHealth()
+}
+
+// GetServiceHealthEvents returns the most recent
+// changes in service health, oldest first.
+func (herald *Herald) GetServiceHealthEvents() []services.HealthEvent {
+	return herald.health.GetEvents()
+}
@@ -1,5 +1,25 @@
 package herald
 
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/will-rowe/herald/src/records"
+)
+
+// HistoryEntry is used to pass a comment from the
+// history of a run or sample to JS.
+type HistoryEntry struct {
+	Timestamp   string   `json:"timestamp"`
+	Kind        string   `json:"kind"`
+	Author      string   `json:"author"`
+	Service     string   `json:"service"`
+	Text        string   `json:"text"`
+	Attachments []string `json:"attachments"`
+}
+
 // GetUser returns the name of the user from the config
 func (herald *Herald) GetUser() string {
 	herald.Lock()
@@ -74,6 +94,19 @@ func (herald *Herald) GetTaggedCompleteCount(descriptor string) int {
 	return -1
 }
 
+// GetFailedCount returns the current number of runs/samples in storage that could not be announced
+func (herald *Herald) GetFailedCount(descriptor string) int {
+	herald.Lock()
+	defer herald.Unlock()
+	switch descriptor {
+	case "runs":
+		return herald.failedCount[0]
+	case "samples":
+		return herald.failedCount[1]
+	}
+	return -1
+}
+
 // GetAnnouncementQueueSize returns the current number of items in the announcment queue
 func (herald *Herald) GetAnnouncementQueueSize() int {
 	herald.Lock()
@@ -81,6 +114,41 @@ func (herald *Herald) GetAnnouncementQueueSize() int {
 	return herald.announcementQueue.Len()
 }
 
+// AnnouncementProgress reports the progress of a
+// run or sample that is being announced.
+type AnnouncementProgress struct {
+	RecordType string   `json:"recordType"`
+	Label      string   `json:"label"`
+	Services   int      `json:"services"` // the number of services being sent requests
+	Sent       []string `json:"sent"`     // the services that have been sent a request
+	Failed     []string `json:"failed"`   // the services that could not be sent a request
+	InFlight   []string `json:"inFlight"` // the services that requests are currently being sent to
+	Held       []string `json:"held"`     // the services that are down, so requests are being held
+}
+
+// GetAnnouncementProgress returns the progress of the
+// runs and samples that are currently being announced
+func (herald *Herald) GetAnnouncementProgress() []*AnnouncementProgress {
+	herald.Lock()
+	defer herald.Unlock()
+	progress := make([]*AnnouncementProgress, 0, len(herald.announcing))
+	for _, announcement := range herald.announcing {
+		p := *announcement.progress
+		p.Sent = append([]string{}, p.Sent...)
+		p.Failed = append([]string{}, p.Failed...)
+		p.InFlight = append([]string{}, p.InFlight...)
+		p.Held = append([]string{}, p.Held...)
+		progress = append(progress, &p)
+	}
+	sort.Slice(progress, func(i, j int) bool {
+		if progress[i].RecordType != progress[j].RecordType {
+			return progress[i].RecordType < progress[j].RecordType
+		}
+		return progress[i].Label < progress[j].Label
+	})
+	return progress
+}
+
 // GetAnnouncementCount returns the current number of announcements made
 func (herald *Herald) GetAnnouncementCount() int {
 	herald.Lock()
@@ -98,14 +166,27 @@ func (herald *Herald) PrintConfigToJSONstring() string {
 }
 
 // PrintSampleToJSONstring collects a sample from the database and returns a string of the sample protobuf data in JSON
-func (herald *Herald) PrintSampleToJSONstring(label string) string {
-	herald.Lock()
-	defer herald.Unlock()
+func (herald *Herald) PrintSampleToJSONstring(label string) (string, error) {
+	return herald.PrintRecord(records.RecordType_sample.String(), label, records.DumpJSON)
+}
 
-	// TODO: check the error from GetSampleJSONDump method
-	sampleString, _ := herald.store.GetSampleJSONDump(label)
+// GetDumpFormats returns the formats that runs and samples can be printed in
+func (herald *Herald) GetDumpFormats() []string {
+	return records.DumpFormats
+}
 
-	return sampleString
+// PrintRecord collects a run or sample from the database and returns a string of the protobuf data in the requested format (json/json-proto/yaml/text)
+func (herald *Herald) PrintRecord(recordType, label, format string) (string, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	switch recordType {
+	case records.RecordType_run.String():
+		return herald.store.GetRunDump(label, format)
+	case records.RecordType_sample.String():
+		return herald.store.GetSampleDump(label, format)
+	default:
+		return "", fmt.Errorf("unsupported record type: %v", recordType)
+	}
 }
 
 // GetSampleLabel is used by JS to collect a sample label from the runtime slice of sample data
@@ -143,3 +224,38 @@ func (herald *Herald) GetLabel(iterator int) string {
 	defer herald.Unlock()
 	return herald.runLabels[iterator]
 }
+
+// GetHistory returns the history of a run or sample, filtered by
+// comment kind (system/user/service). An empty kind returns all comments.
+func (herald *Herald) GetHistory(recordType, label, kind string) ([]*HistoryEntry, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	_, metadata, err := herald.getRecord(recordType, label)
+	if err != nil {
+		return nil, err
+	}
+	comments := metadata.GetHistory()
+	if len(kind) != 0 {
+		commentKind, ok := records.CommentKind_value[kind]
+		if !ok {
+			return nil, fmt.Errorf("unsupported comment kind: %v", kind)
+		}
+		comments = metadata.GetHistoryByKind(records.CommentKind(commentKind))
+	}
+	entries := make([]*HistoryEntry, len(comments))
+	for i, comment := range comments {
+		timestamp, err := ptypes.Timestamp(comment.GetTimestamp())
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = &HistoryEntry{
+			Timestamp:   timestamp.Format("2006-01-02 15:04:05"),
+			Kind:        comment.GetKind().String(),
+			Author:      comment.GetAuthor(),
+			Service:     comment.GetService(),
+			Text:        comment.GetText(),
+			Attachments: comment.GetAttachments(),
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,250 @@
+package herald
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// MinknowPositionInterval is how often the MinKNOW
+// positions are polled for the UI.
+var MinknowPositionInterval = 30 * time.Second
+
+// MinknowImport lists the runs created and updated
+// from the MinKNOW protocol history.
+type MinknowImport struct {
+	Created []string `json:"created"` // the labels of the runs created for protocol runs that weren't in Herald
+	Updated []string `json:"updated"` // the labels of the runs that were matched to changed protocol runs
+}
+
+// GetMinknowPositions returns the flow cell positions offered
+// by the registered MinKNOW services. A MinKNOW that can't be
+// reached is logged and skipped, an error is only returned
+// if none of them could be reached.
+func (herald *Herald) GetMinknowPositions() ([]*services.MinknowPosition, error) {
+	registered := services.GetServices()
+	names := []string{}
+	for name, service := range registered {
+		if _, ok := service.(services.PositionLister); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	positions := []*services.MinknowPosition{}
+	var lastErr error
+	for _, name := range names {
+		ctx, cancel := context.WithTimeout(herald.requestCtx, services.MinknowTimeout)
+		servicePositions, err := registered[name].(services.PositionLister).ListPositions(ctx)
+		cancel()
+		if err != nil {
+			log.Printf("%v: %v", name, err)
+			lastErr = err
+			continue
+		}
+		positions = append(positions, servicePositions...)
+	}
+	if len(positions) == 0 && lastErr != nil {
+		return nil, fmt.Errorf("could not reach MinKNOW: %v", lastErr)
+	}
+	return positions, nil
+}
+
+// startPositionPoller will poll the MinKNOW positions in the
+// background, so that they can be shown without waiting on
+// MinKNOW (see GetCachedMinknowPositions).
+func (herald *Herald) startPositionPoller() {
+	herald.positionsWG.Add(1)
+	go func() {
+		defer herald.positionsWG.Done()
+		ticker := time.NewTicker(MinknowPositionInterval)
+		defer ticker.Stop()
+		for {
+			positions, err := herald.GetMinknowPositions()
+			herald.Lock()
+			herald.minknowPositions, herald.minknowPositionsErr = positions, err
+			herald.Unlock()
+			select {
+			case <-herald.requestCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// GetCachedMinknowPositions returns the MinKNOW positions
+// from the last poll, which are empty until the first
+// poll has finished.
+func (herald *Herald) GetCachedMinknowPositions() ([]*services.MinknowPosition, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	return herald.minknowPositions, herald.minknowPositionsErr
+}
+
+// ImportMinknowRuns will get the protocol history from the
+// registered MinKNOW services and add it to the runs in
+// storage, so that runs started straight from MinKNOW are
+// also held by Herald. A protocol run is matched to a run
+// already holding it, then to a run labelled with its sample
+// ID or protocol group ID, otherwise a new run is created.
+func (herald *Herald) ImportMinknowRuns() (*MinknowImport, error) {
+	registered := services.GetServices()
+	names := []string{}
+	for name, service := range registered {
+		if _, ok := service.(services.ProtocolRunLister); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	protocolRuns := []*records.MinknowProtocolRun{}
+	for _, name := range names {
+		ctx, cancel := context.WithTimeout(herald.requestCtx, services.MinknowTimeout)
+		serviceRuns, err := registered[name].(services.ProtocolRunLister).ListProtocolRuns(ctx)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("could not get the protocol runs from %v: %v", name, err)
+		}
+		protocolRuns = append(protocolRuns, serviceRuns...)
+	}
+
+	herald.Lock()
+	defer herald.Unlock()
+
+	// find the protocol runs already held by runs, draining the channel before getting the records
+	labels := []string{}
+	for label := range herald.store.GetRunLabels() {
+		labels = append(labels, string(label))
+	}
+	linked := make(map[string]string)
+	for _, label := range labels {
+		run, err := herald.store.GetRun(label)
+		if err != nil {
+			return nil, err
+		}
+		if run.GetMinknowProtocolRun() != nil {
+			linked[run.GetMinknowProtocolRun().GetProtocolRunID()] = label
+		}
+	}
+
+	imported := &MinknowImport{
+		Created: []string{},
+		Updated: []string{},
+	}
+	for _, protocolRun := range protocolRuns {
+		run, err := herald.matchProtocolRun(protocolRun, linked)
+		if err != nil {
+			return nil, err
+		}
+		if run == nil {
+			run, err = herald.importProtocolRun(protocolRun)
+			if err != nil {
+				return nil, err
+			}
+			imported.Created = append(imported.Created, run.Metadata.GetLabel())
+		} else if !proto.Equal(run.GetMinknowProtocolRun(), protocolRun) {
+			comment := fmt.Sprintf("matched to MinKNOW protocol run %v (%v).", protocolRun.GetProtocolRunID(), protocolRun.GetState())
+			if run.GetMinknowProtocolRun() != nil {
+				comment = fmt.Sprintf("MinKNOW protocol run %v updated (%v).", protocolRun.GetProtocolRunID(), protocolRun.GetState())
+			}
+			if err := run.Metadata.AddComment(comment); err != nil {
+				return nil, err
+			}
+			setProtocolRun(run, protocolRun)
+			if err := herald.updateRecord(run); err != nil {
+				return nil, err
+			}
+			herald.watchAcquisition(run)
+			imported.Updated = append(imported.Updated, run.Metadata.GetLabel())
+		}
+		linked[protocolRun.GetProtocolRunID()] = run.Metadata.GetLabel()
+	}
+	return imported, nil
+}
+
+// matchProtocolRun returns the run in storage for a protocol
+// run, or nil if there isn't one. A run labelled with the
+// sample ID or protocol group ID is only used if it doesn't
+// already hold another protocol run.
+//
+// NOTE: the caller must hold the Herald lock.
+func (herald *Herald) matchProtocolRun(protocolRun *records.MinknowProtocolRun, linked map[string]string) (*records.Run, error) {
+	if label, ok := linked[protocolRun.GetProtocolRunID()]; ok {
+		return herald.store.GetRun(label)
+	}
+	for _, label := range []string{protocolRun.GetSampleID(), protocolRun.GetProtocolGroupID()} {
+		if len(label) == 0 || !herald.store.HasRun(label) {
+			continue
+		}
+		run, err := herald.store.GetRun(label)
+		if err != nil {
+			return nil, err
+		}
+		if run.GetMinknowProtocolRun() == nil {
+			return run, nil
+		}
+	}
+	return nil, nil
+}
+
+// importProtocolRun will create a run for a protocol run,
+// labelled with its sample ID or protocol group ID, or its
+// protocol run ID if these are missing or already used.
+//
+// NOTE: the caller must hold the Herald lock.
+func (herald *Herald) importProtocolRun(protocolRun *records.MinknowProtocolRun) (*records.Run, error) {
+	label := protocolRun.GetProtocolRunID()
+	for _, candidate := range []string{protocolRun.GetSampleID(), protocolRun.GetProtocolGroupID()} {
+		if len(candidate) != 0 && !herald.store.HasRun(candidate) {
+			label = candidate
+			break
+		}
+	}
+	fast5Dir, fastqDir := "", ""
+	if len(protocolRun.GetOutputPath()) != 0 {
+		fast5Dir = filepath.Join(protocolRun.GetOutputPath(), "fast5_pass")
+		fastqDir = filepath.Join(protocolRun.GetOutputPath(), "fastq_pass")
+	}
+	run := records.InitRun(label, protocolRun.GetOutputPath(), fast5Dir, fastqDir, "", 0, "")
+	if err := run.Metadata.AddComment(fmt.Sprintf("imported from MinKNOW protocol run %v (%v).", protocolRun.GetProtocolRunID(), protocolRun.GetState())); err != nil {
+		return nil, err
+	}
+	setProtocolRun(run, protocolRun)
+	if err := run.Metadata.CheckStatus(); err != nil {
+		return nil, err
+	}
+	if err := herald.store.AddRun(run); err != nil {
+		return nil, err
+	}
+	herald.runLabels = append(herald.runLabels, label)
+	herald.watchAcquisition(run)
+	return run, herald.updateCounts(run, true)
+}
+
+// setProtocolRun will add a protocol run to a run, filling
+// in the position, output directory and flow cell type if
+// they aren't set.
+func setProtocolRun(run *records.Run, protocolRun *records.MinknowProtocolRun) {
+	run.MinknowProtocolRun = protocolRun
+	if len(run.GetMinknowPosition()) == 0 {
+		run.MinknowPosition = protocolRun.GetPosition()
+	}
+	if len(run.GetOutputDirectory()) == 0 {
+		run.OutputDirectory = protocolRun.GetOutputPath()
+	}
+	if len(run.GetFlowCellType()) == 0 {
+		run.FlowCellType = protocolRun.GetFlowCellProductCode()
+	}
+
+	// a protocol run that has ended isn't followed
+	if protocolRun.GetEndTime() != nil && len(run.GetAcquisitionSnapshots()) == 0 {
+		run.AcquisitionFinished = true
+	}
+}
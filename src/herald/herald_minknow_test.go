@@ -0,0 +1,222 @@
+package herald
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/will-rowe/herald/src/config"
+	"github.com/will-rowe/herald/src/minknow/minknowtest"
+	"github.com/will-rowe/herald/src/minknow/protocol"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// TestMinknowPositions checks the positions of the MinKNOW services are listed
+func TestMinknowPositions(t *testing.T) {
+	defer func(interval time.Duration) { MinknowPositionInterval = interval }(MinknowPositionInterval)
+	MinknowPositionInterval = 10 * time.Millisecond
+	defer os.RemoveAll("./tmp_minknow")
+	tmp, err := InitHerald("./tmp_minknow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmp.Destroy()
+
+	// check an offline MinKNOW is an error
+	tmp.config.ServiceConnections = map[string]*config.ServiceConnection{
+		"minknow test": {Address: "127.0.0.1:1"},
+	}
+	if err := tmp.setServiceConnections(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tmp.GetMinknowPositions(); err == nil {
+		t.Fatal("positions were listed from an offline MinKNOW")
+	}
+
+	// start a MinKNOW and list its positions
+	fake, err := minknowtest.NewServer("", "X1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fake.Stop()
+	tmp.config.ServiceConnections["minknow test"].Address = fake.Address()
+	if err := tmp.setServiceConnections(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		tmp.config.ServiceConnections = nil
+		tmp.setServiceConnections()
+	}()
+	positions, err := tmp.GetMinknowPositions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 1 || positions[0].Name != "X1" || positions[0].Service != "Minknow test" || positions[0].Address != fake.Position("X1").Address() {
+		t.Fatalf("unexpected positions: %v", positions)
+	}
+
+	// check the positions are polled in the background
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if positions, err := tmp.GetCachedMinknowPositions(); err == nil && len(positions) == 1 && positions[0].Name == "X1" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the polled positions were not updated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestImportMinknowRuns checks the MinKNOW protocol history is matched to runs or imported as new runs
+func TestImportMinknowRuns(t *testing.T) {
+	defer os.RemoveAll("./tmp_import")
+	tmp, err := InitHerald("./tmp_import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmp.Destroy()
+	if err := tmp.AddRun("sample 1", "", "", "", "scov2", 3, "", "", "", 0, "", nil, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// start a MinKNOW with a protocol history for X1
+	fake, err := minknowtest.NewServer("", "X1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fake.Stop()
+	ended := ptypes.TimestampNow()
+	runs := []*protocol.ProtocolRunInfo{
+		{RunId: "p1", State: protocol.ProtocolState_PROTOCOL_COMPLETED, StartTime: ended, EndTime: ended, OutputPath: "/data/p1", UserInfo: &protocol.ProtocolRunUserInfo{SampleId: &wrappers.StringValue{Value: "sample 1"}}},
+		{RunId: "p2", State: protocol.ProtocolState_PROTOCOL_RUNNING, StartTime: ended, OutputPath: "/data/p2", UserInfo: &protocol.ProtocolRunUserInfo{SampleId: &wrappers.StringValue{Value: "sample 1"}, ProtocolGroupId: &wrappers.StringValue{Value: "group 2"}}},
+		{RunId: "p3", State: protocol.ProtocolState_PROTOCOL_FINISHED_WITH_ERROR, StartTime: ended, EndTime: ended},
+	}
+	for _, run := range runs {
+		fake.Position("X1").AddProtocolRun(run)
+	}
+	tmp.config.ServiceConnections = map[string]*config.ServiceConnection{
+		"minknow test": {Address: fake.Address()},
+	}
+	if err := tmp.setServiceConnections(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		tmp.config.ServiceConnections = nil
+		tmp.setServiceConnections()
+	}()
+
+	// check the existing run is matched by sample ID and the others are created
+	imported, err := tmp.ImportMinknowRuns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(imported.Updated, ",") != "sample 1" || strings.Join(imported.Created, ",") != "group 2,p3" {
+		t.Fatalf("unexpected import: %+v", imported)
+	}
+	if tmp.GetRunCount() != 3 {
+		t.Fatalf("expected 3 runs, got %d", tmp.GetRunCount())
+	}
+	run, err := tmp.store.GetRun("sample 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.GetMinknowProtocolRun().GetProtocolRunID() != "p1" || run.GetMinknowPosition() != "X1" || run.GetOutputDirectory() != "/data/p1" || !run.GetAcquisitionFinished() {
+		t.Fatalf("run was not matched to the protocol run: %v", run)
+	}
+	run, err = tmp.store.GetRun("group 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.GetMinknowProtocolRun().GetState() != "running" || run.GetFastqOutputDirectory() != "/data/p2/fastq_pass" || run.GetAcquisitionFinished() {
+		t.Fatalf("unexpected imported run: %v", run)
+	}
+
+	// check importing again only updates the protocol runs that have changed
+	if imported, err = tmp.ImportMinknowRuns(); err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.Created) != 0 || len(imported.Updated) != 0 {
+		t.Fatalf("unchanged protocol runs were imported again: %+v", imported)
+	}
+	runs[1].State, runs[1].EndTime = protocol.ProtocolState_PROTOCOL_COMPLETED, ended
+	fake.Position("X1").AddProtocolRun(runs[1])
+	if imported, err = tmp.ImportMinknowRuns(); err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.Created) != 0 || strings.Join(imported.Updated, ",") != "group 2" {
+		t.Fatalf("unexpected import: %+v", imported)
+	}
+	history, err := tmp.GetHistory("run", "group 2", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := history[len(history)-1]; last.Text != "MinKNOW protocol run p2 updated (completed)." {
+		t.Fatalf("unexpected history: %v", last.Text)
+	}
+}
+
+// TestMinknowRun checks a run is sent to the fake MinKNOW, followed while it sequences and matched to its protocol run
+func TestMinknowRun(t *testing.T) {
+	defer func(interval time.Duration) { services.AcquisitionSnapshotInterval = interval }(services.AcquisitionSnapshotInterval)
+	services.AcquisitionSnapshotInterval = 20 * time.Millisecond
+	defer os.RemoveAll("./tmp_minknow_run")
+
+	// start a MinKNOW that sequences a run when Herald sets up the position
+	fake, err := minknowtest.NewServer("", "X1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fake.Stop()
+	fake.Position("X1").SetAutoStart(minknowtest.SequencingScript(3, 50*time.Millisecond, 100))
+	tmp, err := InitHerald("./tmp_minknow_run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmp.Destroy()
+	tmp.config.ServiceConnections = map[string]*config.ServiceConnection{
+		"minknow test": {Address: fake.Address()},
+	}
+	if err := tmp.setServiceConnections(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		tmp.config.ServiceConnections = nil
+		tmp.setServiceConnections()
+	}()
+
+	// announce the run and check it is followed until the acquisition completes
+	if err := tmp.AddRun("minknow run", "./tmp_minknow_run", "", "", "scov2", 3, "", "X1", "FLO-MIN106", 2, "", []string{"Minknow test"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AnnounceSamples(); err != nil {
+		t.Fatal(err)
+	}
+	if sampleID, contextInfo := fake.Position("X1").SampleID(), fake.Position("X1").ContextInfo(); sampleID != "minknow run" || contextInfo["herald_protocol_group_id"] != "minknow run" {
+		t.Fatalf("the position was not set up for the run: %v %v", sampleID, contextInfo)
+	}
+	progress := waitForProgress(t, tmp, "minknow run", func(progress *AcquisitionProgress) bool { return progress.Finished })
+	if progress.State != "completed" || progress.ReadCount[len(progress.ReadCount)-1] != 300 {
+		t.Fatalf("unexpected acquisition progress: %+v", progress)
+	}
+
+	// check the protocol run is matched to the run on import
+	imported, err := tmp.ImportMinknowRuns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.Created) != 0 || strings.Join(imported.Updated, ",") != "minknow run" {
+		t.Fatalf("unexpected import: %+v", imported)
+	}
+	run, err := tmp.store.GetRun("minknow run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.GetMinknowProtocolRun().GetState() != "completed" || run.GetMinknowProtocolRun().GetFlowCellProductCode() != "FLO-MIN106" || run.GetMinknowProtocolRun().GetAcquisitionRunIDs()[0] != progress.AcquisitionRunID {
+		t.Fatalf("run was not matched to the protocol run: %v", run.GetMinknowProtocolRun())
+	}
+}
@@ -0,0 +1,36 @@
+package herald
+
+import (
+	"io"
+	"log"
+
+	"github.com/will-rowe/herald/src/plugins"
+	"github.com/will-rowe/herald/src/services"
+)
+
+// registerPlugins will start the plugins in the plugin
+// directory and register their services. Plugins are
+// told where the callback server is so that they can
+// report back. A plugin not starting (or offering a
+// service name that is taken) is logged rather than
+// stopping Herald.
+func (herald *Herald) registerPlugins() {
+	if len(herald.config.GetPluginDirectory()) == 0 {
+		return
+	}
+	env := []string{
+		plugins.EnvCallbackAddress + "=" + herald.config.GetCallbackAddress(),
+		plugins.EnvCallbackHTTPAddress + "=" + herald.config.GetCallbackHTTPAddress(),
+		plugins.EnvCallbackToken + "=" + herald.config.GetCallbackToken(),
+	}
+	pluginServices, errs := services.DiscoverPlugins(herald.config.GetPluginDirectory(), env)
+	for _, err := range errs {
+		log.Printf("plugins: %v", err)
+	}
+	for _, plugin := range pluginServices {
+		if err := herald.registerConfigService(plugin); err != nil {
+			log.Printf("plugins: %v", err)
+			plugin.(io.Closer).Close()
+		}
+	}
+}
@@ -1,99 +1,634 @@
 package herald
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
 
 	"github.com/will-rowe/herald/src/records"
 	"github.com/will-rowe/herald/src/services"
 )
 
-// AnnounceSamples will processes the queues and submit service requests
-func (herald *Herald) AnnounceSamples() error {
-	herald.Lock()
-	defer herald.Unlock()
-	if herald.announcementQueue.Len() == 0 {
-		return fmt.Errorf("announcement queue is empty")
+var (
+	// AnnounceInterval is how often the scheduler drains the announcement queue.
+	AnnounceInterval = 30 * time.Second
+
+	// AnnounceAttempts is the number of attempts made to announce a record before it is marked as failed.
+	AnnounceAttempts = 5
+
+	// AnnounceBackoff is the wait after the first failed attempt, it is doubled after each subsequent failure.
+	AnnounceBackoff = 10 * time.Second
+
+	// AnnounceMaxBackoff caps the wait between attempts.
+	AnnounceMaxBackoff = 10 * time.Minute
+
+	// HoldForDownServices keeps records queued, without using up their attempts, while
+	// the health monitor reports a tagged service as down. If false, a down service
+	// counts as a failed attempt.
+	HoldForDownServices = true
+
+	// RequestTimeout is how long a service has to accept a request before it counts as a failed attempt.
+	RequestTimeout = 2 * time.Minute
+)
+
+// enqueue will add a record to the announcement queue. If the
+// record is already queued, nothing is done. If the queue
+// database already holds an announcement for the record,
+// that announcement is resumed, otherwise a new one is created
+// with all the incomplete tags pending and saved to the queue
+// database.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) enqueue(record interface{}) error {
+	recordType, metadata, err := getRecordInfo(record)
+	if err != nil {
+		return err
+	}
+	if herald.announcementQueue.Get(recordType, metadata.GetLabel()) != nil {
+		return nil
 	}
 
-	// iterate once over the queue and process all the runs first
-	for request := herald.announcementQueue.Front(); request != nil; request = request.Next() {
-		switch v := request.Value.(type) {
-		default:
-			return fmt.Errorf("unexpected type in queue: %T", v)
-		case *records.Sample:
-			continue
-		case *records.Run:
-			// make the service requests
-			for tag, complete := range v.Metadata.GetTags() {
+	// resume the saved announcement if there is one
+	item, err := herald.store.GetAnnouncement(recordType, metadata.GetLabel())
+	if err == nil {
+		if err := herald.resumeAnnouncement(item, record, metadata); err != nil {
+			return err
+		}
+		herald.announcementQueue.Push(item)
+		return nil
+	}
 
-				// check it's not been completed already
-				if complete {
-					continue
-				}
+	// otherwise create a new one
+	pending := []string{}
+	for tag, complete := range metadata.GetTags() {
+		if !complete {
+			pending = append(pending, tag)
+		}
+	}
+	sort.Strings(pending)
+	item = &records.Announcement{
+		RecordType: recordType,
+		Label:      metadata.GetLabel(),
+		Enqueued:   ptypes.TimestampNow(),
+		Pending:    pending,
+	}
+	if err := herald.store.PutAnnouncement(item); err != nil {
+		return err
+	}
+	herald.announcementQueue.Push(item)
+	return nil
+}
 
-				// get the service and submit the request
-				service := services.ServiceRegister[tag]
-				if service.CheckAccess() == false {
-					return fmt.Errorf("%v: %v", ErrServiceOffline, tag)
-				}
-				if err := service.SendRequest(v); err != nil {
-					return err
-				}
-			}
+// resumeAnnouncement will check a saved announcement for
+// requests that were interrupted (e.g. by a crash) and put
+// the services back into the pending list. The requests will
+// be resent, so this is noted in the record history.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) resumeAnnouncement(item *records.Announcement, record interface{}, metadata *records.HeraldData) error {
+	if len(item.GetInflight()) == 0 {
+		return nil
+	}
+	for _, tag := range item.GetInflight() {
+		if complete, ok := metadata.GetTags()[tag]; ok && !complete && !contains(item.GetPending(), tag) {
+			item.Pending = append([]string{tag}, item.GetPending()...)
+		}
+		if err := metadata.AddServiceComment(tag, "service request was interrupted, it will be resent."); err != nil {
+			return err
+		}
+	}
+	item.Inflight = nil
+	if err := herald.store.PutAnnouncement(item); err != nil {
+		return err
+	}
+	return herald.updateRecord(record)
+}
 
-			// set the status to announced
-			v.Metadata.SetStatus(records.Status_announced)
+// dequeue will remove a record from the announcement queue
+// and the queue database.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) dequeue(recordType records.RecordType, label string) error {
+	herald.announcementQueue.Remove(recordType, label)
+	return herald.store.DeleteAnnouncement(recordType, label)
+}
 
-			// dequeue the sample
-			v.Metadata.AddComment("run announced.")
-			v.Metadata.SetStatus(records.Status_announced)
-			if err := herald.updateRecord(v); err != nil {
+// pruneQueue will remove any saved announcements whose
+// records are no longer awaiting announcement. It is
+// called once the queue has been rebuilt from storage.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) pruneQueue() error {
+	saved, err := herald.store.GetAnnouncements()
+	if err != nil {
+		return err
+	}
+	for _, item := range saved {
+		if herald.announcementQueue.Get(item.GetRecordType(), item.GetLabel()) == nil {
+			if err := herald.store.DeleteAnnouncement(item.GetRecordType(), item.GetLabel()); err != nil {
 				return err
 			}
-			herald.announcementQueue.Remove(request)
 		}
 	}
+	return nil
+}
 
-	// process the remaining queue (should just be samples now)
-	for request := herald.announcementQueue.Front(); request != nil; request = request.Next() {
+// SetAnnouncementPriority will change the priority of a queued
+// run or sample. Announcements with a higher priority are
+// made first (the default priority is 0).
+func (herald *Herald) SetAnnouncementPriority(recordType, label string, priority int32) error {
+	herald.Lock()
+	defer herald.Unlock()
+	rt, ok := records.RecordType_value[recordType]
+	if !ok {
+		return fmt.Errorf("unsupported record type: %v", recordType)
+	}
+	if err := herald.announcementQueue.SetPriority(records.RecordType(rt), label, priority); err != nil {
+		return err
+	}
+	return herald.store.PutAnnouncement(herald.announcementQueue.Get(records.RecordType(rt), label))
+}
+
+// startScheduler will start a goroutine that drains the
+// announcement queue every AnnounceInterval, until
+// stopScheduler is called.
+func (herald *Herald) startScheduler() {
+	herald.schedulerWG.Add(1)
+	go func() {
+		defer herald.schedulerWG.Done()
+		ticker := time.NewTicker(AnnounceInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-herald.schedulerStop:
+				return
+			case <-ticker.C:
+				if err := herald.drainQueue(false); err != nil {
+					log.Printf("scheduled announcement: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// stopScheduler will stop the scheduler goroutine and
+// wait for any drain in progress to finish.
+func (herald *Herald) stopScheduler() {
+	herald.schedulerOnce.Do(func() {
+		close(herald.schedulerStop)
+	})
+	herald.schedulerWG.Wait()
+}
 
-		// grab the sample that is first in the queue
-		sample := request.Value.(*records.Sample)
+// AnnounceSamples will process the queue now, ignoring any backoff,
+// and submit the service requests. Records that can't be announced
+// are kept in the queue for the scheduler to retry.
+//
+// The Herald lock is not held while requests are in flight, so
+// the runtime info can still be read (see GetAnnouncementProgress).
+func (herald *Herald) AnnounceSamples() error {
+	herald.Lock()
+	stopping, queueSize := herald.stopping, herald.announcementQueue.Len()
+	herald.Unlock()
+	if stopping {
+		return ErrStopping
+	}
+	if queueSize == 0 {
+		return fmt.Errorf("announcement queue is empty")
+	}
+	return herald.drainQueue(true)
+}
 
-		// TODO:
-		// evalute the sample
-		// update fields and propogate to linked data
-		// decide if it should be dequeued
+// inflightAnnouncement is a queued announcement that
+// has been dispatched to the service worker pools.
+type inflightAnnouncement struct {
+	item     *records.Announcement // the queued announcement
+	record   records.Record        // a copy of the record, which is sent to the services
+	pending  []string              // the services to send requests to in this attempt
+	progress *AnnouncementProgress // the progress of this attempt
+}
 
-		// make the service requests
-		for tag, complete := range sample.Metadata.GetTags() {
+// drainQueue will make a pass of the announcement queue,
+// announcing all runs and then all samples. Records are
+// announced concurrently, with each request going through
+// the worker pool for its service. It keeps going past
+// records that fail and returns an error listing them.
+// If force is false, records waiting on a backoff are
+// skipped. Records already being announced (e.g. by the
+// scheduler) are skipped. Nothing is announced once
+// Herald is being destroyed.
+//
+// NOTE: the caller must not hold the Herald lock
+func (herald *Herald) drainQueue(force bool) error {
+	herald.Lock()
+	if herald.stopping {
+		herald.Unlock()
+		return ErrStopping
+	}
+	herald.announceWG.Add(1)
+	herald.Unlock()
+	defer herald.announceWG.Done()
+	failures := []string{}
 
-			// check it's not been completed already
-			if complete {
+	// process all the runs first, then the samples
+	for _, recordType := range []records.RecordType{records.RecordType_run, records.RecordType_sample} {
+		dispatched, err := herald.dispatch(recordType, force)
+		if err != nil {
+			return err
+		}
+		errs := make([]error, len(dispatched))
+		var wg sync.WaitGroup
+		for i, announcement := range dispatched {
+			wg.Add(1)
+			go func(i int, announcement *inflightAnnouncement) {
+				defer wg.Done()
+				errs[i] = herald.announce(announcement)
+			}(i, announcement)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				failures = append(failures, err.Error())
+			}
+		}
+	}
+	if len(failures) != 0 {
+		return fmt.Errorf("%d announcements failed: %v", len(failures), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// dispatch will collect the queued announcements of a record
+// type that are ready to be made, in priority order, and mark
+// them as in progress.
+func (herald *Herald) dispatch(recordType records.RecordType, force bool) ([]*inflightAnnouncement, error) {
+	herald.Lock()
+	defer herald.Unlock()
+	dispatched := []*inflightAnnouncement{}
+	for _, item := range herald.announcementQueue.Items() {
+		if item.GetRecordType() != recordType {
+			continue
+		}
+		key := queueKey(item.GetRecordType(), item.GetLabel())
+		if _, ok := herald.announcing[key]; ok {
+			continue
+		}
+		if !force && item.GetNextAttempt() != nil {
+			nextAttempt, err := ptypes.Timestamp(item.GetNextAttempt())
+			if err == nil && time.Now().Before(nextAttempt) {
 				continue
 			}
+		}
 
-			// get the service and submit the request
-			service := services.ServiceRegister[tag]
-			if service.CheckAccess() == false {
-				return fmt.Errorf("%v: %v", ErrServiceOffline, tag)
+		// take a copy of the record to send, dropping the announcement if the record has gone
+		record, _, err := herald.getRecord(item.GetRecordType().String(), item.GetLabel())
+		if err != nil {
+			if err := herald.dequeue(item.GetRecordType(), item.GetLabel()); err != nil {
+				return nil, err
 			}
-			if err := service.SendRequest(sample); err != nil {
-				return err
+			continue
+		}
+		announcement := &inflightAnnouncement{
+			item:    item,
+			record:  record.(records.Record),
+			pending: append([]string{}, item.GetPending()...),
+			progress: &AnnouncementProgress{
+				RecordType: item.GetRecordType().String(),
+				Label:      item.GetLabel(),
+				Services:   len(item.GetPending()),
+				Sent:       []string{},
+				Failed:     []string{},
+				InFlight:   []string{},
+				Held:       []string{},
+			},
+		}
+		herald.announcing[key] = announcement
+		dispatched = append(dispatched, announcement)
+	}
+	return dispatched, nil
+}
+
+// announce will send the pending service requests for a
+// dispatched announcement via the service worker pools,
+// before updating the record once all the requests have
+// finished. The announcement is saved to the queue database
+// before and after each request, so that an interrupted
+// announcement can be resumed without resending requests
+// that have already been sent.
+//
+// NOTE: the caller must not hold the Herald lock
+func (herald *Herald) announce(announcement *inflightAnnouncement) error {
+	item := announcement.item
+	defer func() {
+		herald.Lock()
+		delete(herald.announcing, queueKey(item.GetRecordType(), item.GetLabel()))
+		herald.Unlock()
+	}()
+
+	// resolve the reads and primer scheme for a sample so services only receive their own reads
+	if sample, ok := announcement.record.(*records.Sample); ok {
+		if err := herald.resolveSample(sample); err != nil {
+			return herald.finishAnnouncement(announcement, "", err)
+		}
+	}
+
+	// send the requests via the worker pools, keeping going past failed services
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var lastErr error
+	failedService := ""
+	held := []string{}
+	for _, tag := range announcement.pending {
+		service, ok := services.GetService(tag)
+		if !ok {
+			lastErr, failedService = fmt.Errorf("service not registered: %v", tag), tag
+			herald.requestFinished(announcement, tag, nil, lastErr)
+			continue
+		}
+
+		// hold or fail requests for services that are down
+		if !herald.health.IsUp(tag) {
+			if HoldForDownServices {
+				held = append(held, tag)
+				herald.Lock()
+				announcement.progress.Held = append(announcement.progress.Held, tag)
+				herald.Unlock()
+				continue
 			}
+			lastErr, failedService = fmt.Errorf("%v: %v", ErrServiceOffline, tag), tag
+			herald.requestFinished(announcement, tag, nil, lastErr)
+			continue
 		}
+		herald.Lock()
+		pool := herald.getPool(tag)
+		herald.Unlock()
+		wg.Add(1)
+		go func(tag string, service services.Service) {
+			pool.submit(func() {
+				defer wg.Done()
+				err := herald.sendRequest(announcement, tag, service)
+				if err != nil {
+					mu.Lock()
+					lastErr, failedService = err, tag
+					mu.Unlock()
+				}
+			})
+		}(tag, service)
+	}
+	wg.Wait()
+	if lastErr == nil && len(held) != 0 {
+		return fmt.Errorf("%v %v is waiting for services that are down: %v", announcement.item.GetRecordType(), announcement.item.GetLabel(), strings.Join(held, ", "))
+	}
+	return herald.finishAnnouncement(announcement, failedService, lastErr)
+}
+
+// sendRequest will send a request for the record to a service
+// and record the outcome.
+//
+// NOTE: the caller must not hold the Herald lock
+func (herald *Herald) sendRequest(announcement *inflightAnnouncement, tag string, service services.Service) error {
+
+	// mark the request as in flight before sending it
+	herald.Lock()
+	announcement.item.Inflight = append(announcement.item.Inflight, tag)
+	announcement.progress.InFlight = append(announcement.progress.InFlight, tag)
+	err := herald.saveAnnouncement(announcement.item)
+	herald.Unlock()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(herald.requestCtx, RequestTimeout)
+	defer cancel()
+	var result *services.Result
+	sendErr := herald.checkDiskSpace(ctx, tag, announcement.record, service)
+	if sendErr == nil {
+		result, sendErr = service.SendRequest(ctx, announcement.record)
+	}
+	if err := herald.requestFinished(announcement, tag, result, sendErr); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// requestFinished will update an announcement and its record
+// once a service request has been sent or has failed. The
+// result is nil if the request wasn't sent.
+//
+// NOTE: the caller must not hold the Herald lock
+func (herald *Herald) requestFinished(announcement *inflightAnnouncement, tag string, result *services.Result, sendErr error) error {
+	herald.Lock()
+	defer herald.Unlock()
+	item, progress := announcement.item, announcement.progress
+	item.Inflight = remove(item.GetInflight(), tag)
+	progress.InFlight = remove(progress.InFlight, tag)
+	if sendErr != nil {
+		progress.Failed = append(progress.Failed, tag)
+		return herald.saveAnnouncement(item)
+	}
+	item.Pending = remove(item.GetPending(), tag)
+	progress.Sent = append(progress.Sent, tag)
+	if err := herald.saveAnnouncement(item); err != nil {
+		return err
+	}
+	comment := "service request sent"
+	if result != nil && len(result.JobID) != 0 {
+		comment = fmt.Sprintf("%v (job ID: %v)", comment, result.JobID)
+	}
+	return herald.updateMetadata(item.GetRecordType(), item.GetLabel(), func(record interface{}, metadata *records.HeraldData) error {
+		return metadata.AddServiceComment(tag, comment+".")
+	})
+}
+
+// finishAnnouncement will update the status of the record
+// once all the requests for an announcement have finished.
+//
+// NOTE: the caller must not hold the Herald lock
+func (herald *Herald) finishAnnouncement(announcement *inflightAnnouncement, failedService string, reason error) error {
+	herald.Lock()
+	defer herald.Unlock()
+	item := announcement.item
+
+	// get the latest copy of the record, it may have been deleted while the requests were in flight
+	record, metadata, err := herald.getRecord(item.GetRecordType().String(), item.GetLabel())
+	if err != nil || herald.announcementQueue.Get(item.GetRecordType(), item.GetLabel()) != item {
+		return nil
+	}
+	if sample, ok := announcement.record.(*records.Sample); ok && len(sample.GetInputFastqFiles()) != 0 {
+		stored := record.(*records.Sample)
+		stored.InputFastqFiles = sample.GetInputFastqFiles()
+		stored.PrimerScheme, stored.SchemeVersion = sample.GetPrimerScheme(), sample.GetSchemeVersion()
+	}
+	if reason != nil {
+		return herald.announceFailed(item, record, metadata, failedService, reason)
+	}
 
-		// update the status of the sample and dequeue it
-		sample.Metadata.AddComment("sample announced.")
-		sample.Metadata.SetStatus(records.Status_announced)
-		if err := herald.updateRecord(sample); err != nil {
+	// update the status of the record
+	if err := metadata.AddComment(fmt.Sprintf("%v announced.", item.GetRecordType())); err != nil {
+		return err
+	}
+	return herald.setStatus(record, metadata, records.Status_announced)
+}
+
+// saveAnnouncement will save an announcement to the queue
+// database, as long as it is still queued.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) saveAnnouncement(item *records.Announcement) error {
+	if herald.announcementQueue.Get(item.GetRecordType(), item.GetLabel()) != item {
+		return nil
+	}
+	return herald.store.PutAnnouncement(item)
+}
+
+// updateMetadata will apply an update to the latest copy of
+// a record in storage, doing nothing if the record has gone.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) updateMetadata(recordType records.RecordType, label string, update func(record interface{}, metadata *records.HeraldData) error) error {
+	record, metadata, err := herald.getRecord(recordType.String(), label)
+	if err != nil {
+		return nil
+	}
+	if err := update(record, metadata); err != nil {
+		return err
+	}
+	return herald.updateRecord(record)
+}
+
+// announceFailed will record a failed attempt to announce a
+// record. Once AnnounceAttempts have been made the record is
+// marked as failed, otherwise the next attempt is backed off.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) announceFailed(item *records.Announcement, record interface{}, metadata *records.HeraldData, serviceName string, reason error) error {
+	item.Attempts++
+	failErr := fmt.Errorf("%v (attempt %d of %d): %v", metadata.GetLabel(), item.GetAttempts(), AnnounceAttempts, reason)
+	comment := fmt.Sprintf("announcement attempt %d failed: %v", item.GetAttempts(), reason)
+	if len(serviceName) != 0 {
+		metadata.AddServiceComment(serviceName, comment)
+	} else {
+		metadata.AddComment(comment)
+	}
+
+	// give up on the record if it has run out of attempts
+	if int(item.GetAttempts()) >= AnnounceAttempts {
+		metadata.AddComment(fmt.Sprintf("announcement failed after %d attempts.", item.GetAttempts()))
+		if err := herald.setStatus(record, metadata, records.Status_announceFailed); err != nil {
 			return err
 		}
-		herald.announcementQueue.Remove(request)
+		return failErr
 	}
 
-	if herald.announcementQueue.Len() != 0 {
-		return fmt.Errorf("announcements sent but queue still contains %d requests", herald.announcementQueue.Len())
+	// otherwise backoff before the next attempt
+	backoff := AnnounceBackoff << uint(item.GetAttempts()-1)
+	if backoff > AnnounceMaxBackoff || backoff <= 0 {
+		backoff = AnnounceMaxBackoff
 	}
-	return nil
+	nextAttempt, err := ptypes.TimestampProto(time.Now().Add(backoff))
+	if err != nil {
+		return err
+	}
+	item.NextAttempt = nextAttempt
+	if err := herald.store.PutAnnouncement(item); err != nil {
+		return err
+	}
+	if err := herald.updateRecord(record); err != nil {
+		return err
+	}
+	return failErr
+}
+
+// setStatus will update the status of a record in storage
+// and then adjust the runtime counts (dequeuing the record
+// if it was awaiting announcement). The record is saved
+// first so that a crash can't leave a record awaiting
+// announcement without its saved announcement.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) setStatus(record interface{}, metadata *records.HeraldData, status records.Status) error {
+	oldStatus := metadata.GetStatus()
+	metadata.SetStatus(status)
+	if err := herald.updateRecord(record); err != nil {
+		metadata.SetStatus(oldStatus)
+		return err
+	}
+
+	// swap the status back while the old counts are removed
+	metadata.SetStatus(oldStatus)
+	if err := herald.updateCounts(record, false); err != nil {
+		return err
+	}
+	metadata.SetStatus(status)
+	return herald.updateCounts(record, true)
+}
+
+// resolveSample will find the FASTQ files for a sample in
+// its parent run and add them to the sample, along with
+// a summary in the sample history. The primer scheme of
+// the parent run is also added to the sample.
+//
+// NOTE: the caller must not hold the Herald lock
+func (herald *Herald) resolveSample(sample *records.Sample) error {
+	herald.Lock()
+	run, err := herald.store.GetRun(sample.GetParentRun())
+	herald.Unlock()
+	if err != nil {
+		return err
+	}
+	fastqs, err := sample.GetFastqFiles(run)
+	if err != nil {
+		return fmt.Errorf("could not get FASTQ files for %v: %v", sample.Metadata.GetLabel(), err)
+	}
+	summary, err := records.GetFastqSummary(fastqs)
+	if err != nil {
+		return err
+	}
+	sample.InputFastqFiles = fastqs
+	sample.PrimerScheme, sample.SchemeVersion = run.GetPrimerScheme(), run.GetSchemeVersion()
+
+	// record the summary in the stored sample
+	herald.Lock()
+	defer herald.Unlock()
+	return herald.updateMetadata(records.RecordType_sample, sample.Metadata.GetLabel(), func(record interface{}, metadata *records.HeraldData) error {
+		return metadata.AddComment(fmt.Sprintf("found %v.", summary))
+	})
+}
+
+// getRecordInfo returns the type and metadata of a run or sample.
+func getRecordInfo(record interface{}) (records.RecordType, *records.HeraldData, error) {
+	switch v := record.(type) {
+	case *records.Run:
+		return records.RecordType_run, v.GetMetadata(), nil
+	case *records.Sample:
+		return records.RecordType_sample, v.GetMetadata(), nil
+	default:
+		return 0, nil, fmt.Errorf("unsupported record type: %T", record)
+	}
+}
+
+// contains returns true if the string is in the slice.
+func contains(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// remove returns the slice without the string.
+func remove(slice []string, s string) []string {
+	kept := make([]string, 0, len(slice))
+	for _, v := range slice {
+		if v != s {
+			kept = append(kept, v)
+		}
+	}
+	return kept
 }
@@ -0,0 +1,572 @@
+package herald
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	archer "github.com/will-rowe/archer/pkg/api/v1"
+
+	"github.com/will-rowe/herald/src/config"
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services"
+	"github.com/will-rowe/herald/src/services/archertest"
+)
+
+// testService is a service that can be toggled offline and made slow
+type testService struct {
+	name     string
+	online   bool
+	delay    time.Duration // how long each request takes
+	requests int           // the number of requests received
+	active   int           // the number of requests currently being handled
+	peak     int           // the most requests handled at once
+	cancels  []string      // the jobs that have been cancelled, as label/jobID
+	sync.Mutex
+}
+
+func (s *testService) GetServiceName() string            { return s.name }
+func (s *testService) GetRecordType() records.RecordType { return records.RecordType_sample }
+func (s *testService) GetAddress() string                { return "127.0.0.1:0" }
+func (s *testService) GetDependencies() []string         { return nil }
+func (s *testService) CheckAccess(ctx context.Context) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.online
+}
+func (s *testService) setOnline(online bool) {
+	s.Lock()
+	s.online = online
+	s.Unlock()
+}
+func (s *testService) SendRequest(ctx context.Context, record records.Record) (*services.Result, error) {
+	s.Lock()
+	s.requests++
+	s.active++
+	if s.active > s.peak {
+		s.peak = s.active
+	}
+	s.Unlock()
+	time.Sleep(s.delay)
+	s.Lock()
+	s.active--
+	s.Unlock()
+	return &services.Result{}, nil
+}
+func (s *testService) Cancel(ctx context.Context, label, jobID string) error {
+	s.Lock()
+	defer s.Unlock()
+	s.cancels = append(s.cancels, label+"/"+jobID)
+	return nil
+}
+
+// TestScheduler checks that the scheduler retries and then fails records for offline services
+func TestScheduler(t *testing.T) {
+	AnnounceInterval, AnnounceBackoff, AnnounceAttempts = 10*time.Millisecond, time.Millisecond, 3
+	HoldForDownServices = false
+	defer func() { HoldForDownServices = true }()
+	offline := &testService{name: "test offline"}
+	if err := services.RegisterService(offline); err != nil {
+		t.Fatal(err)
+	}
+	defer services.DeregisterService(offline.name)
+	if err := os.MkdirAll("./tmp_queue/fastq_pass", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp_queue/")
+
+	// set up a sample tagged with the offline service
+	tmp, err := InitHerald("./tmp_queue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AddRun("queue run", "./tmp_queue", "", "./tmp_queue/fastq_pass", "scov2", 3, "", "", "", 0, "", nil, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.CreateSample("queue sample", "queue run", 0, "", []string{offline.name}); err != nil {
+		t.Fatal(err)
+	}
+	if tmp.GetAnnouncementQueueSize() != 1 {
+		t.Fatal("tagged sample was not added to the announcement queue")
+	}
+
+	// wait for the scheduler to run out of attempts
+	deadline := time.Now().Add(5 * time.Second)
+	for tmp.GetFailedCount("samples") != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("scheduler did not mark the sample as failed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if tmp.GetAnnouncementQueueSize() != 0 {
+		t.Fatal("failed sample was not removed from the announcement queue")
+	}
+	if offline.requests != 0 {
+		t.Fatal("request was sent to an offline service")
+	}
+
+	// check the scheduler stops cleanly
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestQueuePersistence checks that the announcement queue survives a restart and
+// that an interrupted announcement is resumed without resending completed requests
+func TestQueuePersistence(t *testing.T) {
+	AnnounceInterval, AnnounceAttempts = time.Hour, 5
+	HoldForDownServices = false
+	defer func() { HoldForDownServices = true }()
+	online := &testService{name: "test online", online: true}
+	offline := &testService{name: "test offline"}
+	if err := services.RegisterService(online); err != nil {
+		t.Fatal(err)
+	}
+	if err := services.RegisterService(offline); err != nil {
+		t.Fatal(err)
+	}
+	defer services.DeregisterService(online.name)
+	defer services.DeregisterService(offline.name)
+	if err := os.MkdirAll("./tmp_persist/fastq_pass", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp_persist/")
+
+	// queue a sample and announce it while one of its services is offline
+	tmp, err := InitHerald("./tmp_persist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AddRun("persist run", "./tmp_persist", "", "./tmp_persist/fastq_pass", "scov2", 3, "", "", "", 0, "", nil, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.CreateSample("persist sample", "persist run", 0, "", []string{online.name, offline.name}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AnnounceSamples(); err == nil {
+		t.Fatal("announcement to an offline service did not fail")
+	}
+	if online.requests != 1 {
+		t.Fatalf("online service received %d requests, expected 1", online.requests)
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+
+	// restart and check the announcement was resumed with only the offline service pending
+	tmp, err = InitHerald("./tmp_persist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmp.GetAnnouncementQueueSize() != 1 {
+		t.Fatal("announcement queue was not restored")
+	}
+	item := tmp.announcementQueue.Get(records.RecordType_sample, "persist sample")
+	if len(item.GetPending()) != 1 || item.GetPending()[0] != offline.name || item.GetAttempts() != 1 {
+		t.Fatalf("restored announcement is incorrect: %v", item)
+	}
+
+	// simulate a crash while a request was in flight to the offline service
+	item.Inflight, item.Pending = []string{offline.name}, nil
+	if err := tmp.store.PutAnnouncement(item); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+	tmp, err = InitHerald("./tmp_persist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	item = tmp.announcementQueue.Get(records.RecordType_sample, "persist sample")
+	if len(item.GetInflight()) != 0 || len(item.GetPending()) != 1 || item.GetPending()[0] != offline.name {
+		t.Fatalf("interrupted request was not put back into pending: %v", item)
+	}
+
+	// bring the service online and check the record is announced without resending to the first service
+	waitForHealth(t, tmp, offline.name, services.HealthDown)
+	offline.setOnline(true)
+	tmp.health.CheckNow()
+	waitForQueue(t, tmp, 0)
+	if online.requests != 1 || offline.requests != 1 {
+		t.Fatalf("duplicate requests sent: %d and %d", online.requests, offline.requests)
+	}
+	if tmp.GetAnnouncementQueueSize() != 0 || tmp.store.GetNumAnnouncements() != 0 {
+		t.Fatal("announced sample was not removed from the queue")
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+
+	// check nothing is queued after another restart
+	tmp, err = InitHerald("./tmp_persist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmp.GetAnnouncementQueueSize() != 0 {
+		t.Fatal("announced sample was requeued after a restart")
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestQueueDeletion checks that deleting a queued sample removes it from the queue
+// and that the sample is not announced
+func TestQueueDeletion(t *testing.T) {
+	AnnounceInterval, AnnounceAttempts = time.Hour, 5
+	online := &testService{name: "test online", online: true}
+	if err := services.RegisterService(online); err != nil {
+		t.Fatal(err)
+	}
+	defer services.DeregisterService(online.name)
+	if err := os.MkdirAll("./tmp_delete/fastq_pass/barcode01", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp_delete/")
+
+	// queue a run and two samples
+	tmp, err := InitHerald("./tmp_delete")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AddRun("delete run", "./tmp_delete", "", "./tmp_delete/fastq_pass", "scov2", 3, "EXP-NBD104", "", "", 0, "", nil, true); err != nil {
+		t.Fatal(err)
+	}
+	for i, label := range []string{"kept sample", "deleted sample"} {
+		if err := tmp.CreateSample(label, "delete run", int32(i+1), "", []string{online.name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tmp.GetAnnouncementQueueSize() != 2 {
+		t.Fatalf("expected 2 queued samples, got %d", tmp.GetAnnouncementQueueSize())
+	}
+
+	// check a queued record is not queued twice
+	sample, err := tmp.store.GetSample("kept sample")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.enqueue(sample); err != nil {
+		t.Fatal(err)
+	}
+	if tmp.GetAnnouncementQueueSize() != 2 || tmp.store.GetNumAnnouncements() != 2 {
+		t.Fatal("requeued sample was duplicated")
+	}
+
+	// delete a queued sample and check it is removed from the queue and the queue database
+	if err := tmp.DeleteSample("deleted sample"); err != nil {
+		t.Fatal(err)
+	}
+	if tmp.GetAnnouncementQueueSize() != 1 || tmp.store.GetNumAnnouncements() != 1 {
+		t.Fatal("deleted sample was not removed from the queue")
+	}
+	if tmp.announcementQueue.Get(records.RecordType_sample, "deleted sample") != nil {
+		t.Fatal("deleted sample is still queued")
+	}
+	if err := tmp.SetAnnouncementPriority("sample", "deleted sample", 1); err == nil {
+		t.Fatal("priority was set for a deleted sample")
+	}
+
+	// check only the remaining sample is announced
+	if err := tmp.AnnounceSamples(); err != nil {
+		t.Fatal(err)
+	}
+	if online.requests != 1 {
+		t.Fatalf("expected 1 request, got %d", online.requests)
+	}
+	if tmp.GetAnnouncementCount() != 1 {
+		t.Fatalf("expected 1 announcement, got %d", tmp.GetAnnouncementCount())
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestConcurrentAnnouncement checks that requests go through the service worker pools
+// within their limits, and that the runtime info can be read while requests are in flight
+func TestConcurrentAnnouncement(t *testing.T) {
+	AnnounceInterval, AnnounceAttempts = time.Hour, 5
+	slow := &testService{name: "test slow", online: true, delay: 100 * time.Millisecond}
+	limited := &testService{name: "test limited", online: true}
+	if err := services.RegisterService(slow); err != nil {
+		t.Fatal(err)
+	}
+	if err := services.RegisterService(limited); err != nil {
+		t.Fatal(err)
+	}
+	defer services.DeregisterService(slow.name)
+	defer services.DeregisterService(limited.name)
+	if err := os.MkdirAll("./tmp_workers/fastq_pass", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp_workers/")
+
+	// set up some samples tagged with both services
+	tmp, err := InitHerald("./tmp_workers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp.config.ServiceLimits = map[string]*config.ServiceLimits{
+		slow.name:    {Concurrency: 3},
+		limited.name: {Concurrency: 1, RateLimit: 20},
+	}
+	if err := tmp.AddRun("worker run", "./tmp_workers", "", "./tmp_workers/fastq_pass", "scov2", 3, "EXP-NBD104", "", "", 0, "", nil, true); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 6; i++ {
+		if err := os.MkdirAll(fmt.Sprintf("./tmp_workers/fastq_pass/barcode%02d", i), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := tmp.CreateSample(fmt.Sprintf("worker sample %d", i), "worker run", int32(i), "", []string{slow.name, limited.name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// announce in the background and check the runtime info can be read while requests are in flight
+	start := time.Now()
+	done := make(chan error)
+	go func() {
+		done <- tmp.AnnounceSamples()
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(tmp.GetAnnouncementProgress()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("no announcement progress reported")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	getterStart := time.Now()
+	if tmp.GetSampleCount() != 6 {
+		t.Fatal("could not read the sample count during announcement")
+	}
+	if time.Since(getterStart) > 50*time.Millisecond {
+		t.Fatal("runtime info was blocked by requests in flight")
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// check the limits were respected
+	if slow.requests != 6 || limited.requests != 6 {
+		t.Fatalf("expected 6 requests per service, got %d and %d", slow.requests, limited.requests)
+	}
+	if slow.peak != 3 {
+		t.Fatalf("expected 3 concurrent requests to the slow service, got %d", slow.peak)
+	}
+	if limited.peak != 1 {
+		t.Fatalf("expected 1 concurrent request to the limited service, got %d", limited.peak)
+	}
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Fatalf("rate limit was not applied (6 requests at 20/s took %v)", elapsed)
+	}
+	if tmp.GetAnnouncementCount() != 6 || len(tmp.GetAnnouncementProgress()) != 0 || tmp.GetAnnouncementQueueSize() != 0 {
+		t.Fatal("samples were not all announced")
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestHoldForDownServices checks that records are held in the queue, without using
+// up their attempts, while a service is down and announced once it comes back up
+func TestHoldForDownServices(t *testing.T) {
+	AnnounceInterval, AnnounceAttempts = time.Hour, 1
+	down := &testService{name: "test down"}
+	if err := services.RegisterService(down); err != nil {
+		t.Fatal(err)
+	}
+	defer services.DeregisterService(down.name)
+	if err := os.MkdirAll("./tmp_hold/fastq_pass", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp_hold/")
+
+	// queue a sample and try to announce it while the service is down
+	tmp, err := InitHerald("./tmp_hold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AddRun("hold run", "./tmp_hold", "", "./tmp_hold/fastq_pass", "scov2", 3, "", "", "", 0, "", nil, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.CreateSample("hold sample", "hold run", 0, "", []string{down.name}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := tmp.AnnounceSamples(); err == nil {
+			t.Fatal("announcement to a down service did not report the hold")
+		}
+	}
+	item := tmp.announcementQueue.Get(records.RecordType_sample, "hold sample")
+	if item == nil || item.GetAttempts() != 0 || down.requests != 0 {
+		t.Fatalf("held announcement is incorrect: %v", item)
+	}
+	if tmp.GetFailedCount("samples") != 0 {
+		t.Fatal("held sample was marked as failed")
+	}
+	waitForHealth(t, tmp, down.name, services.HealthDown)
+
+	// bring the service up and check the sample is announced without calling AnnounceSamples
+	down.setOnline(true)
+	tmp.health.CheckNow()
+	waitForQueue(t, tmp, 0)
+	if down.requests != 1 || tmp.GetAnnouncementCount() != 1 {
+		t.Fatalf("held sample was not announced once the service came up (%d requests)", down.requests)
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitForQueue will wait for the announcement queue to reach a size
+func waitForQueue(t *testing.T, tmp *Herald, size int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for tmp.GetAnnouncementQueueSize() != size {
+		if time.Now().After(deadline) {
+			t.Fatalf("announcement queue did not reach %d (currently %d)", size, tmp.GetAnnouncementQueueSize())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitForHealth will wait for the health monitor to report a service state
+func waitForHealth(t *testing.T, tmp *Herald, serviceName string, state services.HealthState) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		health := tmp.health.GetHealth(serviceName)
+		if health != nil && health.State == state {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%v service health did not reach %v", serviceName, state)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitForRun will wait for a run to reach a status
+func waitForRun(t *testing.T, tmp *Herald, label string, status records.Status) *records.Run {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		tmp.Lock()
+		run, err := tmp.store.GetRun(label)
+		tmp.Unlock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if run.GetMetadata().GetStatus() == status {
+			return run
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("run %v did not reach %v (currently %v)", label, status, run.GetMetadata().GetStatus())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestArcherAnnouncements checks runs are announced to a fake Archer, and that failed,
+// slow and offline requests are handled
+func TestArcherAnnouncements(t *testing.T) {
+	AnnounceInterval, AnnounceAttempts = time.Hour, 1
+	defer func(timeout time.Duration) { RequestTimeout = timeout }(RequestTimeout)
+	if err := os.MkdirAll("./tmp_archer/fastq_pass", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp_archer/")
+	if err := ioutil.WriteFile("./tmp_archer/fastq_pass/reads.fastq", []byte("@r1\nACGT\n+\nIIII\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fake, err := archertest.NewServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { fake.Stop() }()
+	tmp, err := InitHerald("./tmp_archer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmp.Destroy()
+	tmp.config.ServiceConnections = map[string]*config.ServiceConnection{
+		"archer upload": {Address: fake.Address()},
+	}
+	if err := tmp.setServiceConnections(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		tmp.config.ServiceConnections = nil
+		tmp.setServiceConnections()
+	}()
+	tmp.health.CheckNow()
+	waitForHealth(t, tmp, "Archer upload", services.HealthUp)
+	addRun := func(label string) {
+		if err := tmp.AddRun(label, "./tmp_archer", "", "./tmp_archer/fastq_pass", "scov2", 3, "", "", "", 0, "", []string{"Archer upload"}, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// check a run is sent with its reads and completes with the Archer endpoint
+	addRun("archer run")
+	if err := tmp.AnnounceSamples(); err != nil {
+		t.Fatal(err)
+	}
+	requests := fake.Requests()
+	if len(requests) != 1 || requests[0].GetSampleID() != "archer run" || len(requests[0].GetInputFASTQfiles()) != 1 || requests[0].GetScheme() != "scov2" || requests[0].GetSchemeVersion() != 3 {
+		t.Fatalf("unexpected archer requests: %v", requests)
+	}
+	run := waitForRun(t, tmp, "archer run", records.Status_tagsComplete)
+	if run.GetMetadata().GetResults()["Archer upload"] != "s3://archer-test/archer run" || run.GetMetadata().GetJobIDs()["Archer upload"] != "archer run" {
+		t.Fatalf("archer result was not kept: %v", run.GetMetadata())
+	}
+
+	// check a job that fails in Archer, a refused request and a slow request all fail the run
+	fake.SetOutcome(archertest.Outcome{State: archer.State_ERROR, Errors: []string{"no reads passed QC"}})
+	addRun("failed run")
+	if err := tmp.AnnounceSamples(); err != nil {
+		t.Fatal(err)
+	}
+	waitForRun(t, tmp, "failed run", records.Status_serviceFailed)
+	fake.SetOutcome(archertest.Outcome{Reject: "unknown primer scheme"})
+	addRun("refused run")
+	if err := tmp.AnnounceSamples(); err == nil || !strings.Contains(err.Error(), "unknown primer scheme") {
+		t.Fatalf("expected the refused request to fail the announcement, got: %v", err)
+	}
+	waitForRun(t, tmp, "refused run", records.Status_announceFailed)
+	RequestTimeout = 100 * time.Millisecond
+	fake.SetOutcome(archertest.Outcome{Delay: time.Second})
+	addRun("slow run")
+	if err := tmp.AnnounceSamples(); err == nil {
+		t.Fatal("expected the slow request to fail the announcement")
+	}
+	waitForRun(t, tmp, "slow run", records.Status_announceFailed)
+	if len(fake.Requests()) != 4 || tmp.GetFailedCount("runs") != 3 || tmp.GetAnnouncementQueueSize() != 0 {
+		t.Fatalf("unexpected outcome of the failed requests (%d requests, %d failed runs)", len(fake.Requests()), tmp.GetFailedCount("runs"))
+	}
+
+	// check a run is held while Archer is offline and sent once it is back
+	address := fake.Address()
+	fake.Stop()
+	tmp.health.CheckNow()
+	waitForHealth(t, tmp, "Archer upload", services.HealthDown)
+	addRun("offline run")
+	if err := tmp.AnnounceSamples(); err == nil {
+		t.Fatal("announcement to an offline Archer did not report the hold")
+	}
+	if item := tmp.announcementQueue.Get(records.RecordType_run, "offline run"); item == nil || item.GetAttempts() != 0 {
+		t.Fatalf("held announcement is incorrect: %v", item)
+	}
+	if fake, err = archertest.NewServer(address); err != nil {
+		t.Fatal(err)
+	}
+	tmp.health.CheckNow()
+	waitForQueue(t, tmp, 0)
+	waitForRun(t, tmp, "offline run", records.Status_tagsComplete)
+	if requests := fake.Requests(); len(requests) != 1 || requests[0].GetSampleID() != "offline run" {
+		t.Fatalf("unexpected archer requests once back online: %v", requests)
+	}
+}
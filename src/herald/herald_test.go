@@ -3,6 +3,9 @@ package herald
 import (
 	"os"
 	"testing"
+
+	"github.com/will-rowe/herald/src/config"
+	"github.com/will-rowe/herald/src/services"
 )
 
 // TestHerald
@@ -16,9 +19,25 @@ func TestHerald(t *testing.T) {
 
 	// create and add a run
 	testExpName := "test run"
-	if err := tmp.AddRun(testExpName, "/tmp", "/tmp/fast5_pass", "/tmp/fastq_pass", "", "", []string{"sequence", "basecall"}, false); err != nil {
+	if err := tmp.AddRun(testExpName, "/tmp", "/tmp/fast5_pass", "/tmp/fastq_pass", "", 0, "EXP-NBD104", "X1", "FLO-MIN106", 48, "", []string{"sequence", "basecall"}, false); err != nil {
 		t.Fatal(err)
 	}
+	if run, err := tmp.store.GetRun(testExpName); err != nil || run.GetMinknowPosition() != "X1" || run.GetFlowCellType() != "FLO-MIN106" || run.GetRunHours() != 48 {
+		t.Fatalf("MinKNOW details were not kept on the run: %v", err)
+	}
+
+	// check an unknown flow cell or a negative run length is rejected
+	if err := tmp.AddRun("bad flow cell run", "/tmp", "/tmp/fast5_pass", "/tmp/fastq_pass", "scov2", 3, "", "", "FLO-XXX000", 0, "", nil, false); err == nil {
+		t.Fatal("AddRun accepted an unknown flow cell type")
+	}
+	if err := tmp.AddRun("bad hours run", "/tmp", "/tmp/fast5_pass", "/tmp/fastq_pass", "scov2", 3, "", "", "FLO-MIN106", -1, "", nil, false); err == nil {
+		t.Fatal("AddRun accepted a negative run length")
+	}
+
+	// check an unknown primer scheme version is rejected
+	if err := tmp.AddRun("bad scheme run", "/tmp", "/tmp/fast5_pass", "/tmp/fastq_pass", "scov2", 999, "", "", "", 0, "", nil, false); err == nil {
+		t.Fatal("AddRun accepted a primer scheme version that is not in the manifest")
+	}
 
 	// check runtime info was updated
 	expCount := tmp.GetRunCount()
@@ -35,6 +54,29 @@ func TestHerald(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// check a used or out of range barcode is rejected
+	if err := tmp.CreateSample("duplicate barcode sample", testExpName, 1, "", nil); err == nil {
+		t.Fatal("CreateSample accepted a barcode that is already used in the run")
+	}
+	if err := tmp.CreateSample("out of range barcode sample", testExpName, 13, "", nil); err == nil {
+		t.Fatal("CreateSample accepted a barcode that is not in the run's barcode kit")
+	}
+
+	// check user comments can be added to the sample and filtered from the history
+	if err := tmp.AddComment("sample", testSampleLabel, "another comment", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.AddComment("sample", testSampleLabel, "bad attachment", []string{"/badPath/to/nowhere"}); err == nil {
+		t.Fatal("AddComment accepted an attachment that does not exist")
+	}
+	userComments, err := tmp.GetHistory("sample", testSampleLabel, "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(userComments) != 2 {
+		t.Fatalf("expected 2 user comments in sample history, got %d", len(userComments))
+	}
+
 	// check runtime info was updated
 	sampleCount := tmp.GetSampleCount()
 	if sampleCount != 1 {
@@ -74,6 +116,106 @@ func TestHerald(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// check nothing is announced once Herald has stopped
+	if err := tmp.AnnounceSamples(); err != ErrStopping {
+		t.Fatalf("expected announcing to be refused after Destroy, got: %v", err)
+	}
+
 	// clean up
 	os.RemoveAll("./tmp/")
 }
+
+// TestConfigServices checks the services in the config are registered while Herald is running
+func TestConfigServices(t *testing.T) {
+	defer os.RemoveAll("./tmp_commands")
+
+	// add some services to the config
+	tmp, err := InitHerald("./tmp_commands")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp.config.CommandServices = []*config.CommandService{
+		{Name: "test pipeline", RecordType: "sample", Command: "echo {label}", OutputDirectory: "./tmp_commands/output"},
+	}
+	tmp.config.WebhookServices = []*config.WebhookService{
+		{Name: "test webhook", RecordType: "run", Url: "http://127.0.0.1:8080/herald", Headers: map[string]string{"X-Api-Key": "abc"}},
+	}
+	tmp.config.TransferServices = []*config.TransferService{
+		{Name: "test transfer", Destination: "./tmp_commands/storage"},
+	}
+	if err := tmp.config.Write(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+
+	// check they are registered on the next start and removed when Herald stops
+	if tmp, err = InitHerald("./tmp_commands"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"test pipeline", "test webhook", "test transfer"} {
+		if _, ok := services.GetService(name); !ok {
+			t.Fatalf("%v service was not registered", name)
+		}
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"test pipeline", "test webhook", "test transfer"} {
+		if _, ok := services.GetService(name); ok {
+			t.Fatalf("%v service was not deregistered", name)
+		}
+	}
+
+	// check a service with an unsupported record type is rejected
+	if _, err := getServiceRecordType("bad pipeline", "experiment"); err == nil {
+		t.Fatal("unsupported record type was accepted")
+	}
+}
+
+// TestServiceConnections checks the connection settings in the config are applied to the gRPC services
+func TestServiceConnections(t *testing.T) {
+	defer os.RemoveAll("./tmp_connections")
+	tmp, err := InitHerald("./tmp_connections")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp.config.ServiceConnections = map[string]*config.ServiceConnection{
+		"archer upload": {Address: "127.0.0.1:1234", Token: "abc"},
+	}
+	if err := tmp.config.Write(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+	if tmp, err = InitHerald("./tmp_connections"); err != nil {
+		t.Fatal(err)
+	}
+	archer, ok := services.GetService("Archer upload")
+	if !ok {
+		t.Fatal("archer service was not registered")
+	}
+	if address := archer.GetAddress(); address != "127.0.0.1:1234" {
+		t.Fatalf("connection settings were not applied: %v", address)
+	}
+
+	// check settings for other services are rejected, and the defaults are used once the settings are removed
+	tmp.config.ServiceConnections = map[string]*config.ServiceConnection{
+		"missing service": {Address: "127.0.0.1:1234"},
+	}
+	if err := tmp.setServiceConnections(); err == nil {
+		t.Fatal("connection settings were accepted for an unknown service")
+	}
+	tmp.config.ServiceConnections = nil
+	if err := tmp.setServiceConnections(); err != nil {
+		t.Fatal(err)
+	}
+	if address := archer.GetAddress(); address != "127.0.0.1:60742" {
+		t.Fatalf("default connection was not restored: %v", address)
+	}
+	if err := tmp.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,115 @@
+package herald
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	// DefaultServiceConcurrency is the number of requests that can be in flight to a service at once, unless set in the config.
+	DefaultServiceConcurrency = 2
+
+	// DefaultServiceRateLimit is the maximum number of requests per second to a service, unless set in the config (0 = unlimited).
+	DefaultServiceRateLimit = 0.0
+)
+
+// servicePool is a pool of workers that send
+// requests to a single service, limiting how
+// many are in flight and how often they start.
+type servicePool struct {
+	name     string        // the name of the service
+	jobs     chan func()   // the requests waiting for a worker
+	interval time.Duration // the minimum time between requests starting (0 = unlimited)
+	wg       sync.WaitGroup
+
+	sync.Mutex
+	next time.Time // the earliest time the next request can start
+}
+
+// newServicePool will start a pool of workers
+// for a service. A rate limit of 0 or less
+// means requests are not rate limited.
+func newServicePool(name string, concurrency int, rateLimit float64) *servicePool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	pool := &servicePool{
+		name: name,
+		jobs: make(chan func()),
+	}
+	if rateLimit > 0 {
+		pool.interval = time.Duration(float64(time.Second) / rateLimit)
+	}
+	pool.wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer pool.wg.Done()
+			for job := range pool.jobs {
+				pool.wait()
+				job()
+			}
+		}()
+	}
+	return pool
+}
+
+// submit will queue a job for the pool, blocking
+// until a worker is free to take it.
+func (pool *servicePool) submit(job func()) {
+	pool.jobs <- job
+}
+
+// stop will stop the workers once they have
+// finished their current jobs.
+func (pool *servicePool) stop() {
+	close(pool.jobs)
+	pool.wg.Wait()
+}
+
+// wait will block until the rate limit
+// allows the next request to start.
+func (pool *servicePool) wait() {
+	if pool.interval == 0 {
+		return
+	}
+	pool.Lock()
+	now := time.Now()
+	if pool.next.Before(now) {
+		pool.next = now
+	}
+	delay := pool.next.Sub(now)
+	pool.next = pool.next.Add(pool.interval)
+	pool.Unlock()
+	time.Sleep(delay)
+}
+
+// getPool returns the worker pool for a service,
+// starting one with the limits from the config
+// if needed.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) getPool(serviceName string) *servicePool {
+	if pool, ok := herald.pools[serviceName]; ok {
+		return pool
+	}
+	concurrency, rateLimit := DefaultServiceConcurrency, DefaultServiceRateLimit
+	if limits := herald.config.GetServiceLimit(serviceName); limits != nil {
+		if limits.GetConcurrency() > 0 {
+			concurrency = int(limits.GetConcurrency())
+		}
+		rateLimit = limits.GetRateLimit()
+	}
+	pool := newServicePool(serviceName, concurrency, rateLimit)
+	herald.pools[serviceName] = pool
+	return pool
+}
+
+// stopPools will stop all the service worker pools.
+//
+// NOTE: the caller must hold the Herald lock
+func (herald *Herald) stopPools() {
+	for name, pool := range herald.pools {
+		pool.stop()
+		delete(herald.pools, name)
+	}
+}
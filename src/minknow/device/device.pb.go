@@ -0,0 +1,2346 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: minknow/rpc/device.proto
+
+package device
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/will-rowe/herald/src/minknow/rpc"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetDeviceInfoResponse_DeviceType int32
+
+const (
+	GetDeviceInfoResponse_MINION          GetDeviceInfoResponse_DeviceType = 0
+	GetDeviceInfoResponse_PROMETHION      GetDeviceInfoResponse_DeviceType = 1 // Deprecated - will never be returned by minknow
+	GetDeviceInfoResponse_GRIDION         GetDeviceInfoResponse_DeviceType = 2
+	GetDeviceInfoResponse_PROMETHION_BETA GetDeviceInfoResponse_DeviceType = 3
+	GetDeviceInfoResponse_MINION_MK1C     GetDeviceInfoResponse_DeviceType = 4
+)
+
+// Enum value maps for GetDeviceInfoResponse_DeviceType.
+var (
+	GetDeviceInfoResponse_DeviceType_name = map[int32]string{
+		0: "MINION",
+		1: "PROMETHION",
+		2: "GRIDION",
+		3: "PROMETHION_BETA",
+		4: "MINION_MK1C",
+	}
+	GetDeviceInfoResponse_DeviceType_value = map[string]int32{
+		"MINION":          0,
+		"PROMETHION":      1,
+		"GRIDION":         2,
+		"PROMETHION_BETA": 3,
+		"MINION_MK1C":     4,
+	}
+)
+
+func (x GetDeviceInfoResponse_DeviceType) Enum() *GetDeviceInfoResponse_DeviceType {
+	p := new(GetDeviceInfoResponse_DeviceType)
+	*p = x
+	return p
+}
+
+func (x GetDeviceInfoResponse_DeviceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetDeviceInfoResponse_DeviceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_minknow_rpc_device_proto_enumTypes[0].Descriptor()
+}
+
+func (GetDeviceInfoResponse_DeviceType) Type() protoreflect.EnumType {
+	return &file_minknow_rpc_device_proto_enumTypes[0]
+}
+
+func (x GetDeviceInfoResponse_DeviceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetDeviceInfoResponse_DeviceType.Descriptor instead.
+func (GetDeviceInfoResponse_DeviceType) EnumDescriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type GetDeviceStateResponse_DeviceState int32
+
+const (
+	GetDeviceStateResponse_DEVICE_DISCONNECTED GetDeviceStateResponse_DeviceState = 0
+	GetDeviceStateResponse_DEVICE_READY        GetDeviceStateResponse_DeviceState = 1
+)
+
+// Enum value maps for GetDeviceStateResponse_DeviceState.
+var (
+	GetDeviceStateResponse_DeviceState_name = map[int32]string{
+		0: "DEVICE_DISCONNECTED",
+		1: "DEVICE_READY",
+	}
+	GetDeviceStateResponse_DeviceState_value = map[string]int32{
+		"DEVICE_DISCONNECTED": 0,
+		"DEVICE_READY":        1,
+	}
+)
+
+func (x GetDeviceStateResponse_DeviceState) Enum() *GetDeviceStateResponse_DeviceState {
+	p := new(GetDeviceStateResponse_DeviceState)
+	*p = x
+	return p
+}
+
+func (x GetDeviceStateResponse_DeviceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetDeviceStateResponse_DeviceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_minknow_rpc_device_proto_enumTypes[1].Descriptor()
+}
+
+func (GetDeviceStateResponse_DeviceState) Type() protoreflect.EnumType {
+	return &file_minknow_rpc_device_proto_enumTypes[1]
+}
+
+func (x GetDeviceStateResponse_DeviceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetDeviceStateResponse_DeviceState.Descriptor instead.
+func (GetDeviceStateResponse_DeviceState) EnumDescriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{4, 0}
+}
+
+// Describes the configuration of a channel on the device.
+//
+// Note that this is a lossy representation. The device-specific APIs provide more precise
+// information. This only describes common configurations, and omits anything that doesn't impact
+// the received signal.
+type ChannelConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The currently-connected well.
+	//
+	// Wells are counted from 1. 0 indicates that no well is connected. 5 indicates some non-generic configuration
+	// such as ground for a minion or connecting all wells on promethion
+	//
+	// Note that MinKNOW can return channel configurations where the well number is larger than the
+	// ``max_well_count`` value returned by :meth:`DeviceService.get_device_info`. This indicates
+	// that some other connection has been made (for example, PromethIONs can simultaneously
+	// connect all wells, and MinIONs can connect to ground).
+	Well uint32 `protobuf:"varint,1,opt,name=well,proto3" json:"well,omitempty"`
+	// Whether the test current is connected to the integrator (measurement circuit).
+	//
+	// The signal will be a steady test current produced on the device. This can be used for
+	// calibration or to test the device integration circuits.
+	TestCurrent bool `protobuf:"varint,2,opt,name=test_current,json=testCurrent,proto3" json:"test_current,omitempty"`
+	// Please DO NOT USE - does not have a practical use, will be removed in later versions.
+	// Whether the regeneration current is connected to the integrator (measurement circuit).
+	//
+	// This is similar to unblock, but uses a different circuit. It is not available on MinION or
+	// GridION devices.
+	Regeneration bool `protobuf:"varint,3,opt,name=regeneration,proto3" json:"regeneration,omitempty"`
+	// Whether the unblock voltage is connected to the integrator (measurement circuit).
+	//
+	// Provides a reverse potential across the connected well. This can be used to drive molecules
+	// back out of the well.
+	Unblock bool `protobuf:"varint,4,opt,name=unblock,proto3" json:"unblock,omitempty"`
+}
+
+func (x *ChannelConfiguration) Reset() {
+	*x = ChannelConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfiguration) ProtoMessage() {}
+
+func (x *ChannelConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfiguration.ProtoReflect.Descriptor instead.
+func (*ChannelConfiguration) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChannelConfiguration) GetWell() uint32 {
+	if x != nil {
+		return x.Well
+	}
+	return 0
+}
+
+func (x *ChannelConfiguration) GetTestCurrent() bool {
+	if x != nil {
+		return x.TestCurrent
+	}
+	return false
+}
+
+func (x *ChannelConfiguration) GetRegeneration() bool {
+	if x != nil {
+		return x.Regeneration
+	}
+	return false
+}
+
+func (x *ChannelConfiguration) GetUnblock() bool {
+	if x != nil {
+		return x.Unblock
+	}
+	return false
+}
+
+type GetDeviceInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDeviceInfoRequest) Reset() {
+	*x = GetDeviceInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceInfoRequest) ProtoMessage() {}
+
+func (x *GetDeviceInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{1}
+}
+
+type GetDeviceInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique identifier for the device.
+	//
+	// This is the identifier of the device MinKNOW was started for. It will only communicate
+	// with this device.
+	//
+	// Note that simulated device IDs are only unique for this host, not globally.
+	//
+	// This value will be set even if the device is not currently connected (assuming MinKNOW
+	// was started by the manager service).
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// The type of the device.
+	DeviceType GetDeviceInfoResponse_DeviceType `protobuf:"varint,2,opt,name=device_type,json=deviceType,proto3,enum=ont.rpc.device.GetDeviceInfoResponse_DeviceType" json:"device_type,omitempty"`
+	// Whether the device is simulated.
+	//
+	// If this is true, there is no physical device - MinKNOW is simluating it. If it is false,
+	// MinKNOW will be acquiring data from a real device.
+	IsSimulated bool `protobuf:"varint,3,opt,name=is_simulated,json=isSimulated,proto3" json:"is_simulated,omitempty"`
+	// The maximum number of channels supported by the device.
+	//
+	// Each channel provides a signal from the device. For example, a MinION supports up to 512
+	// channels, and so can provide 512 simultaneous streams of data.
+	//
+	// This value is fixed for a given device type. Note, however, that a flow cell might be attached
+	// that has a smaller number of channels.
+	MaxChannelCount uint32 `protobuf:"varint,4,opt,name=max_channel_count,json=maxChannelCount,proto3" json:"max_channel_count,omitempty"`
+	// The maximum number of wells connected to each channel.
+	//
+	// A well is a discrete location on the device where sensing can take place. Normally, each well
+	// should have a single nanopore in it.
+	//
+	// For example, a MinION supports up to 4 wells per channel, allowing for 2048 wells in total.
+	// So the value of this for a MinION will be 4.
+	//
+	// This value is fixed for a given device type. Note, however, that a flow cell might be attached
+	// that has a smaller number of wells on each channel.
+	MaxWellsPerChannel uint32 `protobuf:"varint,5,opt,name=max_wells_per_channel,json=maxWellsPerChannel,proto3" json:"max_wells_per_channel,omitempty"`
+	// Whether the set_temperature() method can be expected to work.
+	//
+	// On some systems, not all connected devices have the ability to control their own temperature,
+	// as temperature controls are managed in groups. If this field is true, this device can control
+	// its own temperature. If it it false, it cannot, and the temperature will be maintained at a
+	// pre-determined temperature.
+	CanSetTemperature bool `protobuf:"varint,6,opt,name=can_set_temperature,json=canSetTemperature,proto3" json:"can_set_temperature,omitempty"`
+	// The range of uncalibrated data values.
+	//
+	// This is the number of distinct signal values that can be produced by the device's analog to
+	// digital converter (ADC).
+	Digitisation uint32 `protobuf:"varint,7,opt,name=digitisation,proto3" json:"digitisation,omitempty"`
+	// If this is true then the physical location of the device is described by the location_index
+	// field below. Examples of hardware that will know their physical locations are the devices in
+	// GridIONs and PromethIONs. This is false for MinIONs as these devices are not constrained
+	// to physical location.
+	LocationDefined bool `protobuf:"varint,8,opt,name=location_defined,json=locationDefined,proto3" json:"location_defined,omitempty"`
+	// An index that describes the position of the device in a linear order of devices (from
+	// 0 onwards.)
+	LocationIndex   uint32                                    `protobuf:"varint,9,opt,name=location_index,json=locationIndex,proto3" json:"location_index,omitempty"`
+	FirmwareVersion []*GetDeviceInfoResponse_ComponentVersion `protobuf:"bytes,10,rep,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
+}
+
+func (x *GetDeviceInfoResponse) Reset() {
+	*x = GetDeviceInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceInfoResponse) ProtoMessage() {}
+
+func (x *GetDeviceInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetDeviceInfoResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeviceInfoResponse) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *GetDeviceInfoResponse) GetDeviceType() GetDeviceInfoResponse_DeviceType {
+	if x != nil {
+		return x.DeviceType
+	}
+	return GetDeviceInfoResponse_MINION
+}
+
+func (x *GetDeviceInfoResponse) GetIsSimulated() bool {
+	if x != nil {
+		return x.IsSimulated
+	}
+	return false
+}
+
+func (x *GetDeviceInfoResponse) GetMaxChannelCount() uint32 {
+	if x != nil {
+		return x.MaxChannelCount
+	}
+	return 0
+}
+
+func (x *GetDeviceInfoResponse) GetMaxWellsPerChannel() uint32 {
+	if x != nil {
+		return x.MaxWellsPerChannel
+	}
+	return 0
+}
+
+func (x *GetDeviceInfoResponse) GetCanSetTemperature() bool {
+	if x != nil {
+		return x.CanSetTemperature
+	}
+	return false
+}
+
+func (x *GetDeviceInfoResponse) GetDigitisation() uint32 {
+	if x != nil {
+		return x.Digitisation
+	}
+	return 0
+}
+
+func (x *GetDeviceInfoResponse) GetLocationDefined() bool {
+	if x != nil {
+		return x.LocationDefined
+	}
+	return false
+}
+
+func (x *GetDeviceInfoResponse) GetLocationIndex() uint32 {
+	if x != nil {
+		return x.LocationIndex
+	}
+	return 0
+}
+
+func (x *GetDeviceInfoResponse) GetFirmwareVersion() []*GetDeviceInfoResponse_ComponentVersion {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return nil
+}
+
+type GetDeviceStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDeviceStateRequest) Reset() {
+	*x = GetDeviceStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceStateRequest) ProtoMessage() {}
+
+func (x *GetDeviceStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceStateRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceStateRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{3}
+}
+
+type GetDeviceStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceState GetDeviceStateResponse_DeviceState `protobuf:"varint,1,opt,name=device_state,json=deviceState,proto3,enum=ont.rpc.device.GetDeviceStateResponse_DeviceState" json:"device_state,omitempty"`
+}
+
+func (x *GetDeviceStateResponse) Reset() {
+	*x = GetDeviceStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceStateResponse) ProtoMessage() {}
+
+func (x *GetDeviceStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceStateResponse.ProtoReflect.Descriptor instead.
+func (*GetDeviceStateResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDeviceStateResponse) GetDeviceState() GetDeviceStateResponse_DeviceState {
+	if x != nil {
+		return x.DeviceState
+	}
+	return GetDeviceStateResponse_DEVICE_DISCONNECTED
+}
+
+type StreamDeviceStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamDeviceStateRequest) Reset() {
+	*x = StreamDeviceStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamDeviceStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDeviceStateRequest) ProtoMessage() {}
+
+func (x *StreamDeviceStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDeviceStateRequest.ProtoReflect.Descriptor instead.
+func (*StreamDeviceStateRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{5}
+}
+
+type GetFlowCellInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetFlowCellInfoRequest) Reset() {
+	*x = GetFlowCellInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFlowCellInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlowCellInfoRequest) ProtoMessage() {}
+
+func (x *GetFlowCellInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlowCellInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetFlowCellInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{6}
+}
+
+type GetFlowCellInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether there is a flow cell present.
+	//
+	// If both this and has_adapter are false, none of the other fields will contain useful
+	// information.
+	HasFlowCell bool `protobuf:"varint,1,opt,name=has_flow_cell,json=hasFlowCell,proto3" json:"has_flow_cell,omitempty"`
+	// The number of channels currently supported by the device.
+	//
+	// This may be smaller than the ``max_channel_count`` value returned by get_device_info(),
+	// depending on the attached flow cell.
+	ChannelCount uint32 `protobuf:"varint,2,opt,name=channel_count,json=channelCount,proto3" json:"channel_count,omitempty"`
+	// The number of wells currently supported by the device.
+	//
+	// This may be smaller than the ``max_wells_per_channel`` value returned by get_device_info(),
+	// depending on the attached flow cell.
+	WellsPerChannel uint32 `protobuf:"varint,3,opt,name=wells_per_channel,json=wellsPerChannel,proto3" json:"wells_per_channel,omitempty"`
+	// The unique identifier for the flow cell.
+	//
+	// This should be same as the value printed onto the flow cell casing. Not all flow cells will
+	// have the flow cell ID recorded in a way that MinKNOW can read. In those cases, this will be an
+	// empty string, even though the user may be able to see a printed identifier on the flow cell.
+	FlowCellId string `protobuf:"bytes,4,opt,name=flow_cell_id,json=flowCellId,proto3" json:"flow_cell_id,omitempty"`
+	// The unique identifier for the ASIC.
+	//
+	// Note: This member is deprecated in favour of asic_id_str - it does not work for PromethION
+	//       asics, and will be removed in future.
+	//
+	// This is a numerical value uniquely identifying the ASIC embedded in the flow cell. It will
+	// always be set when a flow cell is present.
+	//
+	// The intended use for this is to track whether the flow cell has been replaced between calls to
+	// get_flow_cell_info(). Any other use is unsupported (see the caveats below).
+	//
+	// Caveats:
+	//
+	// * This value will not mean anything to an end-user.
+	// * There is no guarantee that this field will contain the same numerical identifier across
+	//   MinKNOW versions, even with the same flow cell.
+	// * An ASIC can be recycled into a new flow cell, so this identifier may be re-used in the
+	//   future (although it can be considered unique for the duration of a protocol run).
+	AsicId uint32 `protobuf:"varint,5,opt,name=asic_id,json=asicId,proto3" json:"asic_id,omitempty"`
+	// The unique identifier for the ASIC (formatted as string).
+	//
+	// This is a value uniquely identifying the ASIC embedded in the flow cell. It will
+	// always be set when a flow cell is present.
+	//
+	// The intended use for this is to track the identity of the asic in the flow cell, eg. for
+	// displaying to users during production of chips.
+	//
+	// Caveats:
+	//
+	// * This value will not mean anything to a customer.
+	// * There is no guarantee that this field will contain the same identifier across
+	//   MinKNOW versions, even with the same flow cell.
+	// * An ASIC can be recycled into a new flow cell, so this identifier may be re-used in the
+	//   future (although it can be considered unique for the duration of a protocol run).
+	AsicIdStr string `protobuf:"bytes,13,opt,name=asic_id_str,json=asicIdStr,proto3" json:"asic_id_str,omitempty"`
+	// The product code for the flow cell.
+	//
+	// This should be the code displayed in the shop where the flow cell was bought. Not all
+	// flow cells will have the product code recorded in a way that MinKNOW can read. In those cases,
+	// this will be an empty string.
+	ProductCode string `protobuf:"bytes,6,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	// A unique identifier for the flow cell, which the user can specify.
+	//
+	// In the event a flow cell does not have an eeprom, this field contains data input by the user
+	// using set_flow_cell_info to record required data.
+	//
+	// Only alpha-numeric, space, dash and underscore characters are allowed in this field.
+	//
+	// Since 1.12
+	UserSpecifiedFlowCellId string `protobuf:"bytes,7,opt,name=user_specified_flow_cell_id,json=userSpecifiedFlowCellId,proto3" json:"user_specified_flow_cell_id,omitempty"`
+	// A product code for the flow cell, which the user can specify.
+	//
+	// This should be the code displayed in the shop where the flow cell was bought. Not all
+	// flow cells have an eeprom, in the event they don't, the user can specify using this id using
+	// set_flow_cell_info.
+	//
+	// Since 1.12
+	UserSpecifiedProductCode string `protobuf:"bytes,8,opt,name=user_specified_product_code,json=userSpecifiedProductCode,proto3" json:"user_specified_product_code,omitempty"`
+	// Whether there is a flongle adapter.
+	//
+	// If this is true, the adapter_id, channel_count and wells_per_channel fields should all
+	// provide useful information, even if has_flow_cell is false.
+	HasAdapter bool `protobuf:"varint,9,opt,name=has_adapter,json=hasAdapter,proto3" json:"has_adapter,omitempty"`
+	// The unique identifier of an attached flongle adatper.
+	//
+	// This should be same as the value printed onto the adapter casing.
+	AdapterId string `protobuf:"bytes,10,opt,name=adapter_id,json=adapterId,proto3" json:"adapter_id,omitempty"`
+	// Used to make the field nullable, since the null value is not protobuf's default.
+	//
+	// Types that are assignable to TemperatureOffsetNullable:
+	//	*GetFlowCellInfoResponse_TemperatureOffset
+	TemperatureOffsetNullable isGetFlowCellInfoResponse_TemperatureOffsetNullable `protobuf_oneof:"temperature_offset_nullable"`
+	// The version of the ASIC contained in the flowcell (if specified by the hardware).
+	//
+	// This can be used to determine if the hardware should support certain features
+	// added to only newer ASICs.
+	//
+	// Possible values include: "IA02C", "IA02D", or if the value is not readable on the
+	// current hardware an empty string is returned.
+	//
+	// Since 1.14
+	AsicVersion string `protobuf:"bytes,12,opt,name=asic_version,json=asicVersion,proto3" json:"asic_version,omitempty"`
+}
+
+func (x *GetFlowCellInfoResponse) Reset() {
+	*x = GetFlowCellInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFlowCellInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlowCellInfoResponse) ProtoMessage() {}
+
+func (x *GetFlowCellInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlowCellInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetFlowCellInfoResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFlowCellInfoResponse) GetHasFlowCell() bool {
+	if x != nil {
+		return x.HasFlowCell
+	}
+	return false
+}
+
+func (x *GetFlowCellInfoResponse) GetChannelCount() uint32 {
+	if x != nil {
+		return x.ChannelCount
+	}
+	return 0
+}
+
+func (x *GetFlowCellInfoResponse) GetWellsPerChannel() uint32 {
+	if x != nil {
+		return x.WellsPerChannel
+	}
+	return 0
+}
+
+func (x *GetFlowCellInfoResponse) GetFlowCellId() string {
+	if x != nil {
+		return x.FlowCellId
+	}
+	return ""
+}
+
+func (x *GetFlowCellInfoResponse) GetAsicId() uint32 {
+	if x != nil {
+		return x.AsicId
+	}
+	return 0
+}
+
+func (x *GetFlowCellInfoResponse) GetAsicIdStr() string {
+	if x != nil {
+		return x.AsicIdStr
+	}
+	return ""
+}
+
+func (x *GetFlowCellInfoResponse) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *GetFlowCellInfoResponse) GetUserSpecifiedFlowCellId() string {
+	if x != nil {
+		return x.UserSpecifiedFlowCellId
+	}
+	return ""
+}
+
+func (x *GetFlowCellInfoResponse) GetUserSpecifiedProductCode() string {
+	if x != nil {
+		return x.UserSpecifiedProductCode
+	}
+	return ""
+}
+
+func (x *GetFlowCellInfoResponse) GetHasAdapter() bool {
+	if x != nil {
+		return x.HasAdapter
+	}
+	return false
+}
+
+func (x *GetFlowCellInfoResponse) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+func (m *GetFlowCellInfoResponse) GetTemperatureOffsetNullable() isGetFlowCellInfoResponse_TemperatureOffsetNullable {
+	if m != nil {
+		return m.TemperatureOffsetNullable
+	}
+	return nil
+}
+
+func (x *GetFlowCellInfoResponse) GetTemperatureOffset() float32 {
+	if x, ok := x.GetTemperatureOffsetNullable().(*GetFlowCellInfoResponse_TemperatureOffset); ok {
+		return x.TemperatureOffset
+	}
+	return 0
+}
+
+func (x *GetFlowCellInfoResponse) GetAsicVersion() string {
+	if x != nil {
+		return x.AsicVersion
+	}
+	return ""
+}
+
+type isGetFlowCellInfoResponse_TemperatureOffsetNullable interface {
+	isGetFlowCellInfoResponse_TemperatureOffsetNullable()
+}
+
+type GetFlowCellInfoResponse_TemperatureOffset struct {
+	// The temperature offset for the flow cell, returned as an offset in degrees Celsius.
+	//
+	// This offset specifies a correction to be applied to ASIC temperature.
+	// If multiple ASIC temperatures are available from the device, applies to the mean temperature.
+	//
+	// Since 1.14
+	TemperatureOffset float32 `protobuf:"fixed32,11,opt,name=temperature_offset,json=temperatureOffset,proto3,oneof"`
+}
+
+func (*GetFlowCellInfoResponse_TemperatureOffset) isGetFlowCellInfoResponse_TemperatureOffsetNullable() {
+}
+
+type StreamFlowCellInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamFlowCellInfoRequest) Reset() {
+	*x = StreamFlowCellInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamFlowCellInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFlowCellInfoRequest) ProtoMessage() {}
+
+func (x *StreamFlowCellInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFlowCellInfoRequest.ProtoReflect.Descriptor instead.
+func (*StreamFlowCellInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{8}
+}
+
+type SetUserSpecifiedFlowCellIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique identifier for the flow cell, which the user can specify.
+	//
+	// In the event a flow cell does not have an eeprom, this field can be used by the user
+	// to record their flow_cell_id.
+	//
+	// Since 1.12
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SetUserSpecifiedFlowCellIdRequest) Reset() {
+	*x = SetUserSpecifiedFlowCellIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserSpecifiedFlowCellIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSpecifiedFlowCellIdRequest) ProtoMessage() {}
+
+func (x *SetUserSpecifiedFlowCellIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSpecifiedFlowCellIdRequest.ProtoReflect.Descriptor instead.
+func (*SetUserSpecifiedFlowCellIdRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetUserSpecifiedFlowCellIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SetUserSpecifiedFlowCellIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserSpecifiedFlowCellIdResponse) Reset() {
+	*x = SetUserSpecifiedFlowCellIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserSpecifiedFlowCellIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSpecifiedFlowCellIdResponse) ProtoMessage() {}
+
+func (x *SetUserSpecifiedFlowCellIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSpecifiedFlowCellIdResponse.ProtoReflect.Descriptor instead.
+func (*SetUserSpecifiedFlowCellIdResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{10}
+}
+
+type SetUserSpecifiedProductCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A product code for the flow cell, which the user can specify.
+	//
+	// In the event a flow cell does not have an eeprom, the user can specify product code here.
+	//
+	// Since 1.12
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *SetUserSpecifiedProductCodeRequest) Reset() {
+	*x = SetUserSpecifiedProductCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserSpecifiedProductCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSpecifiedProductCodeRequest) ProtoMessage() {}
+
+func (x *SetUserSpecifiedProductCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSpecifiedProductCodeRequest.ProtoReflect.Descriptor instead.
+func (*SetUserSpecifiedProductCodeRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetUserSpecifiedProductCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type SetUserSpecifiedProductCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserSpecifiedProductCodeResponse) Reset() {
+	*x = SetUserSpecifiedProductCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserSpecifiedProductCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSpecifiedProductCodeResponse) ProtoMessage() {}
+
+func (x *SetUserSpecifiedProductCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSpecifiedProductCodeResponse.ProtoReflect.Descriptor instead.
+func (*SetUserSpecifiedProductCodeResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{12}
+}
+
+type GetChannelsLayoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetChannelsLayoutRequest) Reset() {
+	*x = GetChannelsLayoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelsLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelsLayoutRequest) ProtoMessage() {}
+
+func (x *GetChannelsLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelsLayoutRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelsLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{13}
+}
+
+type GetChannelsLayoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelRecords []*ChannelRecord `protobuf:"bytes,1,rep,name=channel_records,json=channelRecords,proto3" json:"channel_records,omitempty"`
+}
+
+func (x *GetChannelsLayoutResponse) Reset() {
+	*x = GetChannelsLayoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelsLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelsLayoutResponse) ProtoMessage() {}
+
+func (x *GetChannelsLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelsLayoutResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelsLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetChannelsLayoutResponse) GetChannelRecords() []*ChannelRecord {
+	if x != nil {
+		return x.ChannelRecords
+	}
+	return nil
+}
+
+type ChannelRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MuxRecords []*ChannelRecord_MuxRecord `protobuf:"bytes,3,rep,name=mux_records,json=muxRecords,proto3" json:"mux_records,omitempty"`
+}
+
+func (x *ChannelRecord) Reset() {
+	*x = ChannelRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRecord) ProtoMessage() {}
+
+func (x *ChannelRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelRecord.ProtoReflect.Descriptor instead.
+func (*ChannelRecord) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChannelRecord) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChannelRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelRecord) GetMuxRecords() []*ChannelRecord_MuxRecord {
+	if x != nil {
+		return x.MuxRecords
+	}
+	return nil
+}
+
+// Firmware versions of components associated with this device
+//
+// Depending on the hardware, there may be several components associated with
+// this device, each with their own firmware version.
+type GetDeviceInfoResponse_ComponentVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Description of the component that has firmware
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	// The firmware version, if this cannot be determined for a component where
+	// the firmware version would usually be available, this will contain
+	// "Unknown"
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetDeviceInfoResponse_ComponentVersion) Reset() {
+	*x = GetDeviceInfoResponse_ComponentVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceInfoResponse_ComponentVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceInfoResponse_ComponentVersion) ProtoMessage() {}
+
+func (x *GetDeviceInfoResponse_ComponentVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceInfoResponse_ComponentVersion.ProtoReflect.Descriptor instead.
+func (*GetDeviceInfoResponse_ComponentVersion) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *GetDeviceInfoResponse_ComponentVersion) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *GetDeviceInfoResponse_ComponentVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ChannelRecord_MuxRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PhysX uint32 `protobuf:"varint,2,opt,name=phys_x,json=physX,proto3" json:"phys_x,omitempty"`
+	PhysY uint32 `protobuf:"varint,3,opt,name=phys_y,json=physY,proto3" json:"phys_y,omitempty"`
+}
+
+func (x *ChannelRecord_MuxRecord) Reset() {
+	*x = ChannelRecord_MuxRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_device_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelRecord_MuxRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRecord_MuxRecord) ProtoMessage() {}
+
+func (x *ChannelRecord_MuxRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_device_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelRecord_MuxRecord.ProtoReflect.Descriptor instead.
+func (*ChannelRecord_MuxRecord) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_device_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ChannelRecord_MuxRecord) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChannelRecord_MuxRecord) GetPhysX() uint32 {
+	if x != nil {
+		return x.PhysX
+	}
+	return 0
+}
+
+func (x *ChannelRecord_MuxRecord) GetPhysY() uint32 {
+	if x != nil {
+		return x.PhysY
+	}
+	return 0
+}
+
+var File_minknow_rpc_device_proto protoreflect.FileDescriptor
+
+var file_minknow_rpc_device_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1d, 0x6d, 0x69, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x77, 0x65, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x65,
+	0x73, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xbb, 0x05, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f,
+	0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f,
+	0x77, 0x65, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x6c, 0x6c,
+	0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x63,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x53, 0x65, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x64, 0x69, 0x67, 0x69, 0x74, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x61, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x4a, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x5b, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x49, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52,
+	0x4f, 0x4d, 0x45, 0x54, 0x48, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x52,
+	0x49, 0x44, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x4d, 0x45,
+	0x54, 0x48, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x45, 0x54, 0x41, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4d, 0x49, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4b, 0x31, 0x43, 0x10, 0x04, 0x22, 0x17, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x38, 0x0a, 0x0b, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x56, 0x49, 0x43,
+	0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x10, 0x01, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbc, 0x04, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73,
+	0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x77, 0x65, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x77, 0x65, 0x6c, 0x6c, 0x73, 0x50,
+	0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x6c, 0x6f,
+	0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61,
+	0x73, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x73,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x5f,
+	0x73, 0x74, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x69, 0x63, 0x49,
+	0x64, 0x53, 0x74, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63,
+	0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x75, 0x73,
+	0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x43,
+	0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x75, 0x73, 0x65, 0x72,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x41, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x00, 0x52, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x69,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x1d, 0x0a, 0x1b, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6e,
+	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x24, 0x0a, 0x22, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x22, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x25, 0x0a, 0x23, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xc8, 0x01,
+	0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x6d, 0x75, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4d, 0x75, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x0a, 0x6d, 0x75, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x49, 0x0a,
+	0x09, 0x4d, 0x75, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x68,
+	0x79, 0x73, 0x5f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x68, 0x79, 0x73,
+	0x58, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x70, 0x68, 0x79, 0x73, 0x59, 0x32, 0xa7, 0x07, 0x0a, 0x0d, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x67, 0x65,
+	0x74, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e,
+	0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10,
+	0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x67,
+	0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x29, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x8a, 0x01, 0x0a, 0x1f, 0x73, 0x65, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x31, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c,
+	0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8c, 0x01, 0x0a, 0x1f, 0x73, 0x65, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x28, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x77, 0x69, 0x6c, 0x6c, 0x2d, 0x72, 0x6f, 0x77, 0x65, 0x2f, 0x68, 0x65, 0x72, 0x61, 0x6c,
+	0x64, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_minknow_rpc_device_proto_rawDescOnce sync.Once
+	file_minknow_rpc_device_proto_rawDescData = file_minknow_rpc_device_proto_rawDesc
+)
+
+func file_minknow_rpc_device_proto_rawDescGZIP() []byte {
+	file_minknow_rpc_device_proto_rawDescOnce.Do(func() {
+		file_minknow_rpc_device_proto_rawDescData = protoimpl.X.CompressGZIP(file_minknow_rpc_device_proto_rawDescData)
+	})
+	return file_minknow_rpc_device_proto_rawDescData
+}
+
+var file_minknow_rpc_device_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_minknow_rpc_device_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_minknow_rpc_device_proto_goTypes = []interface{}{
+	(GetDeviceInfoResponse_DeviceType)(0),          // 0: ont.rpc.device.GetDeviceInfoResponse.DeviceType
+	(GetDeviceStateResponse_DeviceState)(0),        // 1: ont.rpc.device.GetDeviceStateResponse.DeviceState
+	(*ChannelConfiguration)(nil),                   // 2: ont.rpc.device.ChannelConfiguration
+	(*GetDeviceInfoRequest)(nil),                   // 3: ont.rpc.device.GetDeviceInfoRequest
+	(*GetDeviceInfoResponse)(nil),                  // 4: ont.rpc.device.GetDeviceInfoResponse
+	(*GetDeviceStateRequest)(nil),                  // 5: ont.rpc.device.GetDeviceStateRequest
+	(*GetDeviceStateResponse)(nil),                 // 6: ont.rpc.device.GetDeviceStateResponse
+	(*StreamDeviceStateRequest)(nil),               // 7: ont.rpc.device.StreamDeviceStateRequest
+	(*GetFlowCellInfoRequest)(nil),                 // 8: ont.rpc.device.GetFlowCellInfoRequest
+	(*GetFlowCellInfoResponse)(nil),                // 9: ont.rpc.device.GetFlowCellInfoResponse
+	(*StreamFlowCellInfoRequest)(nil),              // 10: ont.rpc.device.StreamFlowCellInfoRequest
+	(*SetUserSpecifiedFlowCellIdRequest)(nil),      // 11: ont.rpc.device.SetUserSpecifiedFlowCellIdRequest
+	(*SetUserSpecifiedFlowCellIdResponse)(nil),     // 12: ont.rpc.device.SetUserSpecifiedFlowCellIdResponse
+	(*SetUserSpecifiedProductCodeRequest)(nil),     // 13: ont.rpc.device.SetUserSpecifiedProductCodeRequest
+	(*SetUserSpecifiedProductCodeResponse)(nil),    // 14: ont.rpc.device.SetUserSpecifiedProductCodeResponse
+	(*GetChannelsLayoutRequest)(nil),               // 15: ont.rpc.device.GetChannelsLayoutRequest
+	(*GetChannelsLayoutResponse)(nil),              // 16: ont.rpc.device.GetChannelsLayoutResponse
+	(*ChannelRecord)(nil),                          // 17: ont.rpc.device.ChannelRecord
+	(*GetDeviceInfoResponse_ComponentVersion)(nil), // 18: ont.rpc.device.GetDeviceInfoResponse.ComponentVersion
+	(*ChannelRecord_MuxRecord)(nil),                // 19: ont.rpc.device.ChannelRecord.MuxRecord
+}
+var file_minknow_rpc_device_proto_depIdxs = []int32{
+	0,  // 0: ont.rpc.device.GetDeviceInfoResponse.device_type:type_name -> ont.rpc.device.GetDeviceInfoResponse.DeviceType
+	18, // 1: ont.rpc.device.GetDeviceInfoResponse.firmware_version:type_name -> ont.rpc.device.GetDeviceInfoResponse.ComponentVersion
+	1,  // 2: ont.rpc.device.GetDeviceStateResponse.device_state:type_name -> ont.rpc.device.GetDeviceStateResponse.DeviceState
+	17, // 3: ont.rpc.device.GetChannelsLayoutResponse.channel_records:type_name -> ont.rpc.device.ChannelRecord
+	19, // 4: ont.rpc.device.ChannelRecord.mux_records:type_name -> ont.rpc.device.ChannelRecord.MuxRecord
+	3,  // 5: ont.rpc.device.DeviceService.get_device_info:input_type -> ont.rpc.device.GetDeviceInfoRequest
+	5,  // 6: ont.rpc.device.DeviceService.get_device_state:input_type -> ont.rpc.device.GetDeviceStateRequest
+	7,  // 7: ont.rpc.device.DeviceService.stream_device_state:input_type -> ont.rpc.device.StreamDeviceStateRequest
+	8,  // 8: ont.rpc.device.DeviceService.get_flow_cell_info:input_type -> ont.rpc.device.GetFlowCellInfoRequest
+	10, // 9: ont.rpc.device.DeviceService.stream_flow_cell_info:input_type -> ont.rpc.device.StreamFlowCellInfoRequest
+	11, // 10: ont.rpc.device.DeviceService.set_user_specified_flow_cell_id:input_type -> ont.rpc.device.SetUserSpecifiedFlowCellIdRequest
+	13, // 11: ont.rpc.device.DeviceService.set_user_specified_product_code:input_type -> ont.rpc.device.SetUserSpecifiedProductCodeRequest
+	15, // 12: ont.rpc.device.DeviceService.get_channels_layout:input_type -> ont.rpc.device.GetChannelsLayoutRequest
+	4,  // 13: ont.rpc.device.DeviceService.get_device_info:output_type -> ont.rpc.device.GetDeviceInfoResponse
+	6,  // 14: ont.rpc.device.DeviceService.get_device_state:output_type -> ont.rpc.device.GetDeviceStateResponse
+	6,  // 15: ont.rpc.device.DeviceService.stream_device_state:output_type -> ont.rpc.device.GetDeviceStateResponse
+	9,  // 16: ont.rpc.device.DeviceService.get_flow_cell_info:output_type -> ont.rpc.device.GetFlowCellInfoResponse
+	9,  // 17: ont.rpc.device.DeviceService.stream_flow_cell_info:output_type -> ont.rpc.device.GetFlowCellInfoResponse
+	12, // 18: ont.rpc.device.DeviceService.set_user_specified_flow_cell_id:output_type -> ont.rpc.device.SetUserSpecifiedFlowCellIdResponse
+	14, // 19: ont.rpc.device.DeviceService.set_user_specified_product_code:output_type -> ont.rpc.device.SetUserSpecifiedProductCodeResponse
+	16, // 20: ont.rpc.device.DeviceService.get_channels_layout:output_type -> ont.rpc.device.GetChannelsLayoutResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_minknow_rpc_device_proto_init() }
+func file_minknow_rpc_device_proto_init() {
+	if File_minknow_rpc_device_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_minknow_rpc_device_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamDeviceStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFlowCellInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFlowCellInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamFlowCellInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserSpecifiedFlowCellIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserSpecifiedFlowCellIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserSpecifiedProductCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserSpecifiedProductCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelsLayoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelsLayoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceInfoResponse_ComponentVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_device_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelRecord_MuxRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_minknow_rpc_device_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*GetFlowCellInfoResponse_TemperatureOffset)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_minknow_rpc_device_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_minknow_rpc_device_proto_goTypes,
+		DependencyIndexes: file_minknow_rpc_device_proto_depIdxs,
+		EnumInfos:         file_minknow_rpc_device_proto_enumTypes,
+		MessageInfos:      file_minknow_rpc_device_proto_msgTypes,
+	}.Build()
+	File_minknow_rpc_device_proto = out.File
+	file_minknow_rpc_device_proto_rawDesc = nil
+	file_minknow_rpc_device_proto_goTypes = nil
+	file_minknow_rpc_device_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DeviceServiceClient is the client API for DeviceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceServiceClient interface {
+	// Get information about the device this MinKNOW instance was started for.
+	//
+	// In normal circumstances (ie: when using the manager service), a new MinKNOW instance
+	// is started for each available device. This call provides information about this device.
+	//
+	// The information returned by this call will not change (providing the MinKNOW instance
+	// was started by the manager service).
+	GetDeviceInfo(ctx context.Context, in *GetDeviceInfoRequest, opts ...grpc.CallOption) (*GetDeviceInfoResponse, error)
+	// Get information about the current device state.
+	//
+	// Information in this call may change as the device is used with MinKNOW, for example,
+	// by unplugging or plugging in the device.
+	// Since 1.12
+	GetDeviceState(ctx context.Context, in *GetDeviceStateRequest, opts ...grpc.CallOption) (*GetDeviceStateResponse, error)
+	// Streaming version of get_device_state
+	//
+	// Since 1.13
+	StreamDeviceState(ctx context.Context, in *StreamDeviceStateRequest, opts ...grpc.CallOption) (DeviceService_StreamDeviceStateClient, error)
+	// Get information about the flow cell (if any).
+	//
+	// This provides information about the flow_cell attached to the device (described by
+	// get_device_info()), if any.
+	GetFlowCellInfo(ctx context.Context, in *GetFlowCellInfoRequest, opts ...grpc.CallOption) (*GetFlowCellInfoResponse, error)
+	// Streaming version of get_flow_cell_info
+	//
+	// Since 1.13
+	StreamFlowCellInfo(ctx context.Context, in *StreamFlowCellInfoRequest, opts ...grpc.CallOption) (DeviceService_StreamFlowCellInfoClient, error)
+	// Set the user specified flow cell id.
+	//
+	// This changes the user specified flow cell id.
+	// MinKNOW will use this id in place of the id read from the eeprom, if no eeprom data
+	// is available.
+	//
+	// This data is reset when the flow cell is disconnected.
+	//
+	// Since 1.12
+	SetUserSpecifiedFlowCellId(ctx context.Context, in *SetUserSpecifiedFlowCellIdRequest, opts ...grpc.CallOption) (*SetUserSpecifiedFlowCellIdResponse, error)
+	// Set the user specified product code.
+	//
+	// This changes the user specified product code.
+	//
+	// MinKNOW does not use the product code, it is intended for use in MinKNOW's clients.
+	//
+	// This data is reset when the flow cell is disconnected.
+	//
+	// Since 1.12
+	SetUserSpecifiedProductCode(ctx context.Context, in *SetUserSpecifiedProductCodeRequest, opts ...grpc.CallOption) (*SetUserSpecifiedProductCodeResponse, error)
+	// Get information about the channel layout
+	//
+	// Since 1.14
+	GetChannelsLayout(ctx context.Context, in *GetChannelsLayoutRequest, opts ...grpc.CallOption) (*GetChannelsLayoutResponse, error)
+}
+
+type deviceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceServiceClient(cc grpc.ClientConnInterface) DeviceServiceClient {
+	return &deviceServiceClient{cc}
+}
+
+func (c *deviceServiceClient) GetDeviceInfo(ctx context.Context, in *GetDeviceInfoRequest, opts ...grpc.CallOption) (*GetDeviceInfoResponse, error) {
+	out := new(GetDeviceInfoResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.device.DeviceService/get_device_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetDeviceState(ctx context.Context, in *GetDeviceStateRequest, opts ...grpc.CallOption) (*GetDeviceStateResponse, error) {
+	out := new(GetDeviceStateResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.device.DeviceService/get_device_state", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) StreamDeviceState(ctx context.Context, in *StreamDeviceStateRequest, opts ...grpc.CallOption) (DeviceService_StreamDeviceStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[0], "/ont.rpc.device.DeviceService/stream_device_state", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceServiceStreamDeviceStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceService_StreamDeviceStateClient interface {
+	Recv() (*GetDeviceStateResponse, error)
+	grpc.ClientStream
+}
+
+type deviceServiceStreamDeviceStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceServiceStreamDeviceStateClient) Recv() (*GetDeviceStateResponse, error) {
+	m := new(GetDeviceStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceServiceClient) GetFlowCellInfo(ctx context.Context, in *GetFlowCellInfoRequest, opts ...grpc.CallOption) (*GetFlowCellInfoResponse, error) {
+	out := new(GetFlowCellInfoResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.device.DeviceService/get_flow_cell_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) StreamFlowCellInfo(ctx context.Context, in *StreamFlowCellInfoRequest, opts ...grpc.CallOption) (DeviceService_StreamFlowCellInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[1], "/ont.rpc.device.DeviceService/stream_flow_cell_info", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceServiceStreamFlowCellInfoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceService_StreamFlowCellInfoClient interface {
+	Recv() (*GetFlowCellInfoResponse, error)
+	grpc.ClientStream
+}
+
+type deviceServiceStreamFlowCellInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceServiceStreamFlowCellInfoClient) Recv() (*GetFlowCellInfoResponse, error) {
+	m := new(GetFlowCellInfoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceServiceClient) SetUserSpecifiedFlowCellId(ctx context.Context, in *SetUserSpecifiedFlowCellIdRequest, opts ...grpc.CallOption) (*SetUserSpecifiedFlowCellIdResponse, error) {
+	out := new(SetUserSpecifiedFlowCellIdResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.device.DeviceService/set_user_specified_flow_cell_id", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) SetUserSpecifiedProductCode(ctx context.Context, in *SetUserSpecifiedProductCodeRequest, opts ...grpc.CallOption) (*SetUserSpecifiedProductCodeResponse, error) {
+	out := new(SetUserSpecifiedProductCodeResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.device.DeviceService/set_user_specified_product_code", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetChannelsLayout(ctx context.Context, in *GetChannelsLayoutRequest, opts ...grpc.CallOption) (*GetChannelsLayoutResponse, error) {
+	out := new(GetChannelsLayoutResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.device.DeviceService/get_channels_layout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceServiceServer is the server API for DeviceService service.
+type DeviceServiceServer interface {
+	// Get information about the device this MinKNOW instance was started for.
+	//
+	// In normal circumstances (ie: when using the manager service), a new MinKNOW instance
+	// is started for each available device. This call provides information about this device.
+	//
+	// The information returned by this call will not change (providing the MinKNOW instance
+	// was started by the manager service).
+	GetDeviceInfo(context.Context, *GetDeviceInfoRequest) (*GetDeviceInfoResponse, error)
+	// Get information about the current device state.
+	//
+	// Information in this call may change as the device is used with MinKNOW, for example,
+	// by unplugging or plugging in the device.
+	// Since 1.12
+	GetDeviceState(context.Context, *GetDeviceStateRequest) (*GetDeviceStateResponse, error)
+	// Streaming version of get_device_state
+	//
+	// Since 1.13
+	StreamDeviceState(*StreamDeviceStateRequest, DeviceService_StreamDeviceStateServer) error
+	// Get information about the flow cell (if any).
+	//
+	// This provides information about the flow_cell attached to the device (described by
+	// get_device_info()), if any.
+	GetFlowCellInfo(context.Context, *GetFlowCellInfoRequest) (*GetFlowCellInfoResponse, error)
+	// Streaming version of get_flow_cell_info
+	//
+	// Since 1.13
+	StreamFlowCellInfo(*StreamFlowCellInfoRequest, DeviceService_StreamFlowCellInfoServer) error
+	// Set the user specified flow cell id.
+	//
+	// This changes the user specified flow cell id.
+	// MinKNOW will use this id in place of the id read from the eeprom, if no eeprom data
+	// is available.
+	//
+	// This data is reset when the flow cell is disconnected.
+	//
+	// Since 1.12
+	SetUserSpecifiedFlowCellId(context.Context, *SetUserSpecifiedFlowCellIdRequest) (*SetUserSpecifiedFlowCellIdResponse, error)
+	// Set the user specified product code.
+	//
+	// This changes the user specified product code.
+	//
+	// MinKNOW does not use the product code, it is intended for use in MinKNOW's clients.
+	//
+	// This data is reset when the flow cell is disconnected.
+	//
+	// Since 1.12
+	SetUserSpecifiedProductCode(context.Context, *SetUserSpecifiedProductCodeRequest) (*SetUserSpecifiedProductCodeResponse, error)
+	// Get information about the channel layout
+	//
+	// Since 1.14
+	GetChannelsLayout(context.Context, *GetChannelsLayoutRequest) (*GetChannelsLayoutResponse, error)
+}
+
+// UnimplementedDeviceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDeviceServiceServer struct {
+}
+
+func (*UnimplementedDeviceServiceServer) GetDeviceInfo(context.Context, *GetDeviceInfoRequest) (*GetDeviceInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceInfo not implemented")
+}
+func (*UnimplementedDeviceServiceServer) GetDeviceState(context.Context, *GetDeviceStateRequest) (*GetDeviceStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceState not implemented")
+}
+func (*UnimplementedDeviceServiceServer) StreamDeviceState(*StreamDeviceStateRequest, DeviceService_StreamDeviceStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDeviceState not implemented")
+}
+func (*UnimplementedDeviceServiceServer) GetFlowCellInfo(context.Context, *GetFlowCellInfoRequest) (*GetFlowCellInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlowCellInfo not implemented")
+}
+func (*UnimplementedDeviceServiceServer) StreamFlowCellInfo(*StreamFlowCellInfoRequest, DeviceService_StreamFlowCellInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFlowCellInfo not implemented")
+}
+func (*UnimplementedDeviceServiceServer) SetUserSpecifiedFlowCellId(context.Context, *SetUserSpecifiedFlowCellIdRequest) (*SetUserSpecifiedFlowCellIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserSpecifiedFlowCellId not implemented")
+}
+func (*UnimplementedDeviceServiceServer) SetUserSpecifiedProductCode(context.Context, *SetUserSpecifiedProductCodeRequest) (*SetUserSpecifiedProductCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserSpecifiedProductCode not implemented")
+}
+func (*UnimplementedDeviceServiceServer) GetChannelsLayout(context.Context, *GetChannelsLayoutRequest) (*GetChannelsLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelsLayout not implemented")
+}
+
+func RegisterDeviceServiceServer(s *grpc.Server, srv DeviceServiceServer) {
+	s.RegisterService(&_DeviceService_serviceDesc, srv)
+}
+
+func _DeviceService_GetDeviceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetDeviceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.device.DeviceService/GetDeviceInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetDeviceInfo(ctx, req.(*GetDeviceInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetDeviceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetDeviceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.device.DeviceService/GetDeviceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetDeviceState(ctx, req.(*GetDeviceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_StreamDeviceState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDeviceStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceServiceServer).StreamDeviceState(m, &deviceServiceStreamDeviceStateServer{stream})
+}
+
+type DeviceService_StreamDeviceStateServer interface {
+	Send(*GetDeviceStateResponse) error
+	grpc.ServerStream
+}
+
+type deviceServiceStreamDeviceStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceServiceStreamDeviceStateServer) Send(m *GetDeviceStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceService_GetFlowCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlowCellInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetFlowCellInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.device.DeviceService/GetFlowCellInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetFlowCellInfo(ctx, req.(*GetFlowCellInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_StreamFlowCellInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFlowCellInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceServiceServer).StreamFlowCellInfo(m, &deviceServiceStreamFlowCellInfoServer{stream})
+}
+
+type DeviceService_StreamFlowCellInfoServer interface {
+	Send(*GetFlowCellInfoResponse) error
+	grpc.ServerStream
+}
+
+type deviceServiceStreamFlowCellInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceServiceStreamFlowCellInfoServer) Send(m *GetFlowCellInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceService_SetUserSpecifiedFlowCellId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserSpecifiedFlowCellIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).SetUserSpecifiedFlowCellId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.device.DeviceService/SetUserSpecifiedFlowCellId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).SetUserSpecifiedFlowCellId(ctx, req.(*SetUserSpecifiedFlowCellIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_SetUserSpecifiedProductCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserSpecifiedProductCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).SetUserSpecifiedProductCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.device.DeviceService/SetUserSpecifiedProductCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).SetUserSpecifiedProductCode(ctx, req.(*SetUserSpecifiedProductCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetChannelsLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelsLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetChannelsLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.device.DeviceService/GetChannelsLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetChannelsLayout(ctx, req.(*GetChannelsLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ont.rpc.device.DeviceService",
+	HandlerType: (*DeviceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "get_device_info",
+			Handler:    _DeviceService_GetDeviceInfo_Handler,
+		},
+		{
+			MethodName: "get_device_state",
+			Handler:    _DeviceService_GetDeviceState_Handler,
+		},
+		{
+			MethodName: "get_flow_cell_info",
+			Handler:    _DeviceService_GetFlowCellInfo_Handler,
+		},
+		{
+			MethodName: "set_user_specified_flow_cell_id",
+			Handler:    _DeviceService_SetUserSpecifiedFlowCellId_Handler,
+		},
+		{
+			MethodName: "set_user_specified_product_code",
+			Handler:    _DeviceService_SetUserSpecifiedProductCode_Handler,
+		},
+		{
+			MethodName: "get_channels_layout",
+			Handler:    _DeviceService_GetChannelsLayout_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "stream_device_state",
+			Handler:       _DeviceService_StreamDeviceState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "stream_flow_cell_info",
+			Handler:       _DeviceService_StreamFlowCellInfo_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "minknow/rpc/device.proto",
+}
@@ -0,0 +1,2459 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: minknow/rpc/instance.proto
+
+package instance
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	acquisition "github.com/will-rowe/herald/src/minknow/acquisition"
+	device "github.com/will-rowe/herald/src/minknow/device"
+	protocol "github.com/will-rowe/herald/src/minknow/protocol"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetVersionInfoResponse_DistributionStatus int32
+
+const (
+	GetVersionInfoResponse_UNKNOWN GetVersionInfoResponse_DistributionStatus = 0 // The distribution status is unknown or can not be determined
+	GetVersionInfoResponse_STABLE  GetVersionInfoResponse_DistributionStatus = 1 // Released software in that same configuration in which
+	// it was distributed.
+	GetVersionInfoResponse_UNSTABLE GetVersionInfoResponse_DistributionStatus = 2 // Pre-release or release-candidate software that has not
+	// been fully tested, but has not been modified from how it
+	// was distributed.
+	GetVersionInfoResponse_MODIFIED GetVersionInfoResponse_DistributionStatus = 3 // Some or all components have been replaced after distribution
+)
+
+// Enum value maps for GetVersionInfoResponse_DistributionStatus.
+var (
+	GetVersionInfoResponse_DistributionStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "STABLE",
+		2: "UNSTABLE",
+		3: "MODIFIED",
+	}
+	GetVersionInfoResponse_DistributionStatus_value = map[string]int32{
+		"UNKNOWN":  0,
+		"STABLE":   1,
+		"UNSTABLE": 2,
+		"MODIFIED": 3,
+	}
+)
+
+func (x GetVersionInfoResponse_DistributionStatus) Enum() *GetVersionInfoResponse_DistributionStatus {
+	p := new(GetVersionInfoResponse_DistributionStatus)
+	*p = x
+	return p
+}
+
+func (x GetVersionInfoResponse_DistributionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetVersionInfoResponse_DistributionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_minknow_rpc_instance_proto_enumTypes[0].Descriptor()
+}
+
+func (GetVersionInfoResponse_DistributionStatus) Type() protoreflect.EnumType {
+	return &file_minknow_rpc_instance_proto_enumTypes[0]
+}
+
+func (x GetVersionInfoResponse_DistributionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetVersionInfoResponse_DistributionStatus.Descriptor instead.
+func (GetVersionInfoResponse_DistributionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type GetHostTypeResponse_HostType int32
+
+const (
+	GetHostTypeResponse_PC               GetHostTypeResponse_HostType = 0
+	GetHostTypeResponse_PROMETHION_ALPHA GetHostTypeResponse_HostType = 1 // Deprecated - will never be returned by minknow
+	GetHostTypeResponse_GRIDION          GetHostTypeResponse_HostType = 2
+	GetHostTypeResponse_PROMETHION_BETA  GetHostTypeResponse_HostType = 3
+	GetHostTypeResponse_MINIT            GetHostTypeResponse_HostType = 4
+	GetHostTypeResponse_MINION_MK1C      GetHostTypeResponse_HostType = 5
+)
+
+// Enum value maps for GetHostTypeResponse_HostType.
+var (
+	GetHostTypeResponse_HostType_name = map[int32]string{
+		0: "PC",
+		1: "PROMETHION_ALPHA",
+		2: "GRIDION",
+		3: "PROMETHION_BETA",
+		4: "MINIT",
+		5: "MINION_MK1C",
+	}
+	GetHostTypeResponse_HostType_value = map[string]int32{
+		"PC":               0,
+		"PROMETHION_ALPHA": 1,
+		"GRIDION":          2,
+		"PROMETHION_BETA":  3,
+		"MINIT":            4,
+		"MINION_MK1C":      5,
+	}
+)
+
+func (x GetHostTypeResponse_HostType) Enum() *GetHostTypeResponse_HostType {
+	p := new(GetHostTypeResponse_HostType)
+	*p = x
+	return p
+}
+
+func (x GetHostTypeResponse_HostType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetHostTypeResponse_HostType) Descriptor() protoreflect.EnumDescriptor {
+	return file_minknow_rpc_instance_proto_enumTypes[1].Descriptor()
+}
+
+func (GetHostTypeResponse_HostType) Type() protoreflect.EnumType {
+	return &file_minknow_rpc_instance_proto_enumTypes[1]
+}
+
+func (x GetHostTypeResponse_HostType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetHostTypeResponse_HostType.Descriptor instead.
+func (GetHostTypeResponse_HostType) EnumDescriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type GetVersionInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVersionInfoRequest) Reset() {
+	*x = GetVersionInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionInfoRequest) ProtoMessage() {}
+
+func (x *GetVersionInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{0}
+}
+
+type GetVersionInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// What minknow version is installed. Split into major, minor and patch versions
+	// Also includes the full version as a string, which contain the major, minor and patch numbers
+	// as well as if the version is pre-release version (-pre), whether it is a release candidate (-rc#)
+	// or whether it is a variant version (i.e. for conferences) (-variant). For non-release builds it also
+	// includes the hash of the commit it is based on, and whether the working copy is different from that has (-dirty)
+	Minknow *GetVersionInfoResponse_MinknowVersion `protobuf:"bytes,1,opt,name=minknow,proto3" json:"minknow,omitempty"`
+	// The protocols version specifies what version of bream is installed.
+	// By default is set to '0.0.0.0', but will otherwise be set to a Bream release version.
+	Protocols string `protobuf:"bytes,2,opt,name=protocols,proto3" json:"protocols,omitempty"`
+	// Describes the distribution that this MinKNOW installation is part of, ususally
+	// this will be the Metapackage version number/identity, this will be "unknown"
+	// if the distribution-version hasn't been set. This information is also communicated
+	// in the Manager's DaemonMessage in daemon.proto
+	DistributionVersion string `protobuf:"bytes,3,opt,name=distribution_version,json=distributionVersion,proto3" json:"distribution_version,omitempty"`
+	// Indicates if the MinKNOW distribution including components such as Bream
+	// are stable, unstable or have been modified.
+	DistributionStatus GetVersionInfoResponse_DistributionStatus `protobuf:"varint,4,opt,name=distribution_status,json=distributionStatus,proto3,enum=ont.rpc.instance.GetVersionInfoResponse_DistributionStatus" json:"distribution_status,omitempty"`
+	// The configuration version specifies what version of ont-configuration (wanda) is installed.
+	// By default is set to '0.0.0.0', but will otherwise be set to a Wanda release version.
+	//
+	// Since 3.6
+	Configuration string `protobuf:"bytes,5,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *GetVersionInfoResponse) Reset() {
+	*x = GetVersionInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionInfoResponse) ProtoMessage() {}
+
+func (x *GetVersionInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionInfoResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVersionInfoResponse) GetMinknow() *GetVersionInfoResponse_MinknowVersion {
+	if x != nil {
+		return x.Minknow
+	}
+	return nil
+}
+
+func (x *GetVersionInfoResponse) GetProtocols() string {
+	if x != nil {
+		return x.Protocols
+	}
+	return ""
+}
+
+func (x *GetVersionInfoResponse) GetDistributionVersion() string {
+	if x != nil {
+		return x.DistributionVersion
+	}
+	return ""
+}
+
+func (x *GetVersionInfoResponse) GetDistributionStatus() GetVersionInfoResponse_DistributionStatus {
+	if x != nil {
+		return x.DistributionStatus
+	}
+	return GetVersionInfoResponse_UNKNOWN
+}
+
+func (x *GetVersionInfoResponse) GetConfiguration() string {
+	if x != nil {
+		return x.Configuration
+	}
+	return ""
+}
+
+type GetOutputDirectoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetOutputDirectoriesRequest) Reset() {
+	*x = GetOutputDirectoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutputDirectoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutputDirectoriesRequest) ProtoMessage() {}
+
+func (x *GetOutputDirectoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutputDirectoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetOutputDirectoriesRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{2}
+}
+
+type OutputDirectories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The base output directory. Anything that is output to files is branched from this directory.
+	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Directory where logs will be stored.
+	Log string `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	// Base directory where reads will be outputted.
+	Reads string `protobuf:"bytes,3,opt,name=reads,proto3" json:"reads,omitempty"`
+}
+
+func (x *OutputDirectories) Reset() {
+	*x = OutputDirectories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputDirectories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputDirectories) ProtoMessage() {}
+
+func (x *OutputDirectories) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputDirectories.ProtoReflect.Descriptor instead.
+func (*OutputDirectories) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OutputDirectories) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *OutputDirectories) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *OutputDirectories) GetReads() string {
+	if x != nil {
+		return x.Reads
+	}
+	return ""
+}
+
+type GetDefaultOutputDirectoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDefaultOutputDirectoriesRequest) Reset() {
+	*x = GetDefaultOutputDirectoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDefaultOutputDirectoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultOutputDirectoriesRequest) ProtoMessage() {}
+
+func (x *GetDefaultOutputDirectoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultOutputDirectoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetDefaultOutputDirectoriesRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{4}
+}
+
+type SetOutputDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *SetOutputDirectoryRequest) Reset() {
+	*x = SetOutputDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOutputDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOutputDirectoryRequest) ProtoMessage() {}
+
+func (x *SetOutputDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOutputDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*SetOutputDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetOutputDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type SetOutputDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOutputDirectoryResponse) Reset() {
+	*x = SetOutputDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOutputDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOutputDirectoryResponse) ProtoMessage() {}
+
+func (x *SetOutputDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOutputDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*SetOutputDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{6}
+}
+
+type SetReadsDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *SetReadsDirectoryRequest) Reset() {
+	*x = SetReadsDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadsDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadsDirectoryRequest) ProtoMessage() {}
+
+func (x *SetReadsDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadsDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*SetReadsDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetReadsDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type SetReadsDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetReadsDirectoryResponse) Reset() {
+	*x = SetReadsDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadsDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadsDirectoryResponse) ProtoMessage() {}
+
+func (x *SetReadsDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadsDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*SetReadsDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{8}
+}
+
+// disk-usage information for one file-system
+type FilesystemDiskSpaceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the file-system
+	FilesystemId string `protobuf:"bytes,1,opt,name=filesystem_id,json=filesystemId,proto3" json:"filesystem_id,omitempty"`
+	// How much space is left on the file-system
+	BytesAvailable uint64 `protobuf:"varint,2,opt,name=bytes_available,json=bytesAvailable,proto3" json:"bytes_available,omitempty"`
+	// The total capacity of the file-system when empty.
+	BytesCapacity uint64 `protobuf:"varint,3,opt,name=bytes_capacity,json=bytesCapacity,proto3" json:"bytes_capacity,omitempty"`
+	// A list of what MinKNOW stores on this file-system, eg: reads, logs,
+	// intermediate-files
+	What []string `protobuf:"bytes,4,rep,name=what,proto3" json:"what,omitempty"`
+	// MinKNOW needs this much space to stop an experiment. If bytes_available
+	// goes below this number, data could be lost!
+	BytesToStopCleanly uint64 `protobuf:"varint,5,opt,name=bytes_to_stop_cleanly,json=bytesToStopCleanly,proto3" json:"bytes_to_stop_cleanly,omitempty"`
+	// The amount of space left on the file-system when recommend_alert
+	// was set true.
+	BytesWhenAlertIssued uint64 `protobuf:"varint,6,opt,name=bytes_when_alert_issued,json=bytesWhenAlertIssued,proto3" json:"bytes_when_alert_issued,omitempty"`
+	// MinKNOW recommends that you alert someone about the disk-usage
+	RecommendAlert bool `protobuf:"varint,7,opt,name=recommend_alert,json=recommendAlert,proto3" json:"recommend_alert,omitempty"`
+	// MinKNOW recommends that you stop the experiment due to disk-usage
+	// concerns
+	RecommendStop bool `protobuf:"varint,8,opt,name=recommend_stop,json=recommendStop,proto3" json:"recommend_stop,omitempty"`
+}
+
+func (x *FilesystemDiskSpaceInfo) Reset() {
+	*x = FilesystemDiskSpaceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesystemDiskSpaceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesystemDiskSpaceInfo) ProtoMessage() {}
+
+func (x *FilesystemDiskSpaceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesystemDiskSpaceInfo.ProtoReflect.Descriptor instead.
+func (*FilesystemDiskSpaceInfo) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FilesystemDiskSpaceInfo) GetFilesystemId() string {
+	if x != nil {
+		return x.FilesystemId
+	}
+	return ""
+}
+
+func (x *FilesystemDiskSpaceInfo) GetBytesAvailable() uint64 {
+	if x != nil {
+		return x.BytesAvailable
+	}
+	return 0
+}
+
+func (x *FilesystemDiskSpaceInfo) GetBytesCapacity() uint64 {
+	if x != nil {
+		return x.BytesCapacity
+	}
+	return 0
+}
+
+func (x *FilesystemDiskSpaceInfo) GetWhat() []string {
+	if x != nil {
+		return x.What
+	}
+	return nil
+}
+
+func (x *FilesystemDiskSpaceInfo) GetBytesToStopCleanly() uint64 {
+	if x != nil {
+		return x.BytesToStopCleanly
+	}
+	return 0
+}
+
+func (x *FilesystemDiskSpaceInfo) GetBytesWhenAlertIssued() uint64 {
+	if x != nil {
+		return x.BytesWhenAlertIssued
+	}
+	return 0
+}
+
+func (x *FilesystemDiskSpaceInfo) GetRecommendAlert() bool {
+	if x != nil {
+		return x.RecommendAlert
+	}
+	return false
+}
+
+func (x *FilesystemDiskSpaceInfo) GetRecommendStop() bool {
+	if x != nil {
+		return x.RecommendStop
+	}
+	return false
+}
+
+type GetDiskSpaceInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDiskSpaceInfoRequest) Reset() {
+	*x = GetDiskSpaceInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskSpaceInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskSpaceInfoRequest) ProtoMessage() {}
+
+func (x *GetDiskSpaceInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskSpaceInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskSpaceInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{10}
+}
+
+type GetDiskSpaceInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilesystemDiskSpaceInfo []*FilesystemDiskSpaceInfo `protobuf:"bytes,1,rep,name=filesystem_disk_space_info,json=filesystemDiskSpaceInfo,proto3" json:"filesystem_disk_space_info,omitempty"`
+}
+
+func (x *GetDiskSpaceInfoResponse) Reset() {
+	*x = GetDiskSpaceInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskSpaceInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskSpaceInfoResponse) ProtoMessage() {}
+
+func (x *GetDiskSpaceInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskSpaceInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskSpaceInfoResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDiskSpaceInfoResponse) GetFilesystemDiskSpaceInfo() []*FilesystemDiskSpaceInfo {
+	if x != nil {
+		return x.FilesystemDiskSpaceInfo
+	}
+	return nil
+}
+
+type GetMachineIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMachineIdRequest) Reset() {
+	*x = GetMachineIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMachineIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineIdRequest) ProtoMessage() {}
+
+func (x *GetMachineIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineIdRequest.ProtoReflect.Descriptor instead.
+func (*GetMachineIdRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{12}
+}
+
+type GetMachineIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The machine_id MinKNOW uses for this host.
+	MachineId string `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+}
+
+func (x *GetMachineIdResponse) Reset() {
+	*x = GetMachineIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMachineIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineIdResponse) ProtoMessage() {}
+
+func (x *GetMachineIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineIdResponse.ProtoReflect.Descriptor instead.
+func (*GetMachineIdResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMachineIdResponse) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type GetHostTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetHostTypeRequest) Reset() {
+	*x = GetHostTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHostTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostTypeRequest) ProtoMessage() {}
+
+func (x *GetHostTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetHostTypeRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{14}
+}
+
+type GetHostTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostType GetHostTypeResponse_HostType `protobuf:"varint,1,opt,name=host_type,json=hostType,proto3,enum=ont.rpc.instance.GetHostTypeResponse_HostType" json:"host_type,omitempty"`
+}
+
+func (x *GetHostTypeResponse) Reset() {
+	*x = GetHostTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHostTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostTypeResponse) ProtoMessage() {}
+
+func (x *GetHostTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetHostTypeResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetHostTypeResponse) GetHostType() GetHostTypeResponse_HostType {
+	if x != nil {
+		return x.HostType
+	}
+	return GetHostTypeResponse_PC
+}
+
+type StreamInstanceActivityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamInstanceActivityRequest) Reset() {
+	*x = StreamInstanceActivityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamInstanceActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInstanceActivityRequest) ProtoMessage() {}
+
+func (x *StreamInstanceActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInstanceActivityRequest.ProtoReflect.Descriptor instead.
+func (*StreamInstanceActivityRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{16}
+}
+
+type DeviceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current state of the device
+	DeviceState device.GetDeviceStateResponse_DeviceState `protobuf:"varint,1,opt,name=device_state,json=deviceState,proto3,enum=ont.rpc.device.GetDeviceStateResponse_DeviceState" json:"device_state,omitempty"`
+	// Information about the connected device (or no content if disconnected see: device_state)
+	DeviceInfo *device.GetDeviceInfoResponse `protobuf:"bytes,2,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+}
+
+func (x *DeviceInfo) Reset() {
+	*x = DeviceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceInfo) ProtoMessage() {}
+
+func (x *DeviceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceInfo.ProtoReflect.Descriptor instead.
+func (*DeviceInfo) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeviceInfo) GetDeviceState() device.GetDeviceStateResponse_DeviceState {
+	if x != nil {
+		return x.DeviceState
+	}
+	return device.GetDeviceStateResponse_DEVICE_DISCONNECTED
+}
+
+func (x *DeviceInfo) GetDeviceInfo() *device.GetDeviceInfoResponse {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return nil
+}
+
+type StreamInstanceActivityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to StreamValue:
+	//	*StreamInstanceActivityResponse_DeviceInfo
+	//	*StreamInstanceActivityResponse_FlowCellInfo
+	//	*StreamInstanceActivityResponse_ProtocolRunInfo
+	//	*StreamInstanceActivityResponse_AcquisitionRunInfo
+	//	*StreamInstanceActivityResponse_FlowCellHealth_
+	//	*StreamInstanceActivityResponse_YieldSummary
+	StreamValue isStreamInstanceActivityResponse_StreamValue `protobuf_oneof:"stream_value"`
+}
+
+func (x *StreamInstanceActivityResponse) Reset() {
+	*x = StreamInstanceActivityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamInstanceActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInstanceActivityResponse) ProtoMessage() {}
+
+func (x *StreamInstanceActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInstanceActivityResponse.ProtoReflect.Descriptor instead.
+func (*StreamInstanceActivityResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *StreamInstanceActivityResponse) GetStreamValue() isStreamInstanceActivityResponse_StreamValue {
+	if m != nil {
+		return m.StreamValue
+	}
+	return nil
+}
+
+func (x *StreamInstanceActivityResponse) GetDeviceInfo() *DeviceInfo {
+	if x, ok := x.GetStreamValue().(*StreamInstanceActivityResponse_DeviceInfo); ok {
+		return x.DeviceInfo
+	}
+	return nil
+}
+
+func (x *StreamInstanceActivityResponse) GetFlowCellInfo() *device.GetFlowCellInfoResponse {
+	if x, ok := x.GetStreamValue().(*StreamInstanceActivityResponse_FlowCellInfo); ok {
+		return x.FlowCellInfo
+	}
+	return nil
+}
+
+func (x *StreamInstanceActivityResponse) GetProtocolRunInfo() *protocol.ProtocolRunInfo {
+	if x, ok := x.GetStreamValue().(*StreamInstanceActivityResponse_ProtocolRunInfo); ok {
+		return x.ProtocolRunInfo
+	}
+	return nil
+}
+
+func (x *StreamInstanceActivityResponse) GetAcquisitionRunInfo() *acquisition.AcquisitionRunInfo {
+	if x, ok := x.GetStreamValue().(*StreamInstanceActivityResponse_AcquisitionRunInfo); ok {
+		return x.AcquisitionRunInfo
+	}
+	return nil
+}
+
+func (x *StreamInstanceActivityResponse) GetFlowCellHealth() *StreamInstanceActivityResponse_FlowCellHealth {
+	if x, ok := x.GetStreamValue().(*StreamInstanceActivityResponse_FlowCellHealth_); ok {
+		return x.FlowCellHealth
+	}
+	return nil
+}
+
+func (x *StreamInstanceActivityResponse) GetYieldSummary() *acquisition.AcquisitionYieldSummary {
+	if x, ok := x.GetStreamValue().(*StreamInstanceActivityResponse_YieldSummary); ok {
+		return x.YieldSummary
+	}
+	return nil
+}
+
+type isStreamInstanceActivityResponse_StreamValue interface {
+	isStreamInstanceActivityResponse_StreamValue()
+}
+
+type StreamInstanceActivityResponse_DeviceInfo struct {
+	// Information about whether the device is connected or not, and if it is, gives
+	// information about the connected device
+	DeviceInfo *DeviceInfo `protobuf:"bytes,1,opt,name=device_info,json=deviceInfo,proto3,oneof"`
+}
+
+type StreamInstanceActivityResponse_FlowCellInfo struct {
+	// Information about the currently conected flow cell
+	//
+	// Note: if no flow cell is connected this [flow_cell_info.has_flow_cell] will be false
+	FlowCellInfo *device.GetFlowCellInfoResponse `protobuf:"bytes,2,opt,name=flow_cell_info,json=flowCellInfo,proto3,oneof"`
+}
+
+type StreamInstanceActivityResponse_ProtocolRunInfo struct {
+	// Information about the in progress protocol.
+	//
+	// Note if no protocol is active this message will not be present.
+	ProtocolRunInfo *protocol.ProtocolRunInfo `protobuf:"bytes,3,opt,name=protocol_run_info,json=protocolRunInfo,proto3,oneof"`
+}
+
+type StreamInstanceActivityResponse_AcquisitionRunInfo struct {
+	// Information about the current acquisition run
+	//
+	// Note if no acquisition is active the message will not be present.
+	AcquisitionRunInfo *acquisition.AcquisitionRunInfo `protobuf:"bytes,4,opt,name=acquisition_run_info,json=acquisitionRunInfo,proto3,oneof"`
+}
+
+type StreamInstanceActivityResponse_FlowCellHealth_ struct {
+	// Information about the health of the flow cell within the current run
+	//
+	// Note: only available if a run is in progress
+	FlowCellHealth *StreamInstanceActivityResponse_FlowCellHealth `protobuf:"bytes,5,opt,name=flow_cell_health,json=flowCellHealth,proto3,oneof"`
+}
+
+type StreamInstanceActivityResponse_YieldSummary struct {
+	// Acquisition yield information. Describes information such as number of reads,
+	// what number of those reads have passed or failed basecalling etc. Rate
+	// limited to 1 second per update
+	YieldSummary *acquisition.AcquisitionYieldSummary `protobuf:"bytes,6,opt,name=yield_summary,json=yieldSummary,proto3,oneof"`
+}
+
+func (*StreamInstanceActivityResponse_DeviceInfo) isStreamInstanceActivityResponse_StreamValue() {}
+
+func (*StreamInstanceActivityResponse_FlowCellInfo) isStreamInstanceActivityResponse_StreamValue() {}
+
+func (*StreamInstanceActivityResponse_ProtocolRunInfo) isStreamInstanceActivityResponse_StreamValue() {
+}
+
+func (*StreamInstanceActivityResponse_AcquisitionRunInfo) isStreamInstanceActivityResponse_StreamValue() {
+}
+
+func (*StreamInstanceActivityResponse_FlowCellHealth_) isStreamInstanceActivityResponse_StreamValue() {
+}
+
+func (*StreamInstanceActivityResponse_YieldSummary) isStreamInstanceActivityResponse_StreamValue() {}
+
+type GetVersionInfoResponse_MinknowVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Major int32  `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor int32  `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	Patch int32  `protobuf:"varint,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	Full  string `protobuf:"bytes,4,opt,name=full,proto3" json:"full,omitempty"`
+}
+
+func (x *GetVersionInfoResponse_MinknowVersion) Reset() {
+	*x = GetVersionInfoResponse_MinknowVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionInfoResponse_MinknowVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionInfoResponse_MinknowVersion) ProtoMessage() {}
+
+func (x *GetVersionInfoResponse_MinknowVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionInfoResponse_MinknowVersion.ProtoReflect.Descriptor instead.
+func (*GetVersionInfoResponse_MinknowVersion) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetVersionInfoResponse_MinknowVersion) GetMajor() int32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *GetVersionInfoResponse_MinknowVersion) GetMinor() int32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *GetVersionInfoResponse_MinknowVersion) GetPatch() int32 {
+	if x != nil {
+		return x.Patch
+	}
+	return 0
+}
+
+func (x *GetVersionInfoResponse_MinknowVersion) GetFull() string {
+	if x != nil {
+		return x.Full
+	}
+	return ""
+}
+
+type StreamInstanceActivityResponse_FlowCellHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map between channel state name and a percentage of how much time that state has been active with respect to all other channel states
+	//
+	// This is over one minute of time this is calculated over
+	ChannelStatePercentages map[string]float32 `protobuf:"bytes,1,rep,name=channel_state_percentages,json=channelStatePercentages,proto3" json:"channel_state_percentages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+}
+
+func (x *StreamInstanceActivityResponse_FlowCellHealth) Reset() {
+	*x = StreamInstanceActivityResponse_FlowCellHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_instance_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamInstanceActivityResponse_FlowCellHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInstanceActivityResponse_FlowCellHealth) ProtoMessage() {}
+
+func (x *StreamInstanceActivityResponse_FlowCellHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_instance_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInstanceActivityResponse_FlowCellHealth.ProtoReflect.Descriptor instead.
+func (*StreamInstanceActivityResponse_FlowCellHealth) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_instance_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *StreamInstanceActivityResponse_FlowCellHealth) GetChannelStatePercentages() map[string]float32 {
+	if x != nil {
+		return x.ChannelStatePercentages
+	}
+	return nil
+}
+
+var File_minknow_rpc_instance_proto protoreflect.FileDescriptor
+
+var file_minknow_rpc_instance_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x1d,
+	0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6d,
+	0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x04, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x13, 0x64,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x66, 0x0a, 0x0e, 0x4d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0x49, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x53, 0x54, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x03, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x53, 0x0a, 0x11, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x24, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2f, 0x0a, 0x19,
+	0x53, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x1c, 0x0a,
+	0x1a, 0x53, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x1b, 0x0a, 0x19, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdc, 0x02, 0x0a, 0x17, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x68, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x77, 0x68, 0x61, 0x74, 0x12, 0x31, 0x0a,
+	0x15, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x17, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x68, 0x65, 0x6e, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74,
+	0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x66, 0x0a, 0x1a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x17,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x66, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02,
+	0x50, 0x43, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x52,
+	0x49, 0x44, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x4d, 0x45,
+	0x54, 0x48, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x45, 0x54, 0x41, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05,
+	0x4d, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x49, 0x4e, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x4b, 0x31, 0x43, 0x10, 0x05, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x0a, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x55, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x46, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xac, 0x06, 0x0a, 0x1e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4f, 0x0a, 0x0e, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c,
+	0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4f, 0x0a, 0x11,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a,
+	0x14, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6e,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x6b, 0x0a, 0x10, 0x66, 0x6c,
+	0x6f, 0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c,
+	0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x0d, 0x79, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x59, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0c,
+	0x79, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0xf7, 0x01, 0x0a,
+	0x0e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x98, 0x01, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x5c, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x4a, 0x0a, 0x1c, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x87, 0x08, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x67, 0x65,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x27,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e,
+	0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x1e, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x61, 0x64, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x13, 0x67, 0x65, 0x74,
+	0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x29, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0e, 0x67, 0x65, 0x74,
+	0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0d,
+	0x67, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x2e,
+	0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a,
+	0x18, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
+	0x69, 0x6c, 0x6c, 0x2d, 0x72, 0x6f, 0x77, 0x65, 0x2f, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x2f,
+	0x73, 0x72, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_minknow_rpc_instance_proto_rawDescOnce sync.Once
+	file_minknow_rpc_instance_proto_rawDescData = file_minknow_rpc_instance_proto_rawDesc
+)
+
+func file_minknow_rpc_instance_proto_rawDescGZIP() []byte {
+	file_minknow_rpc_instance_proto_rawDescOnce.Do(func() {
+		file_minknow_rpc_instance_proto_rawDescData = protoimpl.X.CompressGZIP(file_minknow_rpc_instance_proto_rawDescData)
+	})
+	return file_minknow_rpc_instance_proto_rawDescData
+}
+
+var file_minknow_rpc_instance_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_minknow_rpc_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_minknow_rpc_instance_proto_goTypes = []interface{}{
+	(GetVersionInfoResponse_DistributionStatus)(0),        // 0: ont.rpc.instance.GetVersionInfoResponse.DistributionStatus
+	(GetHostTypeResponse_HostType)(0),                     // 1: ont.rpc.instance.GetHostTypeResponse.HostType
+	(*GetVersionInfoRequest)(nil),                         // 2: ont.rpc.instance.GetVersionInfoRequest
+	(*GetVersionInfoResponse)(nil),                        // 3: ont.rpc.instance.GetVersionInfoResponse
+	(*GetOutputDirectoriesRequest)(nil),                   // 4: ont.rpc.instance.GetOutputDirectoriesRequest
+	(*OutputDirectories)(nil),                             // 5: ont.rpc.instance.OutputDirectories
+	(*GetDefaultOutputDirectoriesRequest)(nil),            // 6: ont.rpc.instance.GetDefaultOutputDirectoriesRequest
+	(*SetOutputDirectoryRequest)(nil),                     // 7: ont.rpc.instance.SetOutputDirectoryRequest
+	(*SetOutputDirectoryResponse)(nil),                    // 8: ont.rpc.instance.SetOutputDirectoryResponse
+	(*SetReadsDirectoryRequest)(nil),                      // 9: ont.rpc.instance.SetReadsDirectoryRequest
+	(*SetReadsDirectoryResponse)(nil),                     // 10: ont.rpc.instance.SetReadsDirectoryResponse
+	(*FilesystemDiskSpaceInfo)(nil),                       // 11: ont.rpc.instance.FilesystemDiskSpaceInfo
+	(*GetDiskSpaceInfoRequest)(nil),                       // 12: ont.rpc.instance.GetDiskSpaceInfoRequest
+	(*GetDiskSpaceInfoResponse)(nil),                      // 13: ont.rpc.instance.GetDiskSpaceInfoResponse
+	(*GetMachineIdRequest)(nil),                           // 14: ont.rpc.instance.GetMachineIdRequest
+	(*GetMachineIdResponse)(nil),                          // 15: ont.rpc.instance.GetMachineIdResponse
+	(*GetHostTypeRequest)(nil),                            // 16: ont.rpc.instance.GetHostTypeRequest
+	(*GetHostTypeResponse)(nil),                           // 17: ont.rpc.instance.GetHostTypeResponse
+	(*StreamInstanceActivityRequest)(nil),                 // 18: ont.rpc.instance.StreamInstanceActivityRequest
+	(*DeviceInfo)(nil),                                    // 19: ont.rpc.instance.DeviceInfo
+	(*StreamInstanceActivityResponse)(nil),                // 20: ont.rpc.instance.StreamInstanceActivityResponse
+	(*GetVersionInfoResponse_MinknowVersion)(nil),         // 21: ont.rpc.instance.GetVersionInfoResponse.MinknowVersion
+	(*StreamInstanceActivityResponse_FlowCellHealth)(nil), // 22: ont.rpc.instance.StreamInstanceActivityResponse.FlowCellHealth
+	nil, // 23: ont.rpc.instance.StreamInstanceActivityResponse.FlowCellHealth.ChannelStatePercentagesEntry
+	(device.GetDeviceStateResponse_DeviceState)(0), // 24: ont.rpc.device.GetDeviceStateResponse.DeviceState
+	(*device.GetDeviceInfoResponse)(nil),           // 25: ont.rpc.device.GetDeviceInfoResponse
+	(*device.GetFlowCellInfoResponse)(nil),         // 26: ont.rpc.device.GetFlowCellInfoResponse
+	(*protocol.ProtocolRunInfo)(nil),               // 27: ont.rpc.protocol.ProtocolRunInfo
+	(*acquisition.AcquisitionRunInfo)(nil),         // 28: ont.rpc.acquisition.AcquisitionRunInfo
+	(*acquisition.AcquisitionYieldSummary)(nil),    // 29: ont.rpc.acquisition.AcquisitionYieldSummary
+}
+var file_minknow_rpc_instance_proto_depIdxs = []int32{
+	21, // 0: ont.rpc.instance.GetVersionInfoResponse.minknow:type_name -> ont.rpc.instance.GetVersionInfoResponse.MinknowVersion
+	0,  // 1: ont.rpc.instance.GetVersionInfoResponse.distribution_status:type_name -> ont.rpc.instance.GetVersionInfoResponse.DistributionStatus
+	11, // 2: ont.rpc.instance.GetDiskSpaceInfoResponse.filesystem_disk_space_info:type_name -> ont.rpc.instance.FilesystemDiskSpaceInfo
+	1,  // 3: ont.rpc.instance.GetHostTypeResponse.host_type:type_name -> ont.rpc.instance.GetHostTypeResponse.HostType
+	24, // 4: ont.rpc.instance.DeviceInfo.device_state:type_name -> ont.rpc.device.GetDeviceStateResponse.DeviceState
+	25, // 5: ont.rpc.instance.DeviceInfo.device_info:type_name -> ont.rpc.device.GetDeviceInfoResponse
+	19, // 6: ont.rpc.instance.StreamInstanceActivityResponse.device_info:type_name -> ont.rpc.instance.DeviceInfo
+	26, // 7: ont.rpc.instance.StreamInstanceActivityResponse.flow_cell_info:type_name -> ont.rpc.device.GetFlowCellInfoResponse
+	27, // 8: ont.rpc.instance.StreamInstanceActivityResponse.protocol_run_info:type_name -> ont.rpc.protocol.ProtocolRunInfo
+	28, // 9: ont.rpc.instance.StreamInstanceActivityResponse.acquisition_run_info:type_name -> ont.rpc.acquisition.AcquisitionRunInfo
+	22, // 10: ont.rpc.instance.StreamInstanceActivityResponse.flow_cell_health:type_name -> ont.rpc.instance.StreamInstanceActivityResponse.FlowCellHealth
+	29, // 11: ont.rpc.instance.StreamInstanceActivityResponse.yield_summary:type_name -> ont.rpc.acquisition.AcquisitionYieldSummary
+	23, // 12: ont.rpc.instance.StreamInstanceActivityResponse.FlowCellHealth.channel_state_percentages:type_name -> ont.rpc.instance.StreamInstanceActivityResponse.FlowCellHealth.ChannelStatePercentagesEntry
+	2,  // 13: ont.rpc.instance.InstanceService.get_version_info:input_type -> ont.rpc.instance.GetVersionInfoRequest
+	4,  // 14: ont.rpc.instance.InstanceService.get_output_directories:input_type -> ont.rpc.instance.GetOutputDirectoriesRequest
+	6,  // 15: ont.rpc.instance.InstanceService.get_default_output_directories:input_type -> ont.rpc.instance.GetDefaultOutputDirectoriesRequest
+	7,  // 16: ont.rpc.instance.InstanceService.set_output_directory:input_type -> ont.rpc.instance.SetOutputDirectoryRequest
+	9,  // 17: ont.rpc.instance.InstanceService.set_reads_directory:input_type -> ont.rpc.instance.SetReadsDirectoryRequest
+	12, // 18: ont.rpc.instance.InstanceService.get_disk_space_info:input_type -> ont.rpc.instance.GetDiskSpaceInfoRequest
+	14, // 19: ont.rpc.instance.InstanceService.get_machine_id:input_type -> ont.rpc.instance.GetMachineIdRequest
+	16, // 20: ont.rpc.instance.InstanceService.get_host_type:input_type -> ont.rpc.instance.GetHostTypeRequest
+	18, // 21: ont.rpc.instance.InstanceService.stream_instance_activity:input_type -> ont.rpc.instance.StreamInstanceActivityRequest
+	3,  // 22: ont.rpc.instance.InstanceService.get_version_info:output_type -> ont.rpc.instance.GetVersionInfoResponse
+	5,  // 23: ont.rpc.instance.InstanceService.get_output_directories:output_type -> ont.rpc.instance.OutputDirectories
+	5,  // 24: ont.rpc.instance.InstanceService.get_default_output_directories:output_type -> ont.rpc.instance.OutputDirectories
+	8,  // 25: ont.rpc.instance.InstanceService.set_output_directory:output_type -> ont.rpc.instance.SetOutputDirectoryResponse
+	10, // 26: ont.rpc.instance.InstanceService.set_reads_directory:output_type -> ont.rpc.instance.SetReadsDirectoryResponse
+	13, // 27: ont.rpc.instance.InstanceService.get_disk_space_info:output_type -> ont.rpc.instance.GetDiskSpaceInfoResponse
+	15, // 28: ont.rpc.instance.InstanceService.get_machine_id:output_type -> ont.rpc.instance.GetMachineIdResponse
+	17, // 29: ont.rpc.instance.InstanceService.get_host_type:output_type -> ont.rpc.instance.GetHostTypeResponse
+	20, // 30: ont.rpc.instance.InstanceService.stream_instance_activity:output_type -> ont.rpc.instance.StreamInstanceActivityResponse
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_minknow_rpc_instance_proto_init() }
+func file_minknow_rpc_instance_proto_init() {
+	if File_minknow_rpc_instance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_minknow_rpc_instance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOutputDirectoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputDirectories); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDefaultOutputDirectoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOutputDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOutputDirectoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadsDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadsDirectoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesystemDiskSpaceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskSpaceInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskSpaceInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMachineIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMachineIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHostTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHostTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInstanceActivityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInstanceActivityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionInfoResponse_MinknowVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_instance_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInstanceActivityResponse_FlowCellHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_minknow_rpc_instance_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*StreamInstanceActivityResponse_DeviceInfo)(nil),
+		(*StreamInstanceActivityResponse_FlowCellInfo)(nil),
+		(*StreamInstanceActivityResponse_ProtocolRunInfo)(nil),
+		(*StreamInstanceActivityResponse_AcquisitionRunInfo)(nil),
+		(*StreamInstanceActivityResponse_FlowCellHealth_)(nil),
+		(*StreamInstanceActivityResponse_YieldSummary)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_minknow_rpc_instance_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_minknow_rpc_instance_proto_goTypes,
+		DependencyIndexes: file_minknow_rpc_instance_proto_depIdxs,
+		EnumInfos:         file_minknow_rpc_instance_proto_enumTypes,
+		MessageInfos:      file_minknow_rpc_instance_proto_msgTypes,
+	}.Build()
+	File_minknow_rpc_instance_proto = out.File
+	file_minknow_rpc_instance_proto_rawDesc = nil
+	file_minknow_rpc_instance_proto_goTypes = nil
+	file_minknow_rpc_instance_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// InstanceServiceClient is the client API for InstanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type InstanceServiceClient interface {
+	// Current version information includes:
+	// - Minknow version
+	// - Protocols version
+	// - Distribution version
+	GetVersionInfo(ctx context.Context, in *GetVersionInfoRequest, opts ...grpc.CallOption) (*GetVersionInfoResponse, error)
+	// Returns various directory locations where minknow is outputting data. The paths are absolute paths,
+	// local to the machine where minknow is installed
+	//
+	// the `output` base directory can be changed internally
+	// the `logs` directory will not be changed and can be stored
+	// the `reads` directory is determined  by the read writer config
+	GetOutputDirectories(ctx context.Context, in *GetOutputDirectoriesRequest, opts ...grpc.CallOption) (*OutputDirectories, error)
+	// See `get_output_directories`, but this will always return the paths that are defined in the config when the instance
+	// of minknow has started
+	//
+	// Since 1.11
+	GetDefaultOutputDirectories(ctx context.Context, in *GetDefaultOutputDirectoriesRequest, opts ...grpc.CallOption) (*OutputDirectories, error)
+	// Set the base directory to where all data will be output. Must be an absolute directory
+	//
+	// Fails with INVALID_ARGUMENT if `value` is not absolute.
+	// Fails with FAILED_PRECONDITION if this is called during acquisition
+	//
+	// Since 1.11
+	SetOutputDirectory(ctx context.Context, in *SetOutputDirectoryRequest, opts ...grpc.CallOption) (*SetOutputDirectoryResponse, error)
+	// Set the reads directory where read files (hdf5, fastq, protobuf) will be output
+	//
+	// Fails with INVALID_ARGUMENT if `value` is not absolute.
+	// Fails with FAILED_PRECONDITION if this is called during acquisition
+	//
+	// Since 1.12
+	SetReadsDirectory(ctx context.Context, in *SetReadsDirectoryRequest, opts ...grpc.CallOption) (*SetReadsDirectoryResponse, error)
+	// Returns information about the amount of disk space available, how much
+	// space is needed to stop an experiment cleanly and if MinKNOW thinks
+	// that the free disk-space is approaching or past this limit
+	//
+	// Since 1.11
+	GetDiskSpaceInfo(ctx context.Context, in *GetDiskSpaceInfoRequest, opts ...grpc.CallOption) (*GetDiskSpaceInfoResponse, error)
+	// Find the machine id MinKNOW uses for this machine.
+	//
+	// This is expected to be a descriptive string for the machine, MinKNOW currently uses the network hostname.
+	//
+	// note: This is the identifier used when sending telemetry data for this instance.
+	//
+	// Since 1.11
+	GetMachineId(ctx context.Context, in *GetMachineIdRequest, opts ...grpc.CallOption) (*GetMachineIdResponse, error)
+	// Find the host type MinKNOW is running on.
+	//
+	// This data describes the type of machine which MinKNOW is running on.
+	//
+	// Since 1.14
+	GetHostType(ctx context.Context, in *GetHostTypeRequest, opts ...grpc.CallOption) (*GetHostTypeResponse, error)
+	// Find a summary of activity on the instance.
+	//
+	// The information available from this request is also available from other rpc's - this call is intended
+	// as a performance improvement for users who watch a large number of streams (specifically over
+	// web socket transport).
+	//
+	// Use this request to find information about the current device, flow cell, protocol and acquisition state.
+	//
+	// Since 3.2
+	StreamInstanceActivity(ctx context.Context, in *StreamInstanceActivityRequest, opts ...grpc.CallOption) (InstanceService_StreamInstanceActivityClient, error)
+}
+
+type instanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstanceServiceClient(cc grpc.ClientConnInterface) InstanceServiceClient {
+	return &instanceServiceClient{cc}
+}
+
+func (c *instanceServiceClient) GetVersionInfo(ctx context.Context, in *GetVersionInfoRequest, opts ...grpc.CallOption) (*GetVersionInfoResponse, error) {
+	out := new(GetVersionInfoResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/get_version_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetOutputDirectories(ctx context.Context, in *GetOutputDirectoriesRequest, opts ...grpc.CallOption) (*OutputDirectories, error) {
+	out := new(OutputDirectories)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/get_output_directories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetDefaultOutputDirectories(ctx context.Context, in *GetDefaultOutputDirectoriesRequest, opts ...grpc.CallOption) (*OutputDirectories, error) {
+	out := new(OutputDirectories)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/get_default_output_directories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) SetOutputDirectory(ctx context.Context, in *SetOutputDirectoryRequest, opts ...grpc.CallOption) (*SetOutputDirectoryResponse, error) {
+	out := new(SetOutputDirectoryResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/set_output_directory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) SetReadsDirectory(ctx context.Context, in *SetReadsDirectoryRequest, opts ...grpc.CallOption) (*SetReadsDirectoryResponse, error) {
+	out := new(SetReadsDirectoryResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/set_reads_directory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetDiskSpaceInfo(ctx context.Context, in *GetDiskSpaceInfoRequest, opts ...grpc.CallOption) (*GetDiskSpaceInfoResponse, error) {
+	out := new(GetDiskSpaceInfoResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/get_disk_space_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetMachineId(ctx context.Context, in *GetMachineIdRequest, opts ...grpc.CallOption) (*GetMachineIdResponse, error) {
+	out := new(GetMachineIdResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/get_machine_id", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetHostType(ctx context.Context, in *GetHostTypeRequest, opts ...grpc.CallOption) (*GetHostTypeResponse, error) {
+	out := new(GetHostTypeResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.instance.InstanceService/get_host_type", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) StreamInstanceActivity(ctx context.Context, in *StreamInstanceActivityRequest, opts ...grpc.CallOption) (InstanceService_StreamInstanceActivityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_InstanceService_serviceDesc.Streams[0], "/ont.rpc.instance.InstanceService/stream_instance_activity", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &instanceServiceStreamInstanceActivityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InstanceService_StreamInstanceActivityClient interface {
+	Recv() (*StreamInstanceActivityResponse, error)
+	grpc.ClientStream
+}
+
+type instanceServiceStreamInstanceActivityClient struct {
+	grpc.ClientStream
+}
+
+func (x *instanceServiceStreamInstanceActivityClient) Recv() (*StreamInstanceActivityResponse, error) {
+	m := new(StreamInstanceActivityResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// InstanceServiceServer is the server API for InstanceService service.
+type InstanceServiceServer interface {
+	// Current version information includes:
+	// - Minknow version
+	// - Protocols version
+	// - Distribution version
+	GetVersionInfo(context.Context, *GetVersionInfoRequest) (*GetVersionInfoResponse, error)
+	// Returns various directory locations where minknow is outputting data. The paths are absolute paths,
+	// local to the machine where minknow is installed
+	//
+	// the `output` base directory can be changed internally
+	// the `logs` directory will not be changed and can be stored
+	// the `reads` directory is determined  by the read writer config
+	GetOutputDirectories(context.Context, *GetOutputDirectoriesRequest) (*OutputDirectories, error)
+	// See `get_output_directories`, but this will always return the paths that are defined in the config when the instance
+	// of minknow has started
+	//
+	// Since 1.11
+	GetDefaultOutputDirectories(context.Context, *GetDefaultOutputDirectoriesRequest) (*OutputDirectories, error)
+	// Set the base directory to where all data will be output. Must be an absolute directory
+	//
+	// Fails with INVALID_ARGUMENT if `value` is not absolute.
+	// Fails with FAILED_PRECONDITION if this is called during acquisition
+	//
+	// Since 1.11
+	SetOutputDirectory(context.Context, *SetOutputDirectoryRequest) (*SetOutputDirectoryResponse, error)
+	// Set the reads directory where read files (hdf5, fastq, protobuf) will be output
+	//
+	// Fails with INVALID_ARGUMENT if `value` is not absolute.
+	// Fails with FAILED_PRECONDITION if this is called during acquisition
+	//
+	// Since 1.12
+	SetReadsDirectory(context.Context, *SetReadsDirectoryRequest) (*SetReadsDirectoryResponse, error)
+	// Returns information about the amount of disk space available, how much
+	// space is needed to stop an experiment cleanly and if MinKNOW thinks
+	// that the free disk-space is approaching or past this limit
+	//
+	// Since 1.11
+	GetDiskSpaceInfo(context.Context, *GetDiskSpaceInfoRequest) (*GetDiskSpaceInfoResponse, error)
+	// Find the machine id MinKNOW uses for this machine.
+	//
+	// This is expected to be a descriptive string for the machine, MinKNOW currently uses the network hostname.
+	//
+	// note: This is the identifier used when sending telemetry data for this instance.
+	//
+	// Since 1.11
+	GetMachineId(context.Context, *GetMachineIdRequest) (*GetMachineIdResponse, error)
+	// Find the host type MinKNOW is running on.
+	//
+	// This data describes the type of machine which MinKNOW is running on.
+	//
+	// Since 1.14
+	GetHostType(context.Context, *GetHostTypeRequest) (*GetHostTypeResponse, error)
+	// Find a summary of activity on the instance.
+	//
+	// The information available from this request is also available from other rpc's - this call is intended
+	// as a performance improvement for users who watch a large number of streams (specifically over
+	// web socket transport).
+	//
+	// Use this request to find information about the current device, flow cell, protocol and acquisition state.
+	//
+	// Since 3.2
+	StreamInstanceActivity(*StreamInstanceActivityRequest, InstanceService_StreamInstanceActivityServer) error
+}
+
+// UnimplementedInstanceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedInstanceServiceServer struct {
+}
+
+func (*UnimplementedInstanceServiceServer) GetVersionInfo(context.Context, *GetVersionInfoRequest) (*GetVersionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersionInfo not implemented")
+}
+func (*UnimplementedInstanceServiceServer) GetOutputDirectories(context.Context, *GetOutputDirectoriesRequest) (*OutputDirectories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutputDirectories not implemented")
+}
+func (*UnimplementedInstanceServiceServer) GetDefaultOutputDirectories(context.Context, *GetDefaultOutputDirectoriesRequest) (*OutputDirectories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultOutputDirectories not implemented")
+}
+func (*UnimplementedInstanceServiceServer) SetOutputDirectory(context.Context, *SetOutputDirectoryRequest) (*SetOutputDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOutputDirectory not implemented")
+}
+func (*UnimplementedInstanceServiceServer) SetReadsDirectory(context.Context, *SetReadsDirectoryRequest) (*SetReadsDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReadsDirectory not implemented")
+}
+func (*UnimplementedInstanceServiceServer) GetDiskSpaceInfo(context.Context, *GetDiskSpaceInfoRequest) (*GetDiskSpaceInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskSpaceInfo not implemented")
+}
+func (*UnimplementedInstanceServiceServer) GetMachineId(context.Context, *GetMachineIdRequest) (*GetMachineIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineId not implemented")
+}
+func (*UnimplementedInstanceServiceServer) GetHostType(context.Context, *GetHostTypeRequest) (*GetHostTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostType not implemented")
+}
+func (*UnimplementedInstanceServiceServer) StreamInstanceActivity(*StreamInstanceActivityRequest, InstanceService_StreamInstanceActivityServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamInstanceActivity not implemented")
+}
+
+func RegisterInstanceServiceServer(s *grpc.Server, srv InstanceServiceServer) {
+	s.RegisterService(&_InstanceService_serviceDesc, srv)
+}
+
+func _InstanceService_GetVersionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetVersionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/GetVersionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetVersionInfo(ctx, req.(*GetVersionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetOutputDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutputDirectoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetOutputDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/GetOutputDirectories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetOutputDirectories(ctx, req.(*GetOutputDirectoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetDefaultOutputDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultOutputDirectoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetDefaultOutputDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/GetDefaultOutputDirectories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetDefaultOutputDirectories(ctx, req.(*GetDefaultOutputDirectoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_SetOutputDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOutputDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).SetOutputDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/SetOutputDirectory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).SetOutputDirectory(ctx, req.(*SetOutputDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_SetReadsDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadsDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).SetReadsDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/SetReadsDirectory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).SetReadsDirectory(ctx, req.(*SetReadsDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetDiskSpaceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskSpaceInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetDiskSpaceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/GetDiskSpaceInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetDiskSpaceInfo(ctx, req.(*GetDiskSpaceInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetMachineId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetMachineId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/GetMachineId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetMachineId(ctx, req.(*GetMachineIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetHostType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetHostType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.instance.InstanceService/GetHostType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetHostType(ctx, req.(*GetHostTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_StreamInstanceActivity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamInstanceActivityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InstanceServiceServer).StreamInstanceActivity(m, &instanceServiceStreamInstanceActivityServer{stream})
+}
+
+type InstanceService_StreamInstanceActivityServer interface {
+	Send(*StreamInstanceActivityResponse) error
+	grpc.ServerStream
+}
+
+type instanceServiceStreamInstanceActivityServer struct {
+	grpc.ServerStream
+}
+
+func (x *instanceServiceStreamInstanceActivityServer) Send(m *StreamInstanceActivityResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _InstanceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ont.rpc.instance.InstanceService",
+	HandlerType: (*InstanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "get_version_info",
+			Handler:    _InstanceService_GetVersionInfo_Handler,
+		},
+		{
+			MethodName: "get_output_directories",
+			Handler:    _InstanceService_GetOutputDirectories_Handler,
+		},
+		{
+			MethodName: "get_default_output_directories",
+			Handler:    _InstanceService_GetDefaultOutputDirectories_Handler,
+		},
+		{
+			MethodName: "set_output_directory",
+			Handler:    _InstanceService_SetOutputDirectory_Handler,
+		},
+		{
+			MethodName: "set_reads_directory",
+			Handler:    _InstanceService_SetReadsDirectory_Handler,
+		},
+		{
+			MethodName: "get_disk_space_info",
+			Handler:    _InstanceService_GetDiskSpaceInfo_Handler,
+		},
+		{
+			MethodName: "get_machine_id",
+			Handler:    _InstanceService_GetMachineId_Handler,
+		},
+		{
+			MethodName: "get_host_type",
+			Handler:    _InstanceService_GetHostType_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "stream_instance_activity",
+			Handler:       _InstanceService_StreamInstanceActivity_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "minknow/rpc/instance.proto",
+}
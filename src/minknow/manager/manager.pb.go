@@ -0,0 +1,2975 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: minknow/rpc/manager.proto
+
+package manager
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	instance "github.com/will-rowe/herald/src/minknow/instance"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type FlowCellPosition_State int32
+
+const (
+	// The flow cell position is being initialised. This state should only last for a few
+	// seconds, before moving to `STATE_RUNNING` or one of the error states.
+	FlowCellPosition_STATE_INITIALISING FlowCellPosition_State = 0
+	// The flow cell position is in a basic state of operation, and an RPC interface is
+	// available for it.
+	//
+	// `rpc_ports` is guaranteed to be set in this tate. Note that port information may also be
+	// provided in the hardware error states if the software is still running.
+	//
+	// Note that many hardware and software errors are not detectable by the manager, so
+	// `STATE_RUNNING` may be set even when there is a problem. The interface provided over
+	// `rpc_ports` should be the canonical source of the state of the flow cell position when in
+	// `STATE_RUNNING`. In the future, more errors may be tracked properly here.
+	FlowCellPosition_STATE_RUNNING FlowCellPosition_State = 1
+	// After calling reset_position(), this state may be entered to indicate the software is in
+	// the process of being stopped.
+	//
+	// Typically, this state will either transition into STATE_INITIALISING or the position will
+	// be removed entirely (although it could return to one of the hardware error states).
+	FlowCellPosition_STATE_RESETTING FlowCellPosition_State = 2
+	// The hardware corresponding to the flow cell position was removed.
+	//
+	// Only set for removable positions (ie: USB MinIONs). This state indicates that the
+	// hardware was removed, but the software is still running. The software will not be stopped
+	// automatically to prevent possible data loss. Call reset_position() to stop the software
+	// and forget about the position.
+	FlowCellPosition_STATE_HARDWARE_REMOVED FlowCellPosition_State = 3
+	// Communication with the hardware failed.
+	//
+	// For built-in positions, this can include when the hardware was not found at all (eg: if a
+	// PromethION has not had one of its sequencing unit cables attached).
+	//
+	// More information may be available in `error_info`.
+	FlowCellPosition_STATE_HARDWARE_ERROR FlowCellPosition_State = 4
+	// The software corresponding to the flow cell position could not be started (or stopped
+	// unexpectedly).
+	//
+	// More information may be available in `error_info`.
+	FlowCellPosition_STATE_SOFTWARE_ERROR FlowCellPosition_State = 5
+)
+
+// Enum value maps for FlowCellPosition_State.
+var (
+	FlowCellPosition_State_name = map[int32]string{
+		0: "STATE_INITIALISING",
+		1: "STATE_RUNNING",
+		2: "STATE_RESETTING",
+		3: "STATE_HARDWARE_REMOVED",
+		4: "STATE_HARDWARE_ERROR",
+		5: "STATE_SOFTWARE_ERROR",
+	}
+	FlowCellPosition_State_value = map[string]int32{
+		"STATE_INITIALISING":     0,
+		"STATE_RUNNING":          1,
+		"STATE_RESETTING":        2,
+		"STATE_HARDWARE_REMOVED": 3,
+		"STATE_HARDWARE_ERROR":   4,
+		"STATE_SOFTWARE_ERROR":   5,
+	}
+)
+
+func (x FlowCellPosition_State) Enum() *FlowCellPosition_State {
+	p := new(FlowCellPosition_State)
+	*p = x
+	return p
+}
+
+func (x FlowCellPosition_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlowCellPosition_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_minknow_rpc_manager_proto_enumTypes[0].Descriptor()
+}
+
+func (FlowCellPosition_State) Type() protoreflect.EnumType {
+	return &file_minknow_rpc_manager_proto_enumTypes[0]
+}
+
+func (x FlowCellPosition_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlowCellPosition_State.Descriptor instead.
+func (FlowCellPosition_State) EnumDescriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type DescribeHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DescribeHostRequest) Reset() {
+	*x = DescribeHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeHostRequest) ProtoMessage() {}
+
+func (x *DescribeHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeHostRequest.ProtoReflect.Descriptor instead.
+func (*DescribeHostRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{0}
+}
+
+type DescribeHostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The product code for the host, if applicable.
+	//
+	// If this is an integrated Oxford Nanopore device, this will be the product code of the device,
+	// or the part of the device that runs MinKNOW (eg: several PromethION models have separate
+	// product codes for the sequencing unit where the flow cells are inserted and the compute
+	// unit - this would be the product code for the compute unit).
+	//
+	// Some example product codes:
+	//
+	// ============  =================
+	// Product Code  Name
+	// ============  =================
+	// GRD-X5B001    GridION X5
+	// GRD-X5B002    GridION X5
+	// GRD-X5B003    GridION X5 Mk1
+	// MIN-101B      MinIT
+	// MIN-101C      MinION Mk1C
+	// PRO-PRMC01    PromethION Beta
+	// PRO-PRC024    PromethION 24
+	// PRO-PRC048    PromethION 48
+	// ONT-314       PromethION (Production)
+	//
+	// If MinKNOW is running on a machine that is not provided by Oxford Nanopore (ie: their own
+	// laptop or desktop machine with a MinION plugged in), this field will be empty.
+	ProductCode string `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	// A human-readable name for the type of host.
+	//
+	// If `product_code` is set, it will be the name of the model of instrument (see the table in
+	// the `product_code` field). Otherwise it will be empty.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The serial of Nanopore device.
+	//
+	// If `product_code` is empty, this will also be empty.
+	//
+	// This uniquely identifies the device.
+	//
+	// See also `network_name`.
+	Serial string `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	// The network name of the host.
+	//
+	// This is the name the host system believes it has on the network. This can be useful if an IP
+	// address was used to connect to the RPC system.
+	//
+	// For customer computers, this is the only available identfying information for the system.
+	NetworkName string `protobuf:"bytes,4,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`
+}
+
+func (x *DescribeHostResponse) Reset() {
+	*x = DescribeHostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeHostResponse) ProtoMessage() {}
+
+func (x *DescribeHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeHostResponse.ProtoReflect.Descriptor instead.
+func (*DescribeHostResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DescribeHostResponse) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *DescribeHostResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DescribeHostResponse) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *DescribeHostResponse) GetNetworkName() string {
+	if x != nil {
+		return x.NetworkName
+	}
+	return ""
+}
+
+type FlowCellPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the position.
+	//
+	// For MinIONs, this is the name of the MinION (eg: MN12345). For integrated positions, this is
+	// the label for the position on the sequencer (eg: X1 for GridION, 1-A1-D1 for PromethION).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// For integrated flow cell positions, indicates where it is on the sequencing unit.
+	//
+	// This information is not provided for MinIONs (except for the MinION Mk1C, in which case the
+	// position is always 0, 0).
+	Location *FlowCellPosition_Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// The state of the flow cell position.
+	//
+	// If the state is not `STATE_RUNNING` or `STATE_INITIALISING`, the flow cell position can be
+	// assumed to be unusable, and the `error_info` field should be populated.
+	State FlowCellPosition_State `protobuf:"varint,3,opt,name=state,proto3,enum=ont.rpc.manager.FlowCellPosition_State" json:"state,omitempty"`
+	// The ports the APIs for this flow cell position are provided on.
+	//
+	// Always provided if `state` is `STATE_RUNNING`. May also be provided when `state` is one of
+	// the hardware errors if the software is still running.
+	RpcPorts *FlowCellPosition_RpcPorts `protobuf:"bytes,4,opt,name=rpc_ports,json=rpcPorts,proto3" json:"rpc_ports,omitempty"`
+	// Provides a textual description of error states.
+	//
+	// When `state` is not `STATE_INITIALISING`, `STATE_RUNNING` or `STATE_RESETTING`, this provides
+	// some information (in English) about the error. This will be a textual description of the
+	// value in `state`, possibly with extra information about the error (if available).
+	//
+	// This can be useful for dealing with (new) unknown states.
+	ErrorInfo string `protobuf:"bytes,5,opt,name=error_info,json=errorInfo,proto3" json:"error_info,omitempty"`
+}
+
+func (x *FlowCellPosition) Reset() {
+	*x = FlowCellPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowCellPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowCellPosition) ProtoMessage() {}
+
+func (x *FlowCellPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowCellPosition.ProtoReflect.Descriptor instead.
+func (*FlowCellPosition) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlowCellPosition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlowCellPosition) GetLocation() *FlowCellPosition_Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *FlowCellPosition) GetState() FlowCellPosition_State {
+	if x != nil {
+		return x.State
+	}
+	return FlowCellPosition_STATE_INITIALISING
+}
+
+func (x *FlowCellPosition) GetRpcPorts() *FlowCellPosition_RpcPorts {
+	if x != nil {
+		return x.RpcPorts
+	}
+	return nil
+}
+
+func (x *FlowCellPosition) GetErrorInfo() string {
+	if x != nil {
+		return x.ErrorInfo
+	}
+	return ""
+}
+
+type FlowCellPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FlowCellPositionsRequest) Reset() {
+	*x = FlowCellPositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowCellPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowCellPositionsRequest) ProtoMessage() {}
+
+func (x *FlowCellPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowCellPositionsRequest.ProtoReflect.Descriptor instead.
+func (*FlowCellPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{3}
+}
+
+type FlowCellPositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How many positions are available in total.
+	//
+	// This is the same in every message returned from a single call, and allows the caller to
+	// easily see how many further positions might be in subsequent messages.
+	TotalCount int32 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Known flow cell positions.
+	//
+	// Note that not all positions listed here are necessarily available. In particular, integrated
+	// flow cell positions (eg: on GridIONs or PromethIONs) will always be listed.
+	Positions []*FlowCellPosition `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+}
+
+func (x *FlowCellPositionsResponse) Reset() {
+	*x = FlowCellPositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowCellPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowCellPositionsResponse) ProtoMessage() {}
+
+func (x *FlowCellPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowCellPositionsResponse.ProtoReflect.Descriptor instead.
+func (*FlowCellPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlowCellPositionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *FlowCellPositionsResponse) GetPositions() []*FlowCellPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type WatchFlowCellPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchFlowCellPositionsRequest) Reset() {
+	*x = WatchFlowCellPositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchFlowCellPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFlowCellPositionsRequest) ProtoMessage() {}
+
+func (x *WatchFlowCellPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFlowCellPositionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchFlowCellPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{5}
+}
+
+// Each flow cell position will first be listed in `additions`. After that, it may appear in
+// `changes` in zero or more messages. Then, it may appear in `removals` once, after which it will
+// either never appear again, or appear in `additions` again (restarting the cycle).
+//
+// No position will ever appear in more than one field in the same response message.
+type WatchFlowCellPositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Flow cell positions that are new (to this call).
+	//
+	// Contains flow cell positions that have not been seen before on this call (or have re-appeared
+	// after being removed).
+	//
+	// Note that a flow cell position being listed here does not mean it is available. In
+	// particular, integrated flow cell positions (eg: on GridIONs or PromethIONs) will always be
+	// provided, so that they can be positioned appropriately.
+	Additions []*FlowCellPosition `protobuf:"bytes,1,rep,name=additions,proto3" json:"additions,omitempty"`
+	// Flow cell positions that have changed state.
+	//
+	// Every flow cell position provided in this list will have previously been provided in
+	// `additions`.
+	Changes []*FlowCellPosition `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	// A flow cell position has been removed.
+	//
+	// Note that this can only happen with MinIONs - integrated flow cell positions are never
+	// removed (if they become unavailable, this will be noted in the `changes` field).
+	Removals []string `protobuf:"bytes,3,rep,name=removals,proto3" json:"removals,omitempty"`
+}
+
+func (x *WatchFlowCellPositionsResponse) Reset() {
+	*x = WatchFlowCellPositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchFlowCellPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFlowCellPositionsResponse) ProtoMessage() {}
+
+func (x *WatchFlowCellPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFlowCellPositionsResponse.ProtoReflect.Descriptor instead.
+func (*WatchFlowCellPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchFlowCellPositionsResponse) GetAdditions() []*FlowCellPosition {
+	if x != nil {
+		return x.Additions
+	}
+	return nil
+}
+
+func (x *WatchFlowCellPositionsResponse) GetChanges() []*FlowCellPosition {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *WatchFlowCellPositionsResponse) GetRemovals() []string {
+	if x != nil {
+		return x.Removals
+	}
+	return nil
+}
+
+type ResetPositionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the position to restart.
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// Force the software to be restarted even when it appears to be in a healthy state (ie:
+	// STATE_RUNNING).
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *ResetPositionRequest) Reset() {
+	*x = ResetPositionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPositionRequest) ProtoMessage() {}
+
+func (x *ResetPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPositionRequest.ProtoReflect.Descriptor instead.
+func (*ResetPositionRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResetPositionRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ResetPositionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ResetPositionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetPositionResponse) Reset() {
+	*x = ResetPositionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPositionResponse) ProtoMessage() {}
+
+func (x *ResetPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPositionResponse.ProtoReflect.Descriptor instead.
+func (*ResetPositionResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{8}
+}
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{9}
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Devices that haven't been started
+	Inactive []string `protobuf:"bytes,1,rep,name=inactive,proto3" json:"inactive,omitempty"`
+	// Devices that are in the process of being started. A call to stop this device will not work until it has fully started
+	Pending []string `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending,omitempty"`
+	// Devices that have started, along with their port information
+	Active []*ListDevicesResponse_ActiveDevice `protobuf:"bytes,3,rep,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListDevicesResponse) GetInactive() []string {
+	if x != nil {
+		return x.Inactive
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse) GetPending() []string {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse) GetActive() []*ListDevicesResponse_ActiveDevice {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+type BasecallerApiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BasecallerApiRequest) Reset() {
+	*x = BasecallerApiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BasecallerApiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasecallerApiRequest) ProtoMessage() {}
+
+func (x *BasecallerApiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasecallerApiRequest.ProtoReflect.Descriptor instead.
+func (*BasecallerApiRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{11}
+}
+
+type BasecallerApiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The port to use to access the minknow.rpc.basecaller API using the standard gRPC protocol
+	// over TLS
+	Secure uint32 `protobuf:"varint,1,opt,name=secure,proto3" json:"secure,omitempty"`
+	// The port to use to access the minknow.rpc.basecaller API using the standard gRPC protocol
+	// without TLS
+	Insecure uint32 `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// The port to use to access the minknow.rpc.basecaller API using the gRPC-Web protocol over TLS
+	SecureGrpcWeb uint32 `protobuf:"varint,3,opt,name=secure_grpc_web,json=secureGrpcWeb,proto3" json:"secure_grpc_web,omitempty"`
+	// The port to use to access the minknow.rpc.basecaller API using the gRPC-Web protocol without
+	// TLS
+	InsecureGrpcWeb uint32 `protobuf:"varint,4,opt,name=insecure_grpc_web,json=insecureGrpcWeb,proto3" json:"insecure_grpc_web,omitempty"`
+}
+
+func (x *BasecallerApiResponse) Reset() {
+	*x = BasecallerApiResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BasecallerApiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasecallerApiResponse) ProtoMessage() {}
+
+func (x *BasecallerApiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasecallerApiResponse.ProtoReflect.Descriptor instead.
+func (*BasecallerApiResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BasecallerApiResponse) GetSecure() uint32 {
+	if x != nil {
+		return x.Secure
+	}
+	return 0
+}
+
+func (x *BasecallerApiResponse) GetInsecure() uint32 {
+	if x != nil {
+		return x.Insecure
+	}
+	return 0
+}
+
+func (x *BasecallerApiResponse) GetSecureGrpcWeb() uint32 {
+	if x != nil {
+		return x.SecureGrpcWeb
+	}
+	return 0
+}
+
+func (x *BasecallerApiResponse) GetInsecureGrpcWeb() uint32 {
+	if x != nil {
+		return x.InsecureGrpcWeb
+	}
+	return 0
+}
+
+type GetVersionInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVersionInfoRequest) Reset() {
+	*x = GetVersionInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionInfoRequest) ProtoMessage() {}
+
+func (x *GetVersionInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{13}
+}
+
+type GetVersionInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// What minknow version is installed. Split into major, minor and patch versions
+	// Also includes the full version as a string, which contain the major, minor and patch numbers
+	// as well as if the version is pre-release version (-pre), whether it is a release candidate (-rc#)
+	// or whether it is a variant version (i.e. for conferences) (-variant). For non-release builds it also
+	// includes the hash of the commit it is based on, and whether the working copy is different from that has (-dirty)
+	Minknow *instance.GetVersionInfoResponse_MinknowVersion `protobuf:"bytes,1,opt,name=minknow,proto3" json:"minknow,omitempty"`
+	// The protocols version specifies what version of bream is installed.
+	// By default is set to '0.0.0.0', but will otherwise be set to a Bream release version.
+	Protocols string `protobuf:"bytes,2,opt,name=protocols,proto3" json:"protocols,omitempty"`
+	// Describes the distribution that this MinKNOW installation is part of, ususally
+	// this will be the Metapackage version number/identity, this will be "unknown"
+	// if the distribution-version hasn't been set. This information is also communicated
+	// in the Manager's DaemonMessage in daemon.proto
+	DistributionVersion string `protobuf:"bytes,3,opt,name=distribution_version,json=distributionVersion,proto3" json:"distribution_version,omitempty"`
+	// Indicates if the MinKNOW distribution including components such as Bream
+	// are stable, unstable or have been modified.
+	DistributionStatus instance.GetVersionInfoResponse_DistributionStatus `protobuf:"varint,4,opt,name=distribution_status,json=distributionStatus,proto3,enum=ont.rpc.instance.GetVersionInfoResponse_DistributionStatus" json:"distribution_status,omitempty"`
+	// Version of guppy MinKNOW was packaged against.
+	GuppyBuildVersion string `protobuf:"bytes,5,opt,name=guppy_build_version,json=guppyBuildVersion,proto3" json:"guppy_build_version,omitempty"`
+	// Version of guppy MinKNOW running with.
+	GuppyConnectedVersion string `protobuf:"bytes,6,opt,name=guppy_connected_version,json=guppyConnectedVersion,proto3" json:"guppy_connected_version,omitempty"`
+	// The configuration version specifies what version of ont-configuration (wanda) is installed.
+	// By default is set to '0.0.0.0', but will otherwise be set to a Wanda release version.
+	//
+	// Since 3.6
+	Configuration string `protobuf:"bytes,7,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *GetVersionInfoResponse) Reset() {
+	*x = GetVersionInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionInfoResponse) ProtoMessage() {}
+
+func (x *GetVersionInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionInfoResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetVersionInfoResponse) GetMinknow() *instance.GetVersionInfoResponse_MinknowVersion {
+	if x != nil {
+		return x.Minknow
+	}
+	return nil
+}
+
+func (x *GetVersionInfoResponse) GetProtocols() string {
+	if x != nil {
+		return x.Protocols
+	}
+	return ""
+}
+
+func (x *GetVersionInfoResponse) GetDistributionVersion() string {
+	if x != nil {
+		return x.DistributionVersion
+	}
+	return ""
+}
+
+func (x *GetVersionInfoResponse) GetDistributionStatus() instance.GetVersionInfoResponse_DistributionStatus {
+	if x != nil {
+		return x.DistributionStatus
+	}
+	return instance.GetVersionInfoResponse_UNKNOWN
+}
+
+func (x *GetVersionInfoResponse) GetGuppyBuildVersion() string {
+	if x != nil {
+		return x.GuppyBuildVersion
+	}
+	return ""
+}
+
+func (x *GetVersionInfoResponse) GetGuppyConnectedVersion() string {
+	if x != nil {
+		return x.GuppyConnectedVersion
+	}
+	return ""
+}
+
+func (x *GetVersionInfoResponse) GetConfiguration() string {
+	if x != nil {
+		return x.Configuration
+	}
+	return ""
+}
+
+type ListProtocolOutputDirFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify the root path to list files from. If the path is left empty, then the base protocol output directory
+	// will be used.
+	//
+	// Note that the path specified HAS to be a descendant of the protocol output directory, otherwise a INVALID_ARGUMENT
+	// error will be returned
+	//
+	// If the path is left empty, and the path specified in the user config doesn't exist, then the NOT_FOUND error code
+	// will be returned
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ListProtocolOutputDirFilesRequest) Reset() {
+	*x = ListProtocolOutputDirFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolOutputDirFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolOutputDirFilesRequest) ProtoMessage() {}
+
+func (x *ListProtocolOutputDirFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolOutputDirFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListProtocolOutputDirFilesRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListProtocolOutputDirFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ListProtocolOutputDirFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of sub-directories in the directory specified
+	Directories []*ListProtocolOutputDirFilesResponse_DirectoryInfo `protobuf:"bytes,1,rep,name=directories,proto3" json:"directories,omitempty"`
+	// List of files in the directory specified
+	Files []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	// The absolute directory that is being listed within this repsonse
+	CurrentListedPath string `protobuf:"bytes,3,opt,name=current_listed_path,json=currentListedPath,proto3" json:"current_listed_path,omitempty"`
+}
+
+func (x *ListProtocolOutputDirFilesResponse) Reset() {
+	*x = ListProtocolOutputDirFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolOutputDirFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolOutputDirFilesResponse) ProtoMessage() {}
+
+func (x *ListProtocolOutputDirFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolOutputDirFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListProtocolOutputDirFilesResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListProtocolOutputDirFilesResponse) GetDirectories() []*ListProtocolOutputDirFilesResponse_DirectoryInfo {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+func (x *ListProtocolOutputDirFilesResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ListProtocolOutputDirFilesResponse) GetCurrentListedPath() string {
+	if x != nil {
+		return x.CurrentListedPath
+	}
+	return ""
+}
+
+type CreateDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path at which to create the directory.
+	//
+	// This must exist, be a directory, and be within the protocol output directory. This can be
+	// determined via calls to list_protocol_output_dir_files().
+	ParentPath string `protobuf:"bytes,1,opt,name=parent_path,json=parentPath,proto3" json:"parent_path,omitempty"`
+	// The name of the directory to create.
+	//
+	// This must be a single path component (ie: it cannot contain '/' or '\'). There may be other
+	// restrictions on valid characters, depending on the operating system.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateDirectoryRequest) Reset() {
+	*x = CreateDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDirectoryRequest) ProtoMessage() {}
+
+func (x *CreateDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateDirectoryRequest) GetParentPath() string {
+	if x != nil {
+		return x.ParentPath
+	}
+	return ""
+}
+
+func (x *CreateDirectoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path to the created directory.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *CreateDirectoryResponse) Reset() {
+	*x = CreateDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDirectoryResponse) ProtoMessage() {}
+
+func (x *CreateDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FlowCellPosition_Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The column (counting from 0, left-to-right) of the flow cell position on the sequencing
+	// unit when viewed from above/in front.
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// The row (counting from 0, top-to-bottom) of the flow cell position on the sequencing
+	// unit when viewed from above/in front.
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *FlowCellPosition_Location) Reset() {
+	*x = FlowCellPosition_Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowCellPosition_Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowCellPosition_Location) ProtoMessage() {}
+
+func (x *FlowCellPosition_Location) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowCellPosition_Location.ProtoReflect.Descriptor instead.
+func (*FlowCellPosition_Location) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *FlowCellPosition_Location) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *FlowCellPosition_Location) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type FlowCellPosition_RpcPorts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A port providing the standard gRPC protocol over TLS
+	Secure uint32 `protobuf:"varint,1,opt,name=secure,proto3" json:"secure,omitempty"`
+	// A port providing the standard gRPC protocol without TLS
+	Insecure uint32 `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// A port providing the gRPC-Web protocol over TLS
+	SecureGrpcWeb uint32 `protobuf:"varint,3,opt,name=secure_grpc_web,json=secureGrpcWeb,proto3" json:"secure_grpc_web,omitempty"`
+	// A port providing the gRPC-Web protocol without TLS
+	InsecureGrpcWeb uint32 `protobuf:"varint,4,opt,name=insecure_grpc_web,json=insecureGrpcWeb,proto3" json:"insecure_grpc_web,omitempty"`
+}
+
+func (x *FlowCellPosition_RpcPorts) Reset() {
+	*x = FlowCellPosition_RpcPorts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowCellPosition_RpcPorts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowCellPosition_RpcPorts) ProtoMessage() {}
+
+func (x *FlowCellPosition_RpcPorts) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowCellPosition_RpcPorts.ProtoReflect.Descriptor instead.
+func (*FlowCellPosition_RpcPorts) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *FlowCellPosition_RpcPorts) GetSecure() uint32 {
+	if x != nil {
+		return x.Secure
+	}
+	return 0
+}
+
+func (x *FlowCellPosition_RpcPorts) GetInsecure() uint32 {
+	if x != nil {
+		return x.Insecure
+	}
+	return 0
+}
+
+func (x *FlowCellPosition_RpcPorts) GetSecureGrpcWeb() uint32 {
+	if x != nil {
+		return x.SecureGrpcWeb
+	}
+	return 0
+}
+
+func (x *FlowCellPosition_RpcPorts) GetInsecureGrpcWeb() uint32 {
+	if x != nil {
+		return x.InsecureGrpcWeb
+	}
+	return 0
+}
+
+type ListDevicesResponse_RpcPorts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for the old style of rpc using json
+	//
+	// Deprecated, as new rpc's are not being added to this service
+	Jsonrpc uint32 `protobuf:"varint,1,opt,name=jsonrpc,proto3" json:"jsonrpc,omitempty"`
+	// Connects to ws_longpoll process and provides engine state updates
+	//
+	// Deprecated, as minknow is phasing out public access to engine states
+	JsonWebsocket uint32 `protobuf:"varint,2,opt,name=json_websocket,json=jsonWebsocket,proto3" json:"json_websocket,omitempty"` // Deprecated
+	// Secure connection to the grpc service over TLS
+	Secure uint32 `protobuf:"varint,3,opt,name=secure,proto3" json:"secure,omitempty"`
+	// Direct connection to the (insecure) grpc connection. You would connect this via the method
+	// described in the grpc documentation for your language.
+	//
+	// This is the "real" grpc port in that in connects directly to the grpc server. Most connections from python
+	// and other non-web languages should use this port
+	InsecureGrpc uint32 `protobuf:"varint,4,opt,name=insecure_grpc,json=insecureGrpc,proto3" json:"insecure_grpc,omitempty"`
+	// Provides a HTTP/1.1 interface to the grpc connection. Intended for connecting with the browser over javascript
+	// https://github.com/improbable-eng/grpc-web
+	InsecureWeb uint32 `protobuf:"varint,5,opt,name=insecure_web,json=insecureWeb,proto3" json:"insecure_web,omitempty"`
+}
+
+func (x *ListDevicesResponse_RpcPorts) Reset() {
+	*x = ListDevicesResponse_RpcPorts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesResponse_RpcPorts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse_RpcPorts) ProtoMessage() {}
+
+func (x *ListDevicesResponse_RpcPorts) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse_RpcPorts.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse_RpcPorts) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *ListDevicesResponse_RpcPorts) GetJsonrpc() uint32 {
+	if x != nil {
+		return x.Jsonrpc
+	}
+	return 0
+}
+
+func (x *ListDevicesResponse_RpcPorts) GetJsonWebsocket() uint32 {
+	if x != nil {
+		return x.JsonWebsocket
+	}
+	return 0
+}
+
+func (x *ListDevicesResponse_RpcPorts) GetSecure() uint32 {
+	if x != nil {
+		return x.Secure
+	}
+	return 0
+}
+
+func (x *ListDevicesResponse_RpcPorts) GetInsecureGrpc() uint32 {
+	if x != nil {
+		return x.InsecureGrpc
+	}
+	return 0
+}
+
+func (x *ListDevicesResponse_RpcPorts) GetInsecureWeb() uint32 {
+	if x != nil {
+		return x.InsecureWeb
+	}
+	return 0
+}
+
+// Message used to describe how a device is laid out on the physical device.
+type ListDevicesResponse_DeviceLayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Zero based index positioning device in the horizontal direction from a top-down orientation.
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// Zero based index positioning device in the vertical direction from a top-down orientation.
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *ListDevicesResponse_DeviceLayout) Reset() {
+	*x = ListDevicesResponse_DeviceLayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesResponse_DeviceLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse_DeviceLayout) ProtoMessage() {}
+
+func (x *ListDevicesResponse_DeviceLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse_DeviceLayout.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse_DeviceLayout) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *ListDevicesResponse_DeviceLayout) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ListDevicesResponse_DeviceLayout) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type ListDevicesResponse_ActiveDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ports *ListDevicesResponse_RpcPorts `protobuf:"bytes,2,opt,name=ports,proto3" json:"ports,omitempty"`
+	// Device layout information, inidcating this devices physical location.
+	//
+	// The information will be omitted in cases where there is not a fixed layout (eg. minion)
+	//
+	// Since 3.3
+	Layout *ListDevicesResponse_DeviceLayout `protobuf:"bytes,3,opt,name=layout,proto3" json:"layout,omitempty"`
+}
+
+func (x *ListDevicesResponse_ActiveDevice) Reset() {
+	*x = ListDevicesResponse_ActiveDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesResponse_ActiveDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse_ActiveDevice) ProtoMessage() {}
+
+func (x *ListDevicesResponse_ActiveDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse_ActiveDevice.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse_ActiveDevice) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *ListDevicesResponse_ActiveDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListDevicesResponse_ActiveDevice) GetPorts() *ListDevicesResponse_RpcPorts {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse_ActiveDevice) GetLayout() *ListDevicesResponse_DeviceLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+type ListProtocolOutputDirFilesResponse_DirectoryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DirectoryCount int32  `protobuf:"varint,2,opt,name=directory_count,json=directoryCount,proto3" json:"directory_count,omitempty"`
+	FileCount      int32  `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+}
+
+func (x *ListProtocolOutputDirFilesResponse_DirectoryInfo) Reset() {
+	*x = ListProtocolOutputDirFilesResponse_DirectoryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_manager_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolOutputDirFilesResponse_DirectoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolOutputDirFilesResponse_DirectoryInfo) ProtoMessage() {}
+
+func (x *ListProtocolOutputDirFilesResponse_DirectoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_manager_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolOutputDirFilesResponse_DirectoryInfo.ProtoReflect.Descriptor instead.
+func (*ListProtocolOutputDirFilesResponse_DirectoryInfo) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_manager_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *ListProtocolOutputDirFilesResponse_DirectoryInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListProtocolOutputDirFilesResponse_DirectoryInfo) GetDirectoryCount() int32 {
+	if x != nil {
+		return x.DirectoryCount
+	}
+	return 0
+}
+
+func (x *ListProtocolOutputDirFilesResponse_DirectoryInfo) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+var File_minknow_rpc_manager_proto protoreflect.FileDescriptor
+
+var file_minknow_rpc_manager_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x1a, 0x1a, 0x6d, 0x69,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x96, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xec, 0x04, 0x0a, 0x10, 0x46, 0x6c, 0x6f,
+	0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x46, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43,
+	0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x6c,
+	0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
+	0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x08, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x1a, 0x26, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x1a, 0x92, 0x01, 0x0a, 0x08, 0x52, 0x70, 0x63,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x77, 0x65, 0x62, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x47, 0x72, 0x70, 0x63, 0x57, 0x65,
+	0x62, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x5f, 0x77, 0x65, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x47, 0x72, 0x70, 0x63, 0x57, 0x65, 0x62, 0x22, 0x97, 0x01,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45,
+	0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x48, 0x41, 0x52, 0x44, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x41, 0x52,
+	0x44, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x22, 0x1a, 0x0a, 0x18, 0x46, 0x6c, 0x6f, 0x77, 0x43,
+	0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x7d, 0x0a, 0x19, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3f, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x43,
+	0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x1e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x6f,
+	0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x77,
+	0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43,
+	0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x22, 0x48, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa5, 0x04, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x1a, 0xab, 0x01, 0x0a, 0x08, 0x52, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x12, 0x25, 0x0a, 0x0e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x47, 0x72, 0x70, 0x63, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x62, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x57, 0x65, 0x62,
+	0x1a, 0x2a, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x1a, 0xb2, 0x01, 0x0a,
+	0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x43, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x61, 0x73, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41,
+	0x70, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x42, 0x61,
+	0x73, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x77, 0x65, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x47, 0x72, 0x70, 0x63, 0x57, 0x65, 0x62, 0x12,
+	0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x77, 0x65, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x65, 0x47, 0x72, 0x70, 0x63, 0x57, 0x65, 0x62, 0x22, 0x17, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xb8, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x51, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x12, 0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x3b, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x64,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x67, 0x75, 0x70, 0x70, 0x79, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x67, 0x75, 0x70, 0x70, 0x79, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x17, 0x67, 0x75, 0x70, 0x70, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x67, 0x75, 0x70, 0x70, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x37, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xbc, 0x02, 0x0a, 0x22, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44,
+	0x69, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x63, 0x0a, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x6b, 0x0a, 0x0d, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x32, 0xe8, 0x07, 0x0a, 0x0e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46,
+	0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65,
+	0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x6f,
+	0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x6f,
+	0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a,
+	0x0e, 0x62, 0x61, 0x73, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x12,
+	0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x70, 0x69, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x65, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x64, 0x69, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44,
+	0x69, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
+	0x69, 0x6c, 0x6c, 0x2d, 0x72, 0x6f, 0x77, 0x65, 0x2f, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x2f,
+	0x73, 0x72, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_minknow_rpc_manager_proto_rawDescOnce sync.Once
+	file_minknow_rpc_manager_proto_rawDescData = file_minknow_rpc_manager_proto_rawDesc
+)
+
+func file_minknow_rpc_manager_proto_rawDescGZIP() []byte {
+	file_minknow_rpc_manager_proto_rawDescOnce.Do(func() {
+		file_minknow_rpc_manager_proto_rawDescData = protoimpl.X.CompressGZIP(file_minknow_rpc_manager_proto_rawDescData)
+	})
+	return file_minknow_rpc_manager_proto_rawDescData
+}
+
+var file_minknow_rpc_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_minknow_rpc_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_minknow_rpc_manager_proto_goTypes = []interface{}{
+	(FlowCellPosition_State)(0),                              // 0: ont.rpc.manager.FlowCellPosition.State
+	(*DescribeHostRequest)(nil),                              // 1: ont.rpc.manager.DescribeHostRequest
+	(*DescribeHostResponse)(nil),                             // 2: ont.rpc.manager.DescribeHostResponse
+	(*FlowCellPosition)(nil),                                 // 3: ont.rpc.manager.FlowCellPosition
+	(*FlowCellPositionsRequest)(nil),                         // 4: ont.rpc.manager.FlowCellPositionsRequest
+	(*FlowCellPositionsResponse)(nil),                        // 5: ont.rpc.manager.FlowCellPositionsResponse
+	(*WatchFlowCellPositionsRequest)(nil),                    // 6: ont.rpc.manager.WatchFlowCellPositionsRequest
+	(*WatchFlowCellPositionsResponse)(nil),                   // 7: ont.rpc.manager.WatchFlowCellPositionsResponse
+	(*ResetPositionRequest)(nil),                             // 8: ont.rpc.manager.ResetPositionRequest
+	(*ResetPositionResponse)(nil),                            // 9: ont.rpc.manager.ResetPositionResponse
+	(*ListDevicesRequest)(nil),                               // 10: ont.rpc.manager.ListDevicesRequest
+	(*ListDevicesResponse)(nil),                              // 11: ont.rpc.manager.ListDevicesResponse
+	(*BasecallerApiRequest)(nil),                             // 12: ont.rpc.manager.BasecallerApiRequest
+	(*BasecallerApiResponse)(nil),                            // 13: ont.rpc.manager.BasecallerApiResponse
+	(*GetVersionInfoRequest)(nil),                            // 14: ont.rpc.manager.GetVersionInfoRequest
+	(*GetVersionInfoResponse)(nil),                           // 15: ont.rpc.manager.GetVersionInfoResponse
+	(*ListProtocolOutputDirFilesRequest)(nil),                // 16: ont.rpc.manager.ListProtocolOutputDirFilesRequest
+	(*ListProtocolOutputDirFilesResponse)(nil),               // 17: ont.rpc.manager.ListProtocolOutputDirFilesResponse
+	(*CreateDirectoryRequest)(nil),                           // 18: ont.rpc.manager.CreateDirectoryRequest
+	(*CreateDirectoryResponse)(nil),                          // 19: ont.rpc.manager.CreateDirectoryResponse
+	(*FlowCellPosition_Location)(nil),                        // 20: ont.rpc.manager.FlowCellPosition.Location
+	(*FlowCellPosition_RpcPorts)(nil),                        // 21: ont.rpc.manager.FlowCellPosition.RpcPorts
+	(*ListDevicesResponse_RpcPorts)(nil),                     // 22: ont.rpc.manager.ListDevicesResponse.RpcPorts
+	(*ListDevicesResponse_DeviceLayout)(nil),                 // 23: ont.rpc.manager.ListDevicesResponse.DeviceLayout
+	(*ListDevicesResponse_ActiveDevice)(nil),                 // 24: ont.rpc.manager.ListDevicesResponse.ActiveDevice
+	(*ListProtocolOutputDirFilesResponse_DirectoryInfo)(nil), // 25: ont.rpc.manager.ListProtocolOutputDirFilesResponse.DirectoryInfo
+	(*instance.GetVersionInfoResponse_MinknowVersion)(nil),   // 26: ont.rpc.instance.GetVersionInfoResponse.MinknowVersion
+	(instance.GetVersionInfoResponse_DistributionStatus)(0),  // 27: ont.rpc.instance.GetVersionInfoResponse.DistributionStatus
+}
+var file_minknow_rpc_manager_proto_depIdxs = []int32{
+	20, // 0: ont.rpc.manager.FlowCellPosition.location:type_name -> ont.rpc.manager.FlowCellPosition.Location
+	0,  // 1: ont.rpc.manager.FlowCellPosition.state:type_name -> ont.rpc.manager.FlowCellPosition.State
+	21, // 2: ont.rpc.manager.FlowCellPosition.rpc_ports:type_name -> ont.rpc.manager.FlowCellPosition.RpcPorts
+	3,  // 3: ont.rpc.manager.FlowCellPositionsResponse.positions:type_name -> ont.rpc.manager.FlowCellPosition
+	3,  // 4: ont.rpc.manager.WatchFlowCellPositionsResponse.additions:type_name -> ont.rpc.manager.FlowCellPosition
+	3,  // 5: ont.rpc.manager.WatchFlowCellPositionsResponse.changes:type_name -> ont.rpc.manager.FlowCellPosition
+	24, // 6: ont.rpc.manager.ListDevicesResponse.active:type_name -> ont.rpc.manager.ListDevicesResponse.ActiveDevice
+	26, // 7: ont.rpc.manager.GetVersionInfoResponse.minknow:type_name -> ont.rpc.instance.GetVersionInfoResponse.MinknowVersion
+	27, // 8: ont.rpc.manager.GetVersionInfoResponse.distribution_status:type_name -> ont.rpc.instance.GetVersionInfoResponse.DistributionStatus
+	25, // 9: ont.rpc.manager.ListProtocolOutputDirFilesResponse.directories:type_name -> ont.rpc.manager.ListProtocolOutputDirFilesResponse.DirectoryInfo
+	22, // 10: ont.rpc.manager.ListDevicesResponse.ActiveDevice.ports:type_name -> ont.rpc.manager.ListDevicesResponse.RpcPorts
+	23, // 11: ont.rpc.manager.ListDevicesResponse.ActiveDevice.layout:type_name -> ont.rpc.manager.ListDevicesResponse.DeviceLayout
+	1,  // 12: ont.rpc.manager.ManagerService.describe_host:input_type -> ont.rpc.manager.DescribeHostRequest
+	10, // 13: ont.rpc.manager.ManagerService.list_devices:input_type -> ont.rpc.manager.ListDevicesRequest
+	4,  // 14: ont.rpc.manager.ManagerService.flow_cell_positions:input_type -> ont.rpc.manager.FlowCellPositionsRequest
+	6,  // 15: ont.rpc.manager.ManagerService.watch_flow_cell_positions:input_type -> ont.rpc.manager.WatchFlowCellPositionsRequest
+	8,  // 16: ont.rpc.manager.ManagerService.reset_position:input_type -> ont.rpc.manager.ResetPositionRequest
+	12, // 17: ont.rpc.manager.ManagerService.basecaller_api:input_type -> ont.rpc.manager.BasecallerApiRequest
+	14, // 18: ont.rpc.manager.ManagerService.get_version_info:input_type -> ont.rpc.manager.GetVersionInfoRequest
+	16, // 19: ont.rpc.manager.ManagerService.list_protocol_output_dir_files:input_type -> ont.rpc.manager.ListProtocolOutputDirFilesRequest
+	18, // 20: ont.rpc.manager.ManagerService.create_directory:input_type -> ont.rpc.manager.CreateDirectoryRequest
+	2,  // 21: ont.rpc.manager.ManagerService.describe_host:output_type -> ont.rpc.manager.DescribeHostResponse
+	11, // 22: ont.rpc.manager.ManagerService.list_devices:output_type -> ont.rpc.manager.ListDevicesResponse
+	5,  // 23: ont.rpc.manager.ManagerService.flow_cell_positions:output_type -> ont.rpc.manager.FlowCellPositionsResponse
+	7,  // 24: ont.rpc.manager.ManagerService.watch_flow_cell_positions:output_type -> ont.rpc.manager.WatchFlowCellPositionsResponse
+	9,  // 25: ont.rpc.manager.ManagerService.reset_position:output_type -> ont.rpc.manager.ResetPositionResponse
+	13, // 26: ont.rpc.manager.ManagerService.basecaller_api:output_type -> ont.rpc.manager.BasecallerApiResponse
+	15, // 27: ont.rpc.manager.ManagerService.get_version_info:output_type -> ont.rpc.manager.GetVersionInfoResponse
+	17, // 28: ont.rpc.manager.ManagerService.list_protocol_output_dir_files:output_type -> ont.rpc.manager.ListProtocolOutputDirFilesResponse
+	19, // 29: ont.rpc.manager.ManagerService.create_directory:output_type -> ont.rpc.manager.CreateDirectoryResponse
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_minknow_rpc_manager_proto_init() }
+func file_minknow_rpc_manager_proto_init() {
+	if File_minknow_rpc_manager_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_minknow_rpc_manager_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeHostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowCellPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowCellPositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowCellPositionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchFlowCellPositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchFlowCellPositionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPositionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPositionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasecallerApiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasecallerApiResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolOutputDirFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolOutputDirFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDirectoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowCellPosition_Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowCellPosition_RpcPorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesResponse_RpcPorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesResponse_DeviceLayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesResponse_ActiveDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_manager_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolOutputDirFilesResponse_DirectoryInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_minknow_rpc_manager_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_minknow_rpc_manager_proto_goTypes,
+		DependencyIndexes: file_minknow_rpc_manager_proto_depIdxs,
+		EnumInfos:         file_minknow_rpc_manager_proto_enumTypes,
+		MessageInfos:      file_minknow_rpc_manager_proto_msgTypes,
+	}.Build()
+	File_minknow_rpc_manager_proto = out.File
+	file_minknow_rpc_manager_proto_rawDesc = nil
+	file_minknow_rpc_manager_proto_goTypes = nil
+	file_minknow_rpc_manager_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ManagerServiceClient is the client API for ManagerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagerServiceClient interface {
+	// Get information about the machine running MinKNOW.
+	//
+	// Since 3.6
+	DescribeHost(ctx context.Context, in *DescribeHostRequest, opts ...grpc.CallOption) (*DescribeHostResponse, error)
+	// List all devices attached to this machine.
+	//
+	// Devices will be in 1 of 3 states: active, pending, inactive. Will provide port information
+	// for any devices that are active
+	//
+	// DEPRECATED: use `flow_cell_positions` (or `watch_flow_cell_positions`) instead.
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// List all known positions where flow cells can be inserted.
+	//
+	// Provides a snapshot of places where users can insert flow cells. It has a streamed response
+	// in case there are too many positions to fit into a single response, but normally there should
+	// only be a single response.
+	//
+	// Since 3.6
+	FlowCellPositions(ctx context.Context, in *FlowCellPositionsRequest, opts ...grpc.CallOption) (ManagerService_FlowCellPositionsClient, error)
+	// Watch for changes in flow cell position availability and state.
+	//
+	// This is like flow_cell_positions, but updates are streamed as positions come and go (eg:
+	// MinIONs being plugged or unplugged), or their status otherwise changes.
+	//
+	// Since 3.6
+	WatchFlowCellPositions(ctx context.Context, in *WatchFlowCellPositionsRequest, opts ...grpc.CallOption) (ManagerService_WatchFlowCellPositionsClient, error)
+	// Reset a flow cell position.
+	//
+	// If the hardware is in an error state or has been removed and the software is running, it will
+	// stop the software (causing the position to be forgotten if the hardware was removed).
+	//
+	// If the hardware is not in an error state
+	ResetPosition(ctx context.Context, in *ResetPositionRequest, opts ...grpc.CallOption) (*ResetPositionResponse, error)
+	// Get the connection information for the basecaller API.
+	//
+	// This is the service that implements the minknow.rpc.basecaller interface for basecalling
+	// reads files.
+	//
+	// Since 3.5
+	BasecallerApi(ctx context.Context, in *BasecallerApiRequest, opts ...grpc.CallOption) (*BasecallerApiResponse, error)
+	// Current version information includes:
+	// - Minknow version
+	// - Protocols version (i.e. Bream-4 version)
+	// - Configuration version (i.e. Wanda version)
+	// - Distribution version
+	// - Guppy version
+	//
+	// See also: instance.get_version_info which provides some similar information on a per instance basis.
+	// Since 3.3
+	GetVersionInfo(ctx context.Context, in *GetVersionInfoRequest, opts ...grpc.CallOption) (*GetVersionInfoResponse, error)
+	// Returns all the files that are within the protocol output dir specified in the user_conf - or a descendant of that directory
+	// if `path` is supplied in the request.
+	//
+	// INVALID_ARGUMENT will be returned if the `path` argument in the request is not a descendant of the protocol output directory
+	//
+	// NOT_FOUND will be returned if the path does not exist. 'The path' being either the protocol output directory if the `path` argument is not specified
+	// or a valid `path` argument is specified but does not exist.
+	//
+	// Since 3.5
+	ListProtocolOutputDirFiles(ctx context.Context, in *ListProtocolOutputDirFilesRequest, opts ...grpc.CallOption) (ManagerService_ListProtocolOutputDirFilesClient, error)
+	// Create a directory within the protocol output directory tree.
+	//
+	// Errors:
+	//     INVALID_ARGUMENT will be returned if the parent path (where the directory is requested to
+	//         be created) is not within the protocol output directory, or if invalid characters are
+	//         in the requested directory name.
+	//
+	//     FAILED_PRECONDITION will be returned if the parent path is not a directory.
+	//
+	//     NOT_FOUND will be returned if the parent path does not exist.
+	//
+	//     ALREADY_EXISTS will be returned if the directory exists and is not a directory (if the
+	//         directory already exists, this is treated as success).
+	//
+	// The possible valid parent paths can be determined using list_protocol_output_dir_files().
+	//
+	// Since 3.6
+	CreateDirectory(ctx context.Context, in *CreateDirectoryRequest, opts ...grpc.CallOption) (*CreateDirectoryResponse, error)
+}
+
+type managerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManagerServiceClient(cc grpc.ClientConnInterface) ManagerServiceClient {
+	return &managerServiceClient{cc}
+}
+
+func (c *managerServiceClient) DescribeHost(ctx context.Context, in *DescribeHostRequest, opts ...grpc.CallOption) (*DescribeHostResponse, error) {
+	out := new(DescribeHostResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.manager.ManagerService/describe_host", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.manager.ManagerService/list_devices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) FlowCellPositions(ctx context.Context, in *FlowCellPositionsRequest, opts ...grpc.CallOption) (ManagerService_FlowCellPositionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ManagerService_serviceDesc.Streams[0], "/ont.rpc.manager.ManagerService/flow_cell_positions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerServiceFlowCellPositionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagerService_FlowCellPositionsClient interface {
+	Recv() (*FlowCellPositionsResponse, error)
+	grpc.ClientStream
+}
+
+type managerServiceFlowCellPositionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerServiceFlowCellPositionsClient) Recv() (*FlowCellPositionsResponse, error) {
+	m := new(FlowCellPositionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managerServiceClient) WatchFlowCellPositions(ctx context.Context, in *WatchFlowCellPositionsRequest, opts ...grpc.CallOption) (ManagerService_WatchFlowCellPositionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ManagerService_serviceDesc.Streams[1], "/ont.rpc.manager.ManagerService/watch_flow_cell_positions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerServiceWatchFlowCellPositionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagerService_WatchFlowCellPositionsClient interface {
+	Recv() (*WatchFlowCellPositionsResponse, error)
+	grpc.ClientStream
+}
+
+type managerServiceWatchFlowCellPositionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerServiceWatchFlowCellPositionsClient) Recv() (*WatchFlowCellPositionsResponse, error) {
+	m := new(WatchFlowCellPositionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managerServiceClient) ResetPosition(ctx context.Context, in *ResetPositionRequest, opts ...grpc.CallOption) (*ResetPositionResponse, error) {
+	out := new(ResetPositionResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.manager.ManagerService/reset_position", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) BasecallerApi(ctx context.Context, in *BasecallerApiRequest, opts ...grpc.CallOption) (*BasecallerApiResponse, error) {
+	out := new(BasecallerApiResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.manager.ManagerService/basecaller_api", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) GetVersionInfo(ctx context.Context, in *GetVersionInfoRequest, opts ...grpc.CallOption) (*GetVersionInfoResponse, error) {
+	out := new(GetVersionInfoResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.manager.ManagerService/get_version_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) ListProtocolOutputDirFiles(ctx context.Context, in *ListProtocolOutputDirFilesRequest, opts ...grpc.CallOption) (ManagerService_ListProtocolOutputDirFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ManagerService_serviceDesc.Streams[2], "/ont.rpc.manager.ManagerService/list_protocol_output_dir_files", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerServiceListProtocolOutputDirFilesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagerService_ListProtocolOutputDirFilesClient interface {
+	Recv() (*ListProtocolOutputDirFilesResponse, error)
+	grpc.ClientStream
+}
+
+type managerServiceListProtocolOutputDirFilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerServiceListProtocolOutputDirFilesClient) Recv() (*ListProtocolOutputDirFilesResponse, error) {
+	m := new(ListProtocolOutputDirFilesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managerServiceClient) CreateDirectory(ctx context.Context, in *CreateDirectoryRequest, opts ...grpc.CallOption) (*CreateDirectoryResponse, error) {
+	out := new(CreateDirectoryResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.manager.ManagerService/create_directory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServiceServer is the server API for ManagerService service.
+type ManagerServiceServer interface {
+	// Get information about the machine running MinKNOW.
+	//
+	// Since 3.6
+	DescribeHost(context.Context, *DescribeHostRequest) (*DescribeHostResponse, error)
+	// List all devices attached to this machine.
+	//
+	// Devices will be in 1 of 3 states: active, pending, inactive. Will provide port information
+	// for any devices that are active
+	//
+	// DEPRECATED: use `flow_cell_positions` (or `watch_flow_cell_positions`) instead.
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// List all known positions where flow cells can be inserted.
+	//
+	// Provides a snapshot of places where users can insert flow cells. It has a streamed response
+	// in case there are too many positions to fit into a single response, but normally there should
+	// only be a single response.
+	//
+	// Since 3.6
+	FlowCellPositions(*FlowCellPositionsRequest, ManagerService_FlowCellPositionsServer) error
+	// Watch for changes in flow cell position availability and state.
+	//
+	// This is like flow_cell_positions, but updates are streamed as positions come and go (eg:
+	// MinIONs being plugged or unplugged), or their status otherwise changes.
+	//
+	// Since 3.6
+	WatchFlowCellPositions(*WatchFlowCellPositionsRequest, ManagerService_WatchFlowCellPositionsServer) error
+	// Reset a flow cell position.
+	//
+	// If the hardware is in an error state or has been removed and the software is running, it will
+	// stop the software (causing the position to be forgotten if the hardware was removed).
+	//
+	// If the hardware is not in an error state
+	ResetPosition(context.Context, *ResetPositionRequest) (*ResetPositionResponse, error)
+	// Get the connection information for the basecaller API.
+	//
+	// This is the service that implements the minknow.rpc.basecaller interface for basecalling
+	// reads files.
+	//
+	// Since 3.5
+	BasecallerApi(context.Context, *BasecallerApiRequest) (*BasecallerApiResponse, error)
+	// Current version information includes:
+	// - Minknow version
+	// - Protocols version (i.e. Bream-4 version)
+	// - Configuration version (i.e. Wanda version)
+	// - Distribution version
+	// - Guppy version
+	//
+	// See also: instance.get_version_info which provides some similar information on a per instance basis.
+	// Since 3.3
+	GetVersionInfo(context.Context, *GetVersionInfoRequest) (*GetVersionInfoResponse, error)
+	// Returns all the files that are within the protocol output dir specified in the user_conf - or a descendant of that directory
+	// if `path` is supplied in the request.
+	//
+	// INVALID_ARGUMENT will be returned if the `path` argument in the request is not a descendant of the protocol output directory
+	//
+	// NOT_FOUND will be returned if the path does not exist. 'The path' being either the protocol output directory if the `path` argument is not specified
+	// or a valid `path` argument is specified but does not exist.
+	//
+	// Since 3.5
+	ListProtocolOutputDirFiles(*ListProtocolOutputDirFilesRequest, ManagerService_ListProtocolOutputDirFilesServer) error
+	// Create a directory within the protocol output directory tree.
+	//
+	// Errors:
+	//     INVALID_ARGUMENT will be returned if the parent path (where the directory is requested to
+	//         be created) is not within the protocol output directory, or if invalid characters are
+	//         in the requested directory name.
+	//
+	//     FAILED_PRECONDITION will be returned if the parent path is not a directory.
+	//
+	//     NOT_FOUND will be returned if the parent path does not exist.
+	//
+	//     ALREADY_EXISTS will be returned if the directory exists and is not a directory (if the
+	//         directory already exists, this is treated as success).
+	//
+	// The possible valid parent paths can be determined using list_protocol_output_dir_files().
+	//
+	// Since 3.6
+	CreateDirectory(context.Context, *CreateDirectoryRequest) (*CreateDirectoryResponse, error)
+}
+
+// UnimplementedManagerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedManagerServiceServer struct {
+}
+
+func (*UnimplementedManagerServiceServer) DescribeHost(context.Context, *DescribeHostRequest) (*DescribeHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHost not implemented")
+}
+func (*UnimplementedManagerServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (*UnimplementedManagerServiceServer) FlowCellPositions(*FlowCellPositionsRequest, ManagerService_FlowCellPositionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FlowCellPositions not implemented")
+}
+func (*UnimplementedManagerServiceServer) WatchFlowCellPositions(*WatchFlowCellPositionsRequest, ManagerService_WatchFlowCellPositionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFlowCellPositions not implemented")
+}
+func (*UnimplementedManagerServiceServer) ResetPosition(context.Context, *ResetPositionRequest) (*ResetPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPosition not implemented")
+}
+func (*UnimplementedManagerServiceServer) BasecallerApi(context.Context, *BasecallerApiRequest) (*BasecallerApiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasecallerApi not implemented")
+}
+func (*UnimplementedManagerServiceServer) GetVersionInfo(context.Context, *GetVersionInfoRequest) (*GetVersionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersionInfo not implemented")
+}
+func (*UnimplementedManagerServiceServer) ListProtocolOutputDirFiles(*ListProtocolOutputDirFilesRequest, ManagerService_ListProtocolOutputDirFilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListProtocolOutputDirFiles not implemented")
+}
+func (*UnimplementedManagerServiceServer) CreateDirectory(context.Context, *CreateDirectoryRequest) (*CreateDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDirectory not implemented")
+}
+
+func RegisterManagerServiceServer(s *grpc.Server, srv ManagerServiceServer) {
+	s.RegisterService(&_ManagerService_serviceDesc, srv)
+}
+
+func _ManagerService_DescribeHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).DescribeHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.manager.ManagerService/DescribeHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).DescribeHost(ctx, req.(*DescribeHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.manager.ManagerService/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_FlowCellPositions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlowCellPositionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServiceServer).FlowCellPositions(m, &managerServiceFlowCellPositionsServer{stream})
+}
+
+type ManagerService_FlowCellPositionsServer interface {
+	Send(*FlowCellPositionsResponse) error
+	grpc.ServerStream
+}
+
+type managerServiceFlowCellPositionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerServiceFlowCellPositionsServer) Send(m *FlowCellPositionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagerService_WatchFlowCellPositions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFlowCellPositionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServiceServer).WatchFlowCellPositions(m, &managerServiceWatchFlowCellPositionsServer{stream})
+}
+
+type ManagerService_WatchFlowCellPositionsServer interface {
+	Send(*WatchFlowCellPositionsResponse) error
+	grpc.ServerStream
+}
+
+type managerServiceWatchFlowCellPositionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerServiceWatchFlowCellPositionsServer) Send(m *WatchFlowCellPositionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagerService_ResetPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).ResetPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.manager.ManagerService/ResetPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).ResetPosition(ctx, req.(*ResetPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_BasecallerApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasecallerApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).BasecallerApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.manager.ManagerService/BasecallerApi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).BasecallerApi(ctx, req.(*BasecallerApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_GetVersionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).GetVersionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.manager.ManagerService/GetVersionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).GetVersionInfo(ctx, req.(*GetVersionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_ListProtocolOutputDirFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProtocolOutputDirFilesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServiceServer).ListProtocolOutputDirFiles(m, &managerServiceListProtocolOutputDirFilesServer{stream})
+}
+
+type ManagerService_ListProtocolOutputDirFilesServer interface {
+	Send(*ListProtocolOutputDirFilesResponse) error
+	grpc.ServerStream
+}
+
+type managerServiceListProtocolOutputDirFilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerServiceListProtocolOutputDirFilesServer) Send(m *ListProtocolOutputDirFilesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagerService_CreateDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).CreateDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.manager.ManagerService/CreateDirectory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).CreateDirectory(ctx, req.(*CreateDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ManagerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ont.rpc.manager.ManagerService",
+	HandlerType: (*ManagerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "describe_host",
+			Handler:    _ManagerService_DescribeHost_Handler,
+		},
+		{
+			MethodName: "list_devices",
+			Handler:    _ManagerService_ListDevices_Handler,
+		},
+		{
+			MethodName: "reset_position",
+			Handler:    _ManagerService_ResetPosition_Handler,
+		},
+		{
+			MethodName: "basecaller_api",
+			Handler:    _ManagerService_BasecallerApi_Handler,
+		},
+		{
+			MethodName: "get_version_info",
+			Handler:    _ManagerService_GetVersionInfo_Handler,
+		},
+		{
+			MethodName: "create_directory",
+			Handler:    _ManagerService_CreateDirectory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "flow_cell_positions",
+			Handler:       _ManagerService_FlowCellPositions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "watch_flow_cell_positions",
+			Handler:       _ManagerService_WatchFlowCellPositions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "list_protocol_output_dir_files",
+			Handler:       _ManagerService_ListProtocolOutputDirFiles_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "minknow/rpc/manager.proto",
+}
@@ -0,0 +1,419 @@
+package minknowtest
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/minknow/acquisition"
+	"github.com/will-rowe/herald/src/minknow/device"
+	"github.com/will-rowe/herald/src/minknow/instance"
+	"github.com/will-rowe/herald/src/minknow/manager"
+	"github.com/will-rowe/herald/src/minknow/protocol"
+)
+
+// DefaultDiskSpace is the file system each position
+// reports until SetDiskSpace is called.
+var DefaultDiskSpace = &instance.FilesystemDiskSpaceInfo{
+	FilesystemId:       "/data",
+	BytesAvailable:     2e12,
+	BytesCapacity:      4e12,
+	What:               []string{"reads", "logs"},
+	BytesToStopCleanly: 5e9,
+}
+
+// Position is a fake MinKNOW flow cell position, offering
+// the protocol, acquisition, device and instance services.
+//
+// The messages it sends are never changed once sent, an
+// update replaces them instead.
+type Position struct {
+	name       string
+	listener   net.Listener
+	grpcServer *grpc.Server
+	stopped    chan struct{} // closed, under the lock, when the position is stopped
+	stopOnce   sync.Once
+	wg         sync.WaitGroup // the scripts that are playing
+
+	lock         sync.Mutex
+	state        manager.FlowCellPosition_State // the state reported by the manager
+	errorInfo    string                         // why the position is in an error state
+	device       *device.GetDeviceInfoResponse
+	flowCell     *device.GetFlowCellInfoResponse
+	diskSpace    []*instance.FilesystemDiskSpaceInfo
+	sampleID     string
+	contextInfo  map[string]string
+	protocolRuns []*protocol.ProtocolRunInfo // oldest first
+	acquisitions map[string]*acquisition.AcquisitionRunInfo
+	current      *acquisition.AcquisitionRunInfo // the latest acquisition (nil until one starts)
+	acquired     uint64                          // the raw samples acquired by the current acquisition
+	changed      chan struct{}                   // closed, then replaced, when the current acquisition changes
+	autoStart    *Script                         // played when the context info is set (nil for none)
+	runCount     int                             // used to give protocol and acquisition runs their IDs
+}
+
+// newPosition starts serving a position
+// on any free port of the host.
+func newPosition(host, name, deviceID string) (*Position, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return nil, err
+	}
+	position := &Position{
+		name:       name,
+		listener:   listener,
+		grpcServer: grpc.NewServer(),
+		stopped:    make(chan struct{}),
+		state:      manager.FlowCellPosition_STATE_RUNNING,
+		device: &device.GetDeviceInfoResponse{
+			DeviceId:        deviceID,
+			DeviceType:      device.GetDeviceInfoResponse_GRIDION,
+			IsSimulated:     true,
+			MaxChannelCount: 512,
+		},
+		diskSpace:    []*instance.FilesystemDiskSpaceInfo{DefaultDiskSpace},
+		contextInfo:  make(map[string]string),
+		acquisitions: make(map[string]*acquisition.AcquisitionRunInfo),
+		changed:      make(chan struct{}),
+	}
+	position.flowCell = newFlowCell("FAO00001", "FLO-MIN106")
+	protocol.RegisterProtocolServiceServer(position.grpcServer, &protocolServer{p: position})
+	acquisition.RegisterAcquisitionServiceServer(position.grpcServer, &acquisitionServer{p: position})
+	device.RegisterDeviceServiceServer(position.grpcServer, &deviceServer{p: position})
+	instance.RegisterInstanceServiceServer(position.grpcServer, &instanceServer{p: position})
+	go position.grpcServer.Serve(listener)
+	return position, nil
+}
+
+// newFlowCell returns the info for an inserted flow cell.
+func newFlowCell(flowCellID, productCode string) *device.GetFlowCellInfoResponse {
+	return &device.GetFlowCellInfoResponse{
+		HasFlowCell:     true,
+		ChannelCount:    512,
+		WellsPerChannel: 4,
+		FlowCellId:      flowCellID,
+		ProductCode:     productCode,
+	}
+}
+
+// stop will stop serving the position and
+// wait for any scripts that are playing.
+func (p *Position) stop() {
+	p.stopOnce.Do(func() {
+		p.lock.Lock()
+		close(p.stopped)
+		p.lock.Unlock()
+		p.grpcServer.Stop()
+	})
+	p.wg.Wait()
+}
+
+// port returns the port the position is served on.
+func (p *Position) port() int {
+	return p.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Name returns the position name.
+func (p *Position) Name() string {
+	return p.name
+}
+
+// Address returns the host:port of the position.
+func (p *Position) Address() string {
+	return p.listener.Addr().String()
+}
+
+// SetState will change the state the manager reports for
+// the position, with the reason for an error state. Only
+// running positions are given their ports.
+func (p *Position) SetState(state manager.FlowCellPosition_State, errorInfo string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.state, p.errorInfo = state, errorInfo
+}
+
+// getState returns the state of the position.
+func (p *Position) getState() (manager.FlowCellPosition_State, string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.state, p.errorInfo
+}
+
+// SetFlowCell will change the flow cell in the position.
+func (p *Position) SetFlowCell(flowCellID, productCode string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.flowCell = newFlowCell(flowCellID, productCode)
+}
+
+// SetDiskSpace will change the file systems
+// the position reports the space of.
+func (p *Position) SetDiskSpace(filesystems ...*instance.FilesystemDiskSpaceInfo) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.diskSpace = append([]*instance.FilesystemDiskSpaceInfo{}, filesystems...)
+}
+
+// SampleID returns the sample ID set on the position.
+func (p *Position) SampleID() string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.sampleID
+}
+
+// ContextInfo returns a copy of the context
+// info set on the position.
+func (p *Position) ContextInfo() map[string]string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return copyContextInfo(p.contextInfo)
+}
+
+// SetContextInfo will replace the context info on
+// the position, such as info left by an earlier run.
+func (p *Position) SetContextInfo(contextInfo map[string]string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.contextInfo = copyContextInfo(contextInfo)
+}
+
+// AddProtocolRun will add a protocol run to the
+// history of the position, such as one that
+// finished before the fake was started. A run
+// with the same ID is replaced instead.
+func (p *Position) AddProtocolRun(info *protocol.ProtocolRunInfo) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	info = proto.Clone(info).(*protocol.ProtocolRunInfo)
+	for i, existing := range p.protocolRuns {
+		if existing.GetRunId() == info.GetRunId() {
+			p.protocolRuns[i] = info
+			return
+		}
+	}
+	p.protocolRuns = append(p.protocolRuns, info)
+}
+
+// SetAutoStart will play a script each time the context
+// info is set on the position, which is the last thing
+// Herald does when a run is sent to MinKNOW (nil to stop).
+func (p *Position) SetAutoStart(script *Script) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.autoStart = script
+}
+
+// setAcquisition will replace the current acquisition
+// and let any watchers know it has changed.
+//
+// NOTE: the caller must hold the position lock.
+func (p *Position) setAcquisition(info *acquisition.AcquisitionRunInfo, acquired uint64) {
+	p.acquisitions[info.GetRunId()] = info
+	p.current, p.acquired = info, acquired
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+// updateProtocolRun will replace a protocol run
+// with an updated copy.
+//
+// NOTE: the caller must hold the position lock.
+func (p *Position) updateProtocolRun(runID string, update func(info *protocol.ProtocolRunInfo)) {
+	for i, info := range p.protocolRuns {
+		if info.GetRunId() == runID {
+			updated := proto.Clone(info).(*protocol.ProtocolRunInfo)
+			update(updated)
+			p.protocolRuns[i] = updated
+			return
+		}
+	}
+}
+
+// copyContextInfo returns a copy of some context info.
+func copyContextInfo(contextInfo map[string]string) map[string]string {
+	copied := make(map[string]string, len(contextInfo))
+	for key, value := range contextInfo {
+		copied[key] = value
+	}
+	return copied
+}
+
+// protocolServer offers the protocol service for a position.
+type protocolServer struct {
+	protocol.UnimplementedProtocolServiceServer
+	p *Position
+}
+
+// ListProtocolRuns returns the IDs of the protocol runs, oldest first.
+func (s *protocolServer) ListProtocolRuns(ctx context.Context, request *protocol.ListProtocolRunsRequest) (*protocol.ListProtocolRunsResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	resp := &protocol.ListProtocolRunsResponse{}
+	for _, info := range s.p.protocolRuns {
+		resp.RunIds = append(resp.RunIds, info.GetRunId())
+	}
+	return resp, nil
+}
+
+// GetRunInfo returns a protocol run, or the latest one if no ID is given.
+func (s *protocolServer) GetRunInfo(ctx context.Context, request *protocol.GetRunInfoRequest) (*protocol.ProtocolRunInfo, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	if len(request.GetRunId()) == 0 && len(s.p.protocolRuns) != 0 {
+		return s.p.protocolRuns[len(s.p.protocolRuns)-1], nil
+	}
+	for _, info := range s.p.protocolRuns {
+		if info.GetRunId() == request.GetRunId() {
+			return info, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "no protocol run with ID %q", request.GetRunId())
+}
+
+// GetCurrentProtocolRun returns the protocol run that is running.
+func (s *protocolServer) GetCurrentProtocolRun(ctx context.Context, request *protocol.GetCurrentProtocolRunRequest) (*protocol.ProtocolRunInfo, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	if len(s.p.protocolRuns) != 0 {
+		info := s.p.protocolRuns[len(s.p.protocolRuns)-1]
+		if info.GetEndTime() == nil {
+			return info, nil
+		}
+	}
+	return nil, status.Error(codes.FailedPrecondition, "no protocol is running")
+}
+
+// SetSampleId sets the sample ID used by the next protocol run.
+func (s *protocolServer) SetSampleId(ctx context.Context, request *protocol.SetSampleIdRequest) (*protocol.SetSampleIdResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	s.p.sampleID = request.GetSampleId()
+	return &protocol.SetSampleIdResponse{}, nil
+}
+
+// GetSampleId returns the sample ID.
+func (s *protocolServer) GetSampleId(ctx context.Context, request *protocol.GetSampleIdRequest) (*protocol.GetSampleIdResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	return &protocol.GetSampleIdResponse{SampleId: s.p.sampleID}, nil
+}
+
+// SetContextInfo replaces the context info, then plays
+// the auto start script if there is one.
+func (s *protocolServer) SetContextInfo(ctx context.Context, request *protocol.SetContextInfoRequest) (*protocol.SetContextInfoResponse, error) {
+	s.p.lock.Lock()
+	s.p.contextInfo = copyContextInfo(request.GetContextInfo())
+	script := s.p.autoStart
+	s.p.lock.Unlock()
+	if script != nil {
+		s.p.Play(script)
+	}
+	return &protocol.SetContextInfoResponse{}, nil
+}
+
+// GetContextInfo returns the context info.
+func (s *protocolServer) GetContextInfo(ctx context.Context, request *protocol.GetContextInfoRequest) (*protocol.GetContextInfoResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	return &protocol.GetContextInfoResponse{ContextInfo: copyContextInfo(s.p.contextInfo)}, nil
+}
+
+// acquisitionServer offers the acquisition service for a position.
+type acquisitionServer struct {
+	acquisition.UnimplementedAcquisitionServiceServer
+	p *Position
+}
+
+// WatchCurrentAcquisitionRun sends the latest acquisition,
+// then each change to it, until the client goes away.
+func (s *acquisitionServer) WatchCurrentAcquisitionRun(request *acquisition.WatchCurrentAcquisitionRunRequest, stream acquisition.AcquisitionService_WatchCurrentAcquisitionRunServer) error {
+	var sent *acquisition.AcquisitionRunInfo
+	for {
+		s.p.lock.Lock()
+		current, changed := s.p.current, s.p.changed
+		s.p.lock.Unlock()
+		if current != nil && current != sent {
+			if err := stream.Send(current); err != nil {
+				return err
+			}
+			sent = current
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.p.stopped:
+			return status.Error(codes.Unavailable, "MinKNOW is shutting down")
+		}
+	}
+}
+
+// GetAcquisitionInfo returns an acquisition, or the latest one if no ID is given.
+func (s *acquisitionServer) GetAcquisitionInfo(ctx context.Context, request *acquisition.GetAcquisitionRunInfoRequest) (*acquisition.AcquisitionRunInfo, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	if len(request.GetRunId()) == 0 && s.p.current != nil {
+		return s.p.current, nil
+	}
+	if info, ok := s.p.acquisitions[request.GetRunId()]; ok {
+		return info, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "no acquisition run with ID %q", request.GetRunId())
+}
+
+// GetCurrentAcquisitionRun returns the acquisition that is running.
+func (s *acquisitionServer) GetCurrentAcquisitionRun(ctx context.Context, request *acquisition.GetCurrentAcquisitionRunRequest) (*acquisition.AcquisitionRunInfo, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	if s.p.current == nil || s.p.current.GetState() == acquisition.AcquisitionState_ACQUISITION_COMPLETED {
+		return nil, status.Error(codes.FailedPrecondition, "no acquisition is running")
+	}
+	return s.p.current, nil
+}
+
+// GetProgress returns the raw data acquired by the current acquisition.
+func (s *acquisitionServer) GetProgress(ctx context.Context, request *acquisition.GetProgressRequest) (*acquisition.GetProgressResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	return &acquisition.GetProgressResponse{
+		RawPerChannel: &acquisition.GetProgressResponse_RawPerChannel{Acquired: s.p.acquired, Processed: s.p.acquired},
+	}, nil
+}
+
+// deviceServer offers the device service for a position.
+type deviceServer struct {
+	device.UnimplementedDeviceServiceServer
+	p *Position
+}
+
+// GetDeviceInfo returns the device the position belongs to.
+func (s *deviceServer) GetDeviceInfo(ctx context.Context, request *device.GetDeviceInfoRequest) (*device.GetDeviceInfoResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	return s.p.device, nil
+}
+
+// GetFlowCellInfo returns the flow cell in the position.
+func (s *deviceServer) GetFlowCellInfo(ctx context.Context, request *device.GetFlowCellInfoRequest) (*device.GetFlowCellInfoResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	return s.p.flowCell, nil
+}
+
+// instanceServer offers the instance service for a position.
+type instanceServer struct {
+	instance.UnimplementedInstanceServiceServer
+	p *Position
+}
+
+// GetDiskSpaceInfo returns the space on the file systems MinKNOW writes to.
+func (s *instanceServer) GetDiskSpaceInfo(ctx context.Context, request *instance.GetDiskSpaceInfoRequest) (*instance.GetDiskSpaceInfoResponse, error) {
+	s.p.lock.Lock()
+	defer s.p.lock.Unlock()
+	return &instance.GetDiskSpaceInfoResponse{FilesystemDiskSpaceInfo: s.p.diskSpace}, nil
+}
@@ -0,0 +1,84 @@
+package minknowtest
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/minknow/device"
+	"github.com/will-rowe/herald/src/minknow/instance"
+	"github.com/will-rowe/herald/src/minknow/protocol"
+)
+
+// TestPosition checks a position keeps the sample, device and disk space info
+func TestPosition(t *testing.T) {
+	server, err := NewServer("", "X1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	position := server.Position("X1")
+	conn := dial(t, position.Address())
+	ctx := context.Background()
+
+	// check the sample ID and context info
+	protocolClient := protocol.NewProtocolServiceClient(conn)
+	if _, err := protocolClient.SetSampleId(ctx, &protocol.SetSampleIdRequest{SampleId: "sample 1"}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := protocolClient.GetSampleId(ctx, &protocol.GetSampleIdRequest{}); err != nil || resp.GetSampleId() != "sample 1" || position.SampleID() != "sample 1" {
+		t.Fatalf("sample ID was not set: %v %v", resp, err)
+	}
+	if _, err := protocolClient.SetContextInfo(ctx, &protocol.SetContextInfoRequest{ContextInfo: map[string]string{"key": "value"}}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := protocolClient.GetContextInfo(ctx, &protocol.GetContextInfoRequest{}); err != nil || resp.GetContextInfo()["key"] != "value" || position.ContextInfo()["key"] != "value" {
+		t.Fatalf("context info was not set: %v %v", resp, err)
+	}
+	position.SetContextInfo(map[string]string{"lab": "lab 1"})
+	if resp, err := protocolClient.GetContextInfo(ctx, &protocol.GetContextInfoRequest{}); err != nil || len(resp.GetContextInfo()) != 1 || resp.GetContextInfo()["lab"] != "lab 1" {
+		t.Fatalf("context info was not replaced: %v %v", resp, err)
+	}
+
+	// check the device and flow cell
+	deviceClient := device.NewDeviceServiceClient(conn)
+	if resp, err := deviceClient.GetDeviceInfo(ctx, &device.GetDeviceInfoRequest{}); err != nil || resp.GetDeviceType() != device.GetDeviceInfoResponse_GRIDION || len(resp.GetDeviceId()) == 0 {
+		t.Fatalf("unexpected device: %v %v", resp, err)
+	}
+	position.SetFlowCell("FAT12345", "FLO-FLG001")
+	if resp, err := deviceClient.GetFlowCellInfo(ctx, &device.GetFlowCellInfoRequest{}); err != nil || resp.GetFlowCellId() != "FAT12345" || resp.GetProductCode() != "FLO-FLG001" {
+		t.Fatalf("unexpected flow cell: %v %v", resp, err)
+	}
+
+	// check the disk space
+	instanceClient := instance.NewInstanceServiceClient(conn)
+	resp, err := instanceClient.GetDiskSpaceInfo(ctx, &instance.GetDiskSpaceInfoRequest{})
+	if err != nil || len(resp.GetFilesystemDiskSpaceInfo()) != 1 || resp.GetFilesystemDiskSpaceInfo()[0].GetBytesAvailable() != DefaultDiskSpace.GetBytesAvailable() {
+		t.Fatalf("unexpected disk space: %v %v", resp, err)
+	}
+	position.SetDiskSpace(&instance.FilesystemDiskSpaceInfo{FilesystemId: "/full", BytesAvailable: 1e6, What: []string{"reads"}})
+	if resp, err = instanceClient.GetDiskSpaceInfo(ctx, &instance.GetDiskSpaceInfoRequest{}); err != nil || resp.GetFilesystemDiskSpaceInfo()[0].GetFilesystemId() != "/full" {
+		t.Fatalf("disk space was not changed: %v %v", resp, err)
+	}
+
+	// check the protocol history
+	position.AddProtocolRun(&protocol.ProtocolRunInfo{RunId: "p1", State: protocol.ProtocolState_PROTOCOL_COMPLETED})
+	if runs, err := protocolClient.ListProtocolRuns(ctx, &protocol.ListProtocolRunsRequest{}); err != nil || len(runs.GetRunIds()) != 1 || runs.GetRunIds()[0] != "p1" {
+		t.Fatalf("unexpected protocol runs: %v %v", runs, err)
+	}
+	if info, err := protocolClient.GetRunInfo(ctx, &protocol.GetRunInfoRequest{RunId: "p1"}); err != nil || info.GetState() != protocol.ProtocolState_PROTOCOL_COMPLETED {
+		t.Fatalf("unexpected protocol run: %v %v", info, err)
+	}
+	position.AddProtocolRun(&protocol.ProtocolRunInfo{RunId: "p1", State: protocol.ProtocolState_PROTOCOL_STOPPED_BY_USER})
+	if runs, err := protocolClient.ListProtocolRuns(ctx, &protocol.ListProtocolRunsRequest{}); err != nil || len(runs.GetRunIds()) != 1 {
+		t.Fatalf("protocol run was added twice: %v %v", runs, err)
+	}
+	if info, err := protocolClient.GetRunInfo(ctx, &protocol.GetRunInfoRequest{RunId: "p1"}); err != nil || info.GetState() != protocol.ProtocolState_PROTOCOL_STOPPED_BY_USER {
+		t.Fatalf("protocol run was not replaced: %v %v", info, err)
+	}
+	if _, err := protocolClient.GetRunInfo(ctx, &protocol.GetRunInfoRequest{RunId: "p2"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an unknown protocol run to be not found, got: %v", err)
+	}
+}
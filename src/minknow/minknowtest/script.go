@@ -0,0 +1,165 @@
+package minknowtest
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/will-rowe/herald/src/minknow/acquisition"
+	"github.com/will-rowe/herald/src/minknow/protocol"
+)
+
+// SamplesPerBase is used to work out the raw
+// samples acquired from the bases of a step.
+const SamplesPerBase = 9
+
+// Step is a change to the acquisition of a scripted run.
+// The yields are the totals for the run so far.
+type Step struct {
+	After      time.Duration                     // how long to wait after the previous step
+	State      acquisition.AcquisitionState      // the state the acquisition moves to
+	StopReason acquisition.AcquisitionStopReason // why the acquisition stopped (once it is finishing)
+	Reads      int64
+	PassReads  int64
+	FailReads  int64
+	Bases      int64
+}
+
+// Script is a protocol run played on a position. Each
+// run has one acquisition, which follows the steps.
+type Script struct {
+	SampleID        string                 // defaults to the sample ID set on the position
+	ProtocolGroupID string                 // defaults to the herald_protocol_group_id context info
+	OutputPath      string                 // defaults to a directory under /data named like MinKNOW would
+	Outcome         protocol.ProtocolState // the state the protocol run ends in once the acquisition completes (running means completed)
+	Steps           []Step
+}
+
+// SequencingScript returns a script for a run that starts,
+// sequences readsPerStep more reads at each of the given
+// number of steps, then finishes and completes. Each step
+// comes interval after the previous one.
+func SequencingScript(steps int, interval time.Duration, readsPerStep int64) *Script {
+	script := &Script{
+		Steps: []Step{{After: interval, State: acquisition.AcquisitionState_ACQUISITION_STARTING}},
+	}
+	yield := Step{}
+	for i := 1; i <= steps; i++ {
+		reads := int64(i) * readsPerStep
+		yield = Step{
+			After:     interval,
+			State:     acquisition.AcquisitionState_ACQUISITION_RUNNING,
+			Reads:     reads,
+			PassReads: reads * 9 / 10,
+			FailReads: reads - reads*9/10,
+			Bases:     reads * 9 / 10 * 450,
+		}
+		script.Steps = append(script.Steps, yield)
+	}
+	yield.State, yield.StopReason = acquisition.AcquisitionState_ACQUISITION_FINISHING, acquisition.AcquisitionStopReason_STOPPED_PROTOCOL_ENDED
+	script.Steps = append(script.Steps, yield)
+	yield.State = acquisition.AcquisitionState_ACQUISITION_COMPLETED
+	script.Steps = append(script.Steps, yield)
+	return script
+}
+
+// Play will start a protocol run on the position and play
+// the script for its acquisition in the background. It
+// returns the protocol run ID and a channel that is closed
+// once the script has been played (or the position stops).
+func (p *Position) Play(script *Script) (string, <-chan struct{}) {
+	done := make(chan struct{})
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.runCount++
+	started := time.Now()
+	protocolRunID := fmt.Sprintf("%v-protocol-%d", strings.ToLower(p.name), p.runCount)
+	acquisitionRunID := fmt.Sprintf("%v-acquisition-%d", strings.ToLower(p.name), p.runCount)
+	sampleID := script.SampleID
+	if len(sampleID) == 0 {
+		sampleID = p.sampleID
+	}
+	protocolGroupID := script.ProtocolGroupID
+	if len(protocolGroupID) == 0 {
+		protocolGroupID = p.contextInfo["herald_protocol_group_id"]
+	}
+	outputPath := script.OutputPath
+	if len(outputPath) == 0 {
+		outputPath = path.Join("/data", protocolGroupID, sampleID, fmt.Sprintf("%v_%v_%v_%v", started.Format("20060102_1504"), p.name, p.flowCell.GetFlowCellId(), protocolRunID))
+	}
+	startTime, _ := ptypes.TimestampProto(started)
+	p.protocolRuns = append(p.protocolRuns, &protocol.ProtocolRunInfo{
+		RunId:             protocolRunID,
+		ProtocolId:        fmt.Sprintf("sequencing/sequencing_MIN106_DNA:%v:SQK-LSK109", p.flowCell.GetProductCode()),
+		OutputPath:        outputPath,
+		State:             protocol.ProtocolState_PROTOCOL_RUNNING,
+		StartTime:         startTime,
+		AcquisitionRunIds: []string{acquisitionRunID},
+		UserInfo: &protocol.ProtocolRunUserInfo{
+			SampleId:        &wrappers.StringValue{Value: sampleID},
+			ProtocolGroupId: &wrappers.StringValue{Value: protocolGroupID},
+		},
+		Device:   p.device,
+		FlowCell: p.flowCell,
+	})
+	select {
+	case <-p.stopped:
+		close(done)
+		return protocolRunID, done
+	default:
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer close(done)
+		p.play(script, protocolRunID, acquisitionRunID)
+	}()
+	return protocolRunID, done
+}
+
+// play will step through the acquisition for a
+// protocol run, ending the protocol run once the
+// acquisition completes.
+func (p *Position) play(script *Script, protocolRunID, acquisitionRunID string) {
+	var startTime *timestamp.Timestamp
+	for _, step := range script.Steps {
+		select {
+		case <-p.stopped:
+			return
+		case <-time.After(step.After):
+		}
+		now := ptypes.TimestampNow()
+		if startTime == nil {
+			startTime = now
+		}
+		info := &acquisition.AcquisitionRunInfo{
+			RunId:      acquisitionRunID,
+			State:      step.State,
+			StopReason: step.StopReason,
+			StartTime:  startTime,
+			YieldSummary: &acquisition.AcquisitionYieldSummary{
+				ReadCount:               step.Reads,
+				BasecalledPassReadCount: step.PassReads,
+				BasecalledFailReadCount: step.FailReads,
+				BasecalledBases:         step.Bases,
+			},
+		}
+		p.lock.Lock()
+		if step.State == acquisition.AcquisitionState_ACQUISITION_COMPLETED {
+			info.EndTime = now
+			p.updateProtocolRun(protocolRunID, func(run *protocol.ProtocolRunInfo) {
+				run.State, run.EndTime = script.Outcome, now
+				if run.State == protocol.ProtocolState_PROTOCOL_RUNNING {
+					run.State = protocol.ProtocolState_PROTOCOL_COMPLETED
+				}
+			})
+		}
+		p.setAcquisition(info, uint64(step.Bases)*SamplesPerBase)
+		p.lock.Unlock()
+	}
+}
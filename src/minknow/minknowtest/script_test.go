@@ -0,0 +1,91 @@
+package minknowtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/minknow/acquisition"
+	"github.com/will-rowe/herald/src/minknow/protocol"
+)
+
+// TestScript checks a scripted run is played through the acquisition and protocol services
+func TestScript(t *testing.T) {
+	script := SequencingScript(3, 10*time.Millisecond, 100)
+	if len(script.Steps) != 6 || script.Steps[3].Reads != 300 || script.Steps[3].PassReads+script.Steps[3].FailReads != 300 || script.Steps[5].State != acquisition.AcquisitionState_ACQUISITION_COMPLETED {
+		t.Fatalf("unexpected script: %+v", script.Steps)
+	}
+	server, err := NewServer("", "X1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	position := server.Position("X1")
+	conn := dial(t, position.Address())
+	acquisitionClient := acquisition.NewAcquisitionServiceClient(conn)
+	protocolClient := protocol.NewProtocolServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// watch the acquisition while the script plays
+	stream, err := acquisitionClient.WatchCurrentAcquisitionRun(ctx, &acquisition.WatchCurrentAcquisitionRunRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	protocolRunID, done := position.Play(&Script{SampleID: "sample 1", ProtocolGroupID: "group 1", Steps: script.Steps})
+	if info, err := protocolClient.GetCurrentProtocolRun(ctx, &protocol.GetCurrentProtocolRunRequest{}); err != nil || info.GetRunId() != protocolRunID {
+		t.Fatalf("the protocol run is not running: %v %v", info, err)
+	}
+	var last *acquisition.AcquisitionRunInfo
+	for last.GetState() != acquisition.AcquisitionState_ACQUISITION_COMPLETED {
+		info, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last != nil && info.GetState() < last.GetState() {
+			t.Fatalf("acquisition went from %v to %v", last.GetState(), info.GetState())
+		}
+		last = info
+	}
+	<-done
+	if last.GetYieldSummary().GetReadCount() != 300 || last.GetEndTime() == nil || last.GetStopReason() != acquisition.AcquisitionStopReason_STOPPED_PROTOCOL_ENDED {
+		t.Fatalf("unexpected final acquisition: %v", last)
+	}
+	if info, err := acquisitionClient.GetAcquisitionInfo(ctx, &acquisition.GetAcquisitionRunInfoRequest{RunId: last.GetRunId()}); err != nil || info.GetState() != acquisition.AcquisitionState_ACQUISITION_COMPLETED {
+		t.Fatalf("unexpected acquisition info: %v %v", info, err)
+	}
+	if _, err := acquisitionClient.GetCurrentAcquisitionRun(ctx, &acquisition.GetCurrentAcquisitionRunRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected no acquisition to be running, got: %v", err)
+	}
+	info, err := protocolClient.GetRunInfo(ctx, &protocol.GetRunInfoRequest{RunId: protocolRunID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GetState() != protocol.ProtocolState_PROTOCOL_COMPLETED || info.GetEndTime() == nil || info.GetUserInfo().GetSampleId().GetValue() != "sample 1" || info.GetUserInfo().GetProtocolGroupId().GetValue() != "group 1" || info.GetAcquisitionRunIds()[0] != last.GetRunId() {
+		t.Fatalf("unexpected protocol run: %v", info)
+	}
+
+	// check a run is started when the context info is set, using the sample ID and group from the position
+	position.SetAutoStart(&Script{Outcome: protocol.ProtocolState_PROTOCOL_STOPPED_BY_USER, Steps: []Step{{State: acquisition.AcquisitionState_ACQUISITION_COMPLETED}}})
+	if _, err := protocolClient.SetSampleId(ctx, &protocol.SetSampleIdRequest{SampleId: "sample 2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := protocolClient.SetContextInfo(ctx, &protocol.SetContextInfoRequest{ContextInfo: map[string]string{"herald_protocol_group_id": "group 2"}}); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if info, err = protocolClient.GetRunInfo(ctx, &protocol.GetRunInfoRequest{}); err != nil {
+			t.Fatal(err)
+		}
+		if info.GetEndTime() != nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if info.GetRunId() == protocolRunID || info.GetState() != protocol.ProtocolState_PROTOCOL_STOPPED_BY_USER || info.GetUserInfo().GetSampleId().GetValue() != "sample 2" || info.GetUserInfo().GetProtocolGroupId().GetValue() != "group 2" {
+		t.Fatalf("unexpected auto started protocol run: %v", info)
+	}
+}
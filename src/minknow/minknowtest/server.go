@@ -0,0 +1,154 @@
+// Package minknowtest provides a fake MinKNOW, offering the
+// manager and flow cell position services that Herald uses.
+// Positions play through scripted runs so that Herald can be
+// tested, and demonstrated, without a sequencer.
+package minknowtest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/minknow/manager"
+)
+
+// Server is a fake MinKNOW manager. Each of its flow
+// cell positions is served on a port of its own.
+type Server struct {
+	manager.UnimplementedManagerServiceServer
+	listener   net.Listener
+	grpcServer *grpc.Server
+	names      []string             // the position names, sorted
+	positions  map[string]*Position // the positions, by name
+
+	lock   sync.Mutex
+	legacy bool // only offer list_devices, as MinKNOW did before 3.6
+}
+
+// NewServer will start a fake MinKNOW manager on an
+// address (127.0.0.1 on any free port if empty), offering
+// the named flow cell positions (X1 to X5 if none are
+// given, as on a GridION).
+func NewServer(address string, names ...string) (*Server, error) {
+	if len(address) == 0 {
+		address = "127.0.0.1:0"
+	}
+	if len(names) == 0 {
+		names = []string{"X1", "X2", "X3", "X4", "X5"}
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	server := &Server{
+		names:     make([]string, 0, len(names)),
+		positions: make(map[string]*Position),
+	}
+	for i, name := range names {
+		if _, ok := server.positions[name]; ok {
+			server.Stop()
+			return nil, fmt.Errorf("duplicate position name: %v", name)
+		}
+		position, err := newPosition(host, name, fmt.Sprintf("GXB%05d", i+1))
+		if err != nil {
+			server.Stop()
+			return nil, err
+		}
+		server.names = append(server.names, name)
+		server.positions[name] = position
+	}
+	sort.Strings(server.names)
+	if server.listener, err = net.Listen("tcp", address); err != nil {
+		server.Stop()
+		return nil, err
+	}
+	server.grpcServer = grpc.NewServer()
+	manager.RegisterManagerServiceServer(server.grpcServer, server)
+	go server.grpcServer.Serve(server.listener)
+	return server, nil
+}
+
+// Address returns the host:port of the manager.
+func (server *Server) Address() string {
+	return server.listener.Addr().String()
+}
+
+// Port returns the port of the manager.
+func (server *Server) Port() int {
+	return server.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Position returns a flow cell position by
+// name, or nil if it isn't offered.
+func (server *Server) Position(name string) *Position {
+	return server.positions[name]
+}
+
+// SetLegacy will make the manager act like MinKNOW before
+// 3.6, which only offers its positions with list_devices.
+func (server *Server) SetLegacy(legacy bool) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.legacy = legacy
+}
+
+// Stop will stop the manager and its positions,
+// including any scripts that are playing.
+func (server *Server) Stop() {
+	if server.grpcServer != nil {
+		server.grpcServer.Stop()
+	}
+	for _, position := range server.positions {
+		position.stop()
+	}
+}
+
+// FlowCellPositions sends the positions in a single response.
+// Only running positions are given their ports.
+func (server *Server) FlowCellPositions(request *manager.FlowCellPositionsRequest, stream manager.ManagerService_FlowCellPositionsServer) error {
+	server.lock.Lock()
+	legacy := server.legacy
+	server.lock.Unlock()
+	if legacy {
+		return status.Error(codes.Unimplemented, "method FlowCellPositions not implemented")
+	}
+	resp := &manager.FlowCellPositionsResponse{TotalCount: int32(len(server.names))}
+	for _, name := range server.names {
+		position := server.positions[name]
+		state, errorInfo := position.getState()
+		info := &manager.FlowCellPosition{
+			Name:      name,
+			State:     state,
+			ErrorInfo: errorInfo,
+		}
+		if state == manager.FlowCellPosition_STATE_RUNNING {
+			info.RpcPorts = &manager.FlowCellPosition_RpcPorts{Insecure: uint32(position.port())}
+		}
+		resp.Positions = append(resp.Positions, info)
+	}
+	return stream.Send(resp)
+}
+
+// ListDevices sends the running positions as active
+// devices and the others as inactive.
+func (server *Server) ListDevices(ctx context.Context, request *manager.ListDevicesRequest) (*manager.ListDevicesResponse, error) {
+	resp := &manager.ListDevicesResponse{}
+	for _, name := range server.names {
+		position := server.positions[name]
+		if state, _ := position.getState(); state != manager.FlowCellPosition_STATE_RUNNING {
+			resp.Inactive = append(resp.Inactive, name)
+			continue
+		}
+		resp.Active = append(resp.Active, &manager.ListDevicesResponse_ActiveDevice{
+			Name:  name,
+			Ports: &manager.ListDevicesResponse_RpcPorts{InsecureGrpc: uint32(position.port())},
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,95 @@
+package minknowtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/minknow/manager"
+)
+
+// dial connects to the fake, closing the connection when the test ends
+func dial(t *testing.T, address string) *grpc.ClientConn {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// TestServer checks the manager lists the positions and the ports they are served on
+func TestServer(t *testing.T) {
+	if _, err := NewServer("", "X1", "X1"); err == nil {
+		t.Fatal("started a server with duplicate positions")
+	}
+	server, err := NewServer("", "X2", "X1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	if server.Position("X3") != nil {
+		t.Fatal("returned a position that isn't offered")
+	}
+	stream, err := manager.NewManagerServiceClient(dial(t, server.Address())).FlowCellPositions(context.Background(), &manager.FlowCellPositionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetTotalCount() != 2 || len(resp.GetPositions()) != 2 {
+		t.Fatalf("unexpected positions: %v", resp)
+	}
+	for i, name := range []string{"X1", "X2"} {
+		position := resp.GetPositions()[i]
+		if position.GetName() != name || position.GetState() != manager.FlowCellPosition_STATE_RUNNING {
+			t.Fatalf("unexpected position: %v", position)
+		}
+		if address := fmt.Sprintf("127.0.0.1:%d", position.GetRpcPorts().GetInsecure()); address != server.Position(name).Address() {
+			t.Fatalf("position %v is served on %v, not %v", name, server.Position(name).Address(), address)
+		}
+	}
+
+	// check a position that isn't running has no ports, and only list_devices is offered by a legacy manager
+	server.Position("X2").SetState(manager.FlowCellPosition_STATE_HARDWARE_ERROR, "no flow cell")
+	client := manager.NewManagerServiceClient(dial(t, server.Address()))
+	if stream, err = client.FlowCellPositions(context.Background(), &manager.FlowCellPositionsRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	if position := resp.GetPositions()[1]; position.GetState() != manager.FlowCellPosition_STATE_HARDWARE_ERROR || position.GetErrorInfo() != "no flow cell" || position.GetRpcPorts() != nil {
+		t.Fatalf("unexpected position: %v", position)
+	}
+	server.SetLegacy(true)
+	if stream, err = client.FlowCellPositions(context.Background(), &manager.FlowCellPositionsRequest{}); err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected flow_cell_positions to be unimplemented, got: %v", err)
+	}
+	devices, err := client.ListDevices(context.Background(), &manager.ListDevicesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices.GetActive()) != 1 || devices.GetActive()[0].GetName() != "X1" || fmt.Sprintf("127.0.0.1:%d", devices.GetActive()[0].GetPorts().GetInsecureGrpc()) != server.Position("X1").Address() || len(devices.GetInactive()) != 1 || devices.GetInactive()[0] != "X2" {
+		t.Fatalf("unexpected devices: %v", devices)
+	}
+
+	// check a GridION is offered by default
+	gridion, err := NewServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gridion.Stop()
+	if gridion.Position("X1") == nil || gridion.Position("X5") == nil {
+		t.Fatal("the default positions were not offered")
+	}
+}
@@ -0,0 +1,3807 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: minknow/rpc/protocol.proto
+
+package protocol
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	acquisition "github.com/will-rowe/herald/src/minknow/acquisition"
+	device "github.com/will-rowe/herald/src/minknow/device"
+	_ "github.com/will-rowe/herald/src/minknow/rpc"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ProtocolState int32
+
+const (
+	// The protocol is still running.
+	ProtocolState_PROTOCOL_RUNNING ProtocolState = 0
+	// The protocol is waiting for the device to reach temperature
+	//
+	// Since 1.15
+	ProtocolState_PROTOCOL_WAITING_FOR_TEMPERATURE ProtocolState = 4
+	// Occurs when data protocol has stopped (either from a explicit rpc request or the script naturally ended)
+	// and data is still being processed (albacore is still in catchup and processing reads).
+	ProtocolState_PROTOCOL_WAITING_FOR_ACQUISITION ProtocolState = 5
+	// The protocol finished on its own and did not report an error.
+	//
+	// This is determined by the protocol process exiting with a zero exit code.
+	ProtocolState_PROTOCOL_COMPLETED ProtocolState = 1
+	// The protocol was terminated by the user.
+	ProtocolState_PROTOCOL_STOPPED_BY_USER ProtocolState = 2
+	// The protocol finished on its own and reported an error.
+	//
+	// This is determined by the protocol process exiting with a non-zero exit code or because of a
+	// signal. Often, this will be because an exception was raised in the Python code and not
+	// caught, but could also indicate that the Python process crashed or was killed by some
+	// mechanism other than by MinKNOW.
+	ProtocolState_PROTOCOL_FINISHED_WITH_ERROR ProtocolState = 3
+	// The protocol was terminated because the sequencing device encountered an error.
+	ProtocolState_PROTOCOL_FINISHED_WITH_DEVICE_ERROR ProtocolState = 6
+	// The protocol was terminated because MinKNOW could not send telemetry to start acquisition.
+	ProtocolState_PROTOCOL_FINISHED_UNABLE_TO_SEND_TELEMETRY ProtocolState = 7
+)
+
+// Enum value maps for ProtocolState.
+var (
+	ProtocolState_name = map[int32]string{
+		0: "PROTOCOL_RUNNING",
+		4: "PROTOCOL_WAITING_FOR_TEMPERATURE",
+		5: "PROTOCOL_WAITING_FOR_ACQUISITION",
+		1: "PROTOCOL_COMPLETED",
+		2: "PROTOCOL_STOPPED_BY_USER",
+		3: "PROTOCOL_FINISHED_WITH_ERROR",
+		6: "PROTOCOL_FINISHED_WITH_DEVICE_ERROR",
+		7: "PROTOCOL_FINISHED_UNABLE_TO_SEND_TELEMETRY",
+	}
+	ProtocolState_value = map[string]int32{
+		"PROTOCOL_RUNNING":                           0,
+		"PROTOCOL_WAITING_FOR_TEMPERATURE":           4,
+		"PROTOCOL_WAITING_FOR_ACQUISITION":           5,
+		"PROTOCOL_COMPLETED":                         1,
+		"PROTOCOL_STOPPED_BY_USER":                   2,
+		"PROTOCOL_FINISHED_WITH_ERROR":               3,
+		"PROTOCOL_FINISHED_WITH_DEVICE_ERROR":        6,
+		"PROTOCOL_FINISHED_UNABLE_TO_SEND_TELEMETRY": 7,
+	}
+)
+
+func (x ProtocolState) Enum() *ProtocolState {
+	p := new(ProtocolState)
+	*p = x
+	return p
+}
+
+func (x ProtocolState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtocolState) Descriptor() protoreflect.EnumDescriptor {
+	return file_minknow_rpc_protocol_proto_enumTypes[0].Descriptor()
+}
+
+func (ProtocolState) Type() protoreflect.EnumType {
+	return &file_minknow_rpc_protocol_proto_enumTypes[0]
+}
+
+func (x ProtocolState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtocolState.Descriptor instead.
+func (ProtocolState) EnumDescriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{0}
+}
+
+type WaitForFinishedRequest_NotificationState int32
+
+const (
+	// Request return just after the protocol has temrinated, including any acquisition periods completing.
+	WaitForFinishedRequest_NOTIFY_ON_TERMINATION WaitForFinishedRequest_NotificationState = 0
+	// Request return prior to the script being termiated.
+	// note: will try to return 5s before killing the protocol script.
+	WaitForFinishedRequest_NOTIFY_BEFORE_TERMINATION WaitForFinishedRequest_NotificationState = 1
+	// Notify as the protocol script finishes, not waiting for any in progress acquisition to complete.
+	WaitForFinishedRequest_NOTIFY_ON_SCRIPT_TERMINATION WaitForFinishedRequest_NotificationState = 2
+)
+
+// Enum value maps for WaitForFinishedRequest_NotificationState.
+var (
+	WaitForFinishedRequest_NotificationState_name = map[int32]string{
+		0: "NOTIFY_ON_TERMINATION",
+		1: "NOTIFY_BEFORE_TERMINATION",
+		2: "NOTIFY_ON_SCRIPT_TERMINATION",
+	}
+	WaitForFinishedRequest_NotificationState_value = map[string]int32{
+		"NOTIFY_ON_TERMINATION":        0,
+		"NOTIFY_BEFORE_TERMINATION":    1,
+		"NOTIFY_ON_SCRIPT_TERMINATION": 2,
+	}
+)
+
+func (x WaitForFinishedRequest_NotificationState) Enum() *WaitForFinishedRequest_NotificationState {
+	p := new(WaitForFinishedRequest_NotificationState)
+	*p = x
+	return p
+}
+
+func (x WaitForFinishedRequest_NotificationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitForFinishedRequest_NotificationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_minknow_rpc_protocol_proto_enumTypes[1].Descriptor()
+}
+
+func (WaitForFinishedRequest_NotificationState) Type() protoreflect.EnumType {
+	return &file_minknow_rpc_protocol_proto_enumTypes[1]
+}
+
+func (x WaitForFinishedRequest_NotificationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitForFinishedRequest_NotificationState.Descriptor instead.
+func (WaitForFinishedRequest_NotificationState) EnumDescriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type ProtocolRunUserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The group which the experiment should be held in.
+	//
+	// note: This value could be unset if a user did not specify a group_id when starting the protocol.
+	ProtocolGroupId *wrappers.StringValue `protobuf:"bytes,1,opt,name=protocol_group_id,json=protocolGroupId,proto3" json:"protocol_group_id,omitempty"`
+	// sample_id created by the user
+	SampleId *wrappers.StringValue `protobuf:"bytes,2,opt,name=sample_id,json=sampleId,proto3" json:"sample_id,omitempty"`
+}
+
+func (x *ProtocolRunUserInfo) Reset() {
+	*x = ProtocolRunUserInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolRunUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolRunUserInfo) ProtoMessage() {}
+
+func (x *ProtocolRunUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolRunUserInfo.ProtoReflect.Descriptor instead.
+func (*ProtocolRunUserInfo) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProtocolRunUserInfo) GetProtocolGroupId() *wrappers.StringValue {
+	if x != nil {
+		return x.ProtocolGroupId
+	}
+	return nil
+}
+
+func (x *ProtocolRunUserInfo) GetSampleId() *wrappers.StringValue {
+	if x != nil {
+		return x.SampleId
+	}
+	return nil
+}
+
+type StartProtocolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the protocol, as provided by list_protocols().
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// The arguments to pass to the protocol.
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// User input describing the protocol.
+	UserInfo *ProtocolRunUserInfo `protobuf:"bytes,3,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+}
+
+func (x *StartProtocolRequest) Reset() {
+	*x = StartProtocolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartProtocolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartProtocolRequest) ProtoMessage() {}
+
+func (x *StartProtocolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartProtocolRequest.ProtoReflect.Descriptor instead.
+func (*StartProtocolRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartProtocolRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *StartProtocolRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *StartProtocolRequest) GetUserInfo() *ProtocolRunUserInfo {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
+}
+
+type StartProtocolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID generated when the protocol is started, to uniquely represent this protocol instance
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *StartProtocolResponse) Reset() {
+	*x = StartProtocolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartProtocolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartProtocolResponse) ProtoMessage() {}
+
+func (x *StartProtocolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartProtocolResponse.ProtoReflect.Descriptor instead.
+func (*StartProtocolResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartProtocolResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type StopProtocolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify how any running acquisition should
+	// be handled when stopping the protocol.
+	//
+	// Protocol state will enter PROTOCOL_WAITING_FOR_ACQUISITION whilst any running
+	// acquisition is finished.
+	//
+	// If a script ends on its own any analysis that was started is stopped, and it
+	// is allowed to catchup. If the caller wants to end catchup they can call stop_protocol
+	// to end catchup.
+	//
+	// Since 1.15
+	DataActionOnStop acquisition.StopRequest_DataAction `protobuf:"varint,1,opt,name=data_action_on_stop,json=dataActionOnStop,proto3,enum=ont.rpc.acquisition.StopRequest_DataAction" json:"data_action_on_stop,omitempty"`
+}
+
+func (x *StopProtocolRequest) Reset() {
+	*x = StopProtocolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopProtocolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopProtocolRequest) ProtoMessage() {}
+
+func (x *StopProtocolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopProtocolRequest.ProtoReflect.Descriptor instead.
+func (*StopProtocolRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StopProtocolRequest) GetDataActionOnStop() acquisition.StopRequest_DataAction {
+	if x != nil {
+		return x.DataActionOnStop
+	}
+	return acquisition.StopRequest_STOP_DEFAULT
+}
+
+type StopProtocolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopProtocolResponse) Reset() {
+	*x = StopProtocolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopProtocolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopProtocolResponse) ProtoMessage() {}
+
+func (x *StopProtocolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopProtocolResponse.ProtoReflect.Descriptor instead.
+func (*StopProtocolResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{4}
+}
+
+type ListProtocolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If this is false, then will try to use the cached value of the protocol list where possible
+	// (still subject to changes in flow cell).
+	// If this is true, then will force a reload of the protocol list
+	//
+	// Defaults to false
+	ForceReload bool `protobuf:"varint,1,opt,name=force_reload,json=forceReload,proto3" json:"force_reload,omitempty"`
+}
+
+func (x *ListProtocolsRequest) Reset() {
+	*x = ListProtocolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolsRequest) ProtoMessage() {}
+
+func (x *ListProtocolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolsRequest.ProtoReflect.Descriptor instead.
+func (*ListProtocolsRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListProtocolsRequest) GetForceReload() bool {
+	if x != nil {
+		return x.ForceReload
+	}
+	return false
+}
+
+type ProtocolInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier          string                            `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Tags                map[string]*ProtocolInfo_TagValue `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name                string                            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TagExtractionResult *ProtocolInfo_TagExtractionResult `protobuf:"bytes,4,opt,name=tag_extraction_result,json=tagExtractionResult,proto3" json:"tag_extraction_result,omitempty"`
+}
+
+func (x *ProtocolInfo) Reset() {
+	*x = ProtocolInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolInfo) ProtoMessage() {}
+
+func (x *ProtocolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolInfo.ProtoReflect.Descriptor instead.
+func (*ProtocolInfo) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProtocolInfo) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ProtocolInfo) GetTags() map[string]*ProtocolInfo_TagValue {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ProtocolInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtocolInfo) GetTagExtractionResult() *ProtocolInfo_TagExtractionResult {
+	if x != nil {
+		return x.TagExtractionResult
+	}
+	return nil
+}
+
+type ListProtocolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocols []*ProtocolInfo `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`
+}
+
+func (x *ListProtocolsResponse) Reset() {
+	*x = ListProtocolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolsResponse) ProtoMessage() {}
+
+func (x *ListProtocolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolsResponse.ProtoReflect.Descriptor instead.
+func (*ListProtocolsResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListProtocolsResponse) GetProtocols() []*ProtocolInfo {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+type WaitForFinishedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only consider a specific protocol run.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Control what to wait for.
+	//
+	// Specifying NOTIFY_BEFORE_TERMINATION allows a caller to be notified the script will be ended _soon_,
+	// and do final work to end cleanly.
+	//
+	// Since 1.11
+	State WaitForFinishedRequest_NotificationState `protobuf:"varint,2,opt,name=state,proto3,enum=ont.rpc.protocol.WaitForFinishedRequest_NotificationState" json:"state,omitempty"`
+	// Timeout to wait for finished, if the timeout expires before the protocol is complete (in the state requested)
+	// then the response returns.
+	//
+	// By default the timeout will wait forever.
+	//
+	// Since 1.15
+	Timeout float32 `protobuf:"fixed32,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *WaitForFinishedRequest) Reset() {
+	*x = WaitForFinishedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForFinishedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForFinishedRequest) ProtoMessage() {}
+
+func (x *WaitForFinishedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForFinishedRequest.ProtoReflect.Descriptor instead.
+func (*WaitForFinishedRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WaitForFinishedRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *WaitForFinishedRequest) GetState() WaitForFinishedRequest_NotificationState {
+	if x != nil {
+		return x.State
+	}
+	return WaitForFinishedRequest_NOTIFY_ON_TERMINATION
+}
+
+func (x *WaitForFinishedRequest) GetTimeout() float32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type GetRunInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The protocol run to get information about.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *GetRunInfoRequest) Reset() {
+	*x = GetRunInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRunInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRunInfoRequest) ProtoMessage() {}
+
+func (x *GetRunInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRunInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetRunInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRunInfoRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type Epi2MeWorkflowReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier for a workflow ( unique to a specific instance of epi2me )
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// URL that links to the workflow report. Syntax for a local workflow: file:// otherwise: http(s)://
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *Epi2MeWorkflowReference) Reset() {
+	*x = Epi2MeWorkflowReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Epi2MeWorkflowReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Epi2MeWorkflowReference) ProtoMessage() {}
+
+func (x *Epi2MeWorkflowReference) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Epi2MeWorkflowReference.ProtoReflect.Descriptor instead.
+func (*Epi2MeWorkflowReference) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Epi2MeWorkflowReference) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Epi2MeWorkflowReference) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ProtocolRunInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier assigned to this run.
+	//
+	// This is guaranteed to be made of ASCII characters, and at most 40 characters. It is globally
+	// unique across all protocols runs on all MinKNOW instances.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// The identifier of the protocol, as passed to start_protocol().
+	ProtocolId string `protobuf:"bytes,2,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	// The arguments passed to start_protocol().
+	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Output location for protocol data (eg. reads).
+	//
+	// Since 1.16.
+	OutputPath string `protobuf:"bytes,11,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	// Indicates the current state of the protocol run.
+	State ProtocolState `protobuf:"varint,4,opt,name=state,proto3,enum=ont.rpc.protocol.ProtocolState" json:"state,omitempty"`
+	// When the protocol was started (UTC).
+	StartTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// When the protocol script terminated (UTC).
+	//
+	// Unset if the protocol script is still running.
+	//
+	// Note that the protocol may still run until final acquisiton completes.
+	ScriptEndTime *timestamp.Timestamp `protobuf:"bytes,9,opt,name=script_end_time,json=scriptEndTime,proto3" json:"script_end_time,omitempty"`
+	// When the protocol terminated (UTC), indicating both the
+	// protocol script and the final acquisition were completed.
+	//
+	// Unset if the protocol is still running.
+	EndTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// A list of acquisition periods started whilst this protocol was running.
+	//
+	AcquisitionRunIds []string `protobuf:"bytes,7,rep,name=acquisition_run_ids,json=acquisitionRunIds,proto3" json:"acquisition_run_ids,omitempty"`
+	// User specified information associated with the protocol at start time.
+	//
+	// Since 1.11.
+	UserInfo *ProtocolRunUserInfo `protobuf:"bytes,8,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	// List of workflows from epi2me associated with this protocol
+	//
+	// Since 1.15.
+	Epi2MeWorkflows []*Epi2MeWorkflowReference `protobuf:"bytes,10,rep,name=epi2me_workflows,json=epi2meWorkflows,proto3" json:"epi2me_workflows,omitempty"`
+	// Information about the device a protocol was started with
+	//
+	// Since 3.4
+	Device *device.GetDeviceInfoResponse `protobuf:"bytes,12,opt,name=device,proto3" json:"device,omitempty"`
+	// Information about the flow cell a protocol was started with
+	//
+	// Since 3.4
+	FlowCell *device.GetFlowCellInfoResponse `protobuf:"bytes,13,opt,name=flow_cell,json=flowCell,proto3" json:"flow_cell,omitempty"`
+}
+
+func (x *ProtocolRunInfo) Reset() {
+	*x = ProtocolRunInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolRunInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolRunInfo) ProtoMessage() {}
+
+func (x *ProtocolRunInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolRunInfo.ProtoReflect.Descriptor instead.
+func (*ProtocolRunInfo) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProtocolRunInfo) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ProtocolRunInfo) GetProtocolId() string {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return ""
+}
+
+func (x *ProtocolRunInfo) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *ProtocolRunInfo) GetState() ProtocolState {
+	if x != nil {
+		return x.State
+	}
+	return ProtocolState_PROTOCOL_RUNNING
+}
+
+func (x *ProtocolRunInfo) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetScriptEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ScriptEndTime
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetAcquisitionRunIds() []string {
+	if x != nil {
+		return x.AcquisitionRunIds
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetUserInfo() *ProtocolRunUserInfo {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetEpi2MeWorkflows() []*Epi2MeWorkflowReference {
+	if x != nil {
+		return x.Epi2MeWorkflows
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetDevice() *device.GetDeviceInfoResponse {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *ProtocolRunInfo) GetFlowCell() *device.GetFlowCellInfoResponse {
+	if x != nil {
+		return x.FlowCell
+	}
+	return nil
+}
+
+type ListProtocolRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProtocolRunsRequest) Reset() {
+	*x = ListProtocolRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolRunsRequest) ProtoMessage() {}
+
+func (x *ListProtocolRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListProtocolRunsRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{12}
+}
+
+type ListProtocolRunsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds,proto3" json:"run_ids,omitempty"`
+}
+
+func (x *ListProtocolRunsResponse) Reset() {
+	*x = ListProtocolRunsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolRunsResponse) ProtoMessage() {}
+
+func (x *ListProtocolRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListProtocolRunsResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListProtocolRunsResponse) GetRunIds() []string {
+	if x != nil {
+		return x.RunIds
+	}
+	return nil
+}
+
+type GetCurrentProtocolRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCurrentProtocolRunRequest) Reset() {
+	*x = GetCurrentProtocolRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentProtocolRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentProtocolRunRequest) ProtoMessage() {}
+
+func (x *GetCurrentProtocolRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentProtocolRunRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentProtocolRunRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{14}
+}
+
+type GetCurrentProtocolRunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunInfo *ProtocolRunInfo `protobuf:"bytes,3,opt,name=run_info,json=runInfo,proto3" json:"run_info,omitempty"`
+}
+
+func (x *GetCurrentProtocolRunResponse) Reset() {
+	*x = GetCurrentProtocolRunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentProtocolRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentProtocolRunResponse) ProtoMessage() {}
+
+func (x *GetCurrentProtocolRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentProtocolRunResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentProtocolRunResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCurrentProtocolRunResponse) GetRunInfo() *ProtocolRunInfo {
+	if x != nil {
+		return x.RunInfo
+	}
+	return nil
+}
+
+type WatchCurrentProtocolRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchCurrentProtocolRunRequest) Reset() {
+	*x = WatchCurrentProtocolRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCurrentProtocolRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCurrentProtocolRunRequest) ProtoMessage() {}
+
+func (x *WatchCurrentProtocolRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCurrentProtocolRunRequest.ProtoReflect.Descriptor instead.
+func (*WatchCurrentProtocolRunRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{16}
+}
+
+type GetContextInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetContextInfoRequest) Reset() {
+	*x = GetContextInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContextInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContextInfoRequest) ProtoMessage() {}
+
+func (x *GetContextInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContextInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetContextInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{17}
+}
+
+type GetContextInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextInfo map[string]string `protobuf:"bytes,1,rep,name=context_info,json=contextInfo,proto3" json:"context_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetContextInfoResponse) Reset() {
+	*x = GetContextInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContextInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContextInfoResponse) ProtoMessage() {}
+
+func (x *GetContextInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContextInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetContextInfoResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetContextInfoResponse) GetContextInfo() map[string]string {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+type SetContextInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextInfo map[string]string `protobuf:"bytes,1,rep,name=context_info,json=contextInfo,proto3" json:"context_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SetContextInfoRequest) Reset() {
+	*x = SetContextInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContextInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContextInfoRequest) ProtoMessage() {}
+
+func (x *SetContextInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContextInfoRequest.ProtoReflect.Descriptor instead.
+func (*SetContextInfoRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetContextInfoRequest) GetContextInfo() map[string]string {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+type SetContextInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetContextInfoResponse) Reset() {
+	*x = SetContextInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContextInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContextInfoResponse) ProtoMessage() {}
+
+func (x *SetContextInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContextInfoResponse.ProtoReflect.Descriptor instead.
+func (*SetContextInfoResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{20}
+}
+
+type GetSampleIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSampleIdRequest) Reset() {
+	*x = GetSampleIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSampleIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSampleIdRequest) ProtoMessage() {}
+
+func (x *GetSampleIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSampleIdRequest.ProtoReflect.Descriptor instead.
+func (*GetSampleIdRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{21}
+}
+
+type GetSampleIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SampleId string `protobuf:"bytes,1,opt,name=sample_id,json=sampleId,proto3" json:"sample_id,omitempty"`
+}
+
+func (x *GetSampleIdResponse) Reset() {
+	*x = GetSampleIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSampleIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSampleIdResponse) ProtoMessage() {}
+
+func (x *GetSampleIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSampleIdResponse.ProtoReflect.Descriptor instead.
+func (*GetSampleIdResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSampleIdResponse) GetSampleId() string {
+	if x != nil {
+		return x.SampleId
+	}
+	return ""
+}
+
+type SetSampleIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SampleId string `protobuf:"bytes,1,opt,name=sample_id,json=sampleId,proto3" json:"sample_id,omitempty"`
+}
+
+func (x *SetSampleIdRequest) Reset() {
+	*x = SetSampleIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSampleIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSampleIdRequest) ProtoMessage() {}
+
+func (x *SetSampleIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSampleIdRequest.ProtoReflect.Descriptor instead.
+func (*SetSampleIdRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetSampleIdRequest) GetSampleId() string {
+	if x != nil {
+		return x.SampleId
+	}
+	return ""
+}
+
+type SetSampleIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetSampleIdResponse) Reset() {
+	*x = SetSampleIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSampleIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSampleIdResponse) ProtoMessage() {}
+
+func (x *SetSampleIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSampleIdResponse.ProtoReflect.Descriptor instead.
+func (*SetSampleIdResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{24}
+}
+
+type GetProtocolPurposeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProtocolPurposeRequest) Reset() {
+	*x = GetProtocolPurposeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProtocolPurposeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProtocolPurposeRequest) ProtoMessage() {}
+
+func (x *GetProtocolPurposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProtocolPurposeRequest.ProtoReflect.Descriptor instead.
+func (*GetProtocolPurposeRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{25}
+}
+
+type GetProtocolPurposeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purpose string `protobuf:"bytes,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+}
+
+func (x *GetProtocolPurposeResponse) Reset() {
+	*x = GetProtocolPurposeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProtocolPurposeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProtocolPurposeResponse) ProtoMessage() {}
+
+func (x *GetProtocolPurposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProtocolPurposeResponse.ProtoReflect.Descriptor instead.
+func (*GetProtocolPurposeResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetProtocolPurposeResponse) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+type SetProtocolPurposeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purpose string `protobuf:"bytes,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+}
+
+func (x *SetProtocolPurposeRequest) Reset() {
+	*x = SetProtocolPurposeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProtocolPurposeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProtocolPurposeRequest) ProtoMessage() {}
+
+func (x *SetProtocolPurposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProtocolPurposeRequest.ProtoReflect.Descriptor instead.
+func (*SetProtocolPurposeRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetProtocolPurposeRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+type SetProtocolPurposeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetProtocolPurposeResponse) Reset() {
+	*x = SetProtocolPurposeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProtocolPurposeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProtocolPurposeResponse) ProtoMessage() {}
+
+func (x *SetProtocolPurposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProtocolPurposeResponse.ProtoReflect.Descriptor instead.
+func (*SetProtocolPurposeResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{28}
+}
+
+type AddEpi2MeWorkflowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId          string                   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Epi2MeWorkflow *Epi2MeWorkflowReference `protobuf:"bytes,2,opt,name=epi2me_workflow,json=epi2meWorkflow,proto3" json:"epi2me_workflow,omitempty"`
+}
+
+func (x *AddEpi2MeWorkflowRequest) Reset() {
+	*x = AddEpi2MeWorkflowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEpi2MeWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEpi2MeWorkflowRequest) ProtoMessage() {}
+
+func (x *AddEpi2MeWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEpi2MeWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*AddEpi2MeWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddEpi2MeWorkflowRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *AddEpi2MeWorkflowRequest) GetEpi2MeWorkflow() *Epi2MeWorkflowReference {
+	if x != nil {
+		return x.Epi2MeWorkflow
+	}
+	return nil
+}
+
+type AddEpi2MeWorkflowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddEpi2MeWorkflowResponse) Reset() {
+	*x = AddEpi2MeWorkflowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEpi2MeWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEpi2MeWorkflowResponse) ProtoMessage() {}
+
+func (x *AddEpi2MeWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEpi2MeWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*AddEpi2MeWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{30}
+}
+
+type ListProtocolGroupIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProtocolGroupIdsRequest) Reset() {
+	*x = ListProtocolGroupIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolGroupIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolGroupIdsRequest) ProtoMessage() {}
+
+func (x *ListProtocolGroupIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolGroupIdsRequest.ProtoReflect.Descriptor instead.
+func (*ListProtocolGroupIdsRequest) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{31}
+}
+
+type ListProtocolGroupIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A list of protocol group ids used in any
+	// protocol started on this instance of minknow.
+	//
+	ProtocolGroupIds []string `protobuf:"bytes,1,rep,name=protocol_group_ids,json=protocolGroupIds,proto3" json:"protocol_group_ids,omitempty"`
+}
+
+func (x *ListProtocolGroupIdsResponse) Reset() {
+	*x = ListProtocolGroupIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtocolGroupIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtocolGroupIdsResponse) ProtoMessage() {}
+
+func (x *ListProtocolGroupIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtocolGroupIdsResponse.ProtoReflect.Descriptor instead.
+func (*ListProtocolGroupIdsResponse) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListProtocolGroupIdsResponse) GetProtocolGroupIds() []string {
+	if x != nil {
+		return x.ProtocolGroupIds
+	}
+	return nil
+}
+
+type ProtocolInfo_TagValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to TagValue:
+	//	*ProtocolInfo_TagValue_StringValue
+	//	*ProtocolInfo_TagValue_BoolValue
+	//	*ProtocolInfo_TagValue_IntValue
+	//	*ProtocolInfo_TagValue_DoubleValue
+	//	*ProtocolInfo_TagValue_ArrayValue
+	//	*ProtocolInfo_TagValue_ObjectValue
+	TagValue isProtocolInfo_TagValue_TagValue `protobuf_oneof:"tag_value"`
+}
+
+func (x *ProtocolInfo_TagValue) Reset() {
+	*x = ProtocolInfo_TagValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolInfo_TagValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolInfo_TagValue) ProtoMessage() {}
+
+func (x *ProtocolInfo_TagValue) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolInfo_TagValue.ProtoReflect.Descriptor instead.
+func (*ProtocolInfo_TagValue) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (m *ProtocolInfo_TagValue) GetTagValue() isProtocolInfo_TagValue_TagValue {
+	if m != nil {
+		return m.TagValue
+	}
+	return nil
+}
+
+func (x *ProtocolInfo_TagValue) GetStringValue() string {
+	if x, ok := x.GetTagValue().(*ProtocolInfo_TagValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *ProtocolInfo_TagValue) GetBoolValue() bool {
+	if x, ok := x.GetTagValue().(*ProtocolInfo_TagValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *ProtocolInfo_TagValue) GetIntValue() int64 {
+	if x, ok := x.GetTagValue().(*ProtocolInfo_TagValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *ProtocolInfo_TagValue) GetDoubleValue() float64 {
+	if x, ok := x.GetTagValue().(*ProtocolInfo_TagValue_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *ProtocolInfo_TagValue) GetArrayValue() string {
+	if x, ok := x.GetTagValue().(*ProtocolInfo_TagValue_ArrayValue); ok {
+		return x.ArrayValue
+	}
+	return ""
+}
+
+func (x *ProtocolInfo_TagValue) GetObjectValue() string {
+	if x, ok := x.GetTagValue().(*ProtocolInfo_TagValue_ObjectValue); ok {
+		return x.ObjectValue
+	}
+	return ""
+}
+
+type isProtocolInfo_TagValue_TagValue interface {
+	isProtocolInfo_TagValue_TagValue()
+}
+
+type ProtocolInfo_TagValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type ProtocolInfo_TagValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type ProtocolInfo_TagValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type ProtocolInfo_TagValue_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type ProtocolInfo_TagValue_ArrayValue struct {
+	ArrayValue string `protobuf:"bytes,5,opt,name=array_value,json=arrayValue,proto3,oneof"`
+}
+
+type ProtocolInfo_TagValue_ObjectValue struct {
+	ObjectValue string `protobuf:"bytes,6,opt,name=object_value,json=objectValue,proto3,oneof"`
+}
+
+func (*ProtocolInfo_TagValue_StringValue) isProtocolInfo_TagValue_TagValue() {}
+
+func (*ProtocolInfo_TagValue_BoolValue) isProtocolInfo_TagValue_TagValue() {}
+
+func (*ProtocolInfo_TagValue_IntValue) isProtocolInfo_TagValue_TagValue() {}
+
+func (*ProtocolInfo_TagValue_DoubleValue) isProtocolInfo_TagValue_TagValue() {}
+
+func (*ProtocolInfo_TagValue_ArrayValue) isProtocolInfo_TagValue_TagValue() {}
+
+func (*ProtocolInfo_TagValue_ObjectValue) isProtocolInfo_TagValue_TagValue() {}
+
+type ProtocolInfo_TagExtractionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorReport string `protobuf:"bytes,2,opt,name=error_report,json=errorReport,proto3" json:"error_report,omitempty"`
+}
+
+func (x *ProtocolInfo_TagExtractionResult) Reset() {
+	*x = ProtocolInfo_TagExtractionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_minknow_rpc_protocol_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolInfo_TagExtractionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolInfo_TagExtractionResult) ProtoMessage() {}
+
+func (x *ProtocolInfo_TagExtractionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_minknow_rpc_protocol_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolInfo_TagExtractionResult.ProtoReflect.Descriptor instead.
+func (*ProtocolInfo_TagExtractionResult) Descriptor() ([]byte, []int) {
+	return file_minknow_rpc_protocol_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *ProtocolInfo_TagExtractionResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProtocolInfo_TagExtractionResult) GetErrorReport() string {
+	if x != nil {
+		return x.ErrorReport
+	}
+	return ""
+}
+
+var File_minknow_rpc_protocol_proto protoreflect.FileDescriptor
+
+var file_minknow_rpc_protocol_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x1a, 0x1d,
+	0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6d,
+	0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x48, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2e, 0x0a, 0x15, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x13, 0x53,
+	0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5a, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x64, 0x61,
+	0x74, 0x61, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x22, 0x16,
+	0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0x8a, 0x05, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x74, 0x61, 0x67, 0x5f, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x61, 0x67, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x13, 0x74, 0x61, 0x67, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0xe9, 0x01, 0x0a,
+	0x08, 0x54, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f,
+	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x74,
+	0x61, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x52, 0x0a, 0x13, 0x54, 0x61, 0x67, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x60, 0x0a, 0x09,
+	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x61, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x16, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x50, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6f, 0x0a, 0x11, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x52, 0x4d,
+	0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x4f, 0x54,
+	0x49, 0x46, 0x59, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49,
+	0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x59, 0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x54, 0x45, 0x52,
+	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x2a, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x17, 0x45, 0x70, 0x69, 0x32, 0x6d, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0xba, 0x05, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x42, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x75, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x54, 0x0a, 0x10, 0x65, 0x70, 0x69, 0x32, 0x6d, 0x65, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x45, 0x70, 0x69, 0x32, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x65, 0x70, 0x69, 0x32,
+	0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x66, 0x6c,
+	0x6f, 0x77, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c,
+	0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x73,
+	0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x20, 0x0a, 0x1e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb4, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x31,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x35, 0x0a,
+	0x19, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x75, 0x72, 0x70,
+	0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72,
+	0x70, 0x6f, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x45, 0x70, 0x69, 0x32, 0x6d, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x0f,
+	0x65, 0x70, 0x69, 0x32, 0x6d, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x45, 0x70, 0x69, 0x32, 0x6d, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0e, 0x65, 0x70, 0x69, 0x32, 0x6d, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x45, 0x70, 0x69,
+	0x32, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73,
+	0x2a, 0xa2, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52,
+	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52,
+	0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x24,
+	0x0a, 0x20, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x51, 0x55, 0x49, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
+	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x5f, 0x42, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x2e, 0x0a, 0x2a, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x5f, 0x55, 0x4e, 0x41, 0x42, 0x4c,
+	0x45, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45,
+	0x54, 0x52, 0x59, 0x10, 0x07, 0x32, 0xc2, 0x0d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x26, 0x2e, 0x6f, 0x6e,
+	0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60,
+	0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x11, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x28, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x6d,
+	0x0a, 0x12, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x72, 0x75, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a,
+	0x18, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x12, 0x2e, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x75,
+	0x0a, 0x1a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x12, 0x30, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x10, 0x67, 0x65,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x27,
+	0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0d,
+	0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x2e,
+	0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12,
+	0x61, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x12, 0x24, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88,
+	0x02, 0x01, 0x12, 0x73, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2b, 0x2e, 0x6f, 0x6e, 0x74,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12,
+	0x2b, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f,
+	0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x75, 0x72, 0x70, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x13,
+	0x61, 0x64, 0x64, 0x5f, 0x65, 0x70, 0x69, 0x32, 0x6d, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x12, 0x2a, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x70, 0x69, 0x32, 0x6d, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x70, 0x69, 0x32, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a,
+	0x0a, 0x17, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x6e, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x6e, 0x74, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x69, 0x6c, 0x6c, 0x2d, 0x72, 0x6f,
+	0x77, 0x65, 0x2f, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x6d, 0x69,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_minknow_rpc_protocol_proto_rawDescOnce sync.Once
+	file_minknow_rpc_protocol_proto_rawDescData = file_minknow_rpc_protocol_proto_rawDesc
+)
+
+func file_minknow_rpc_protocol_proto_rawDescGZIP() []byte {
+	file_minknow_rpc_protocol_proto_rawDescOnce.Do(func() {
+		file_minknow_rpc_protocol_proto_rawDescData = protoimpl.X.CompressGZIP(file_minknow_rpc_protocol_proto_rawDescData)
+	})
+	return file_minknow_rpc_protocol_proto_rawDescData
+}
+
+var file_minknow_rpc_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_minknow_rpc_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_minknow_rpc_protocol_proto_goTypes = []interface{}{
+	(ProtocolState)(0), // 0: ont.rpc.protocol.ProtocolState
+	(WaitForFinishedRequest_NotificationState)(0), // 1: ont.rpc.protocol.WaitForFinishedRequest.NotificationState
+	(*ProtocolRunUserInfo)(nil),                   // 2: ont.rpc.protocol.ProtocolRunUserInfo
+	(*StartProtocolRequest)(nil),                  // 3: ont.rpc.protocol.StartProtocolRequest
+	(*StartProtocolResponse)(nil),                 // 4: ont.rpc.protocol.StartProtocolResponse
+	(*StopProtocolRequest)(nil),                   // 5: ont.rpc.protocol.StopProtocolRequest
+	(*StopProtocolResponse)(nil),                  // 6: ont.rpc.protocol.StopProtocolResponse
+	(*ListProtocolsRequest)(nil),                  // 7: ont.rpc.protocol.ListProtocolsRequest
+	(*ProtocolInfo)(nil),                          // 8: ont.rpc.protocol.ProtocolInfo
+	(*ListProtocolsResponse)(nil),                 // 9: ont.rpc.protocol.ListProtocolsResponse
+	(*WaitForFinishedRequest)(nil),                // 10: ont.rpc.protocol.WaitForFinishedRequest
+	(*GetRunInfoRequest)(nil),                     // 11: ont.rpc.protocol.GetRunInfoRequest
+	(*Epi2MeWorkflowReference)(nil),               // 12: ont.rpc.protocol.Epi2meWorkflowReference
+	(*ProtocolRunInfo)(nil),                       // 13: ont.rpc.protocol.ProtocolRunInfo
+	(*ListProtocolRunsRequest)(nil),               // 14: ont.rpc.protocol.ListProtocolRunsRequest
+	(*ListProtocolRunsResponse)(nil),              // 15: ont.rpc.protocol.ListProtocolRunsResponse
+	(*GetCurrentProtocolRunRequest)(nil),          // 16: ont.rpc.protocol.GetCurrentProtocolRunRequest
+	(*GetCurrentProtocolRunResponse)(nil),         // 17: ont.rpc.protocol.GetCurrentProtocolRunResponse
+	(*WatchCurrentProtocolRunRequest)(nil),        // 18: ont.rpc.protocol.WatchCurrentProtocolRunRequest
+	(*GetContextInfoRequest)(nil),                 // 19: ont.rpc.protocol.GetContextInfoRequest
+	(*GetContextInfoResponse)(nil),                // 20: ont.rpc.protocol.GetContextInfoResponse
+	(*SetContextInfoRequest)(nil),                 // 21: ont.rpc.protocol.SetContextInfoRequest
+	(*SetContextInfoResponse)(nil),                // 22: ont.rpc.protocol.SetContextInfoResponse
+	(*GetSampleIdRequest)(nil),                    // 23: ont.rpc.protocol.GetSampleIdRequest
+	(*GetSampleIdResponse)(nil),                   // 24: ont.rpc.protocol.GetSampleIdResponse
+	(*SetSampleIdRequest)(nil),                    // 25: ont.rpc.protocol.SetSampleIdRequest
+	(*SetSampleIdResponse)(nil),                   // 26: ont.rpc.protocol.SetSampleIdResponse
+	(*GetProtocolPurposeRequest)(nil),             // 27: ont.rpc.protocol.GetProtocolPurposeRequest
+	(*GetProtocolPurposeResponse)(nil),            // 28: ont.rpc.protocol.GetProtocolPurposeResponse
+	(*SetProtocolPurposeRequest)(nil),             // 29: ont.rpc.protocol.SetProtocolPurposeRequest
+	(*SetProtocolPurposeResponse)(nil),            // 30: ont.rpc.protocol.SetProtocolPurposeResponse
+	(*AddEpi2MeWorkflowRequest)(nil),              // 31: ont.rpc.protocol.AddEpi2meWorkflowRequest
+	(*AddEpi2MeWorkflowResponse)(nil),             // 32: ont.rpc.protocol.AddEpi2meWorkflowResponse
+	(*ListProtocolGroupIdsRequest)(nil),           // 33: ont.rpc.protocol.ListProtocolGroupIdsRequest
+	(*ListProtocolGroupIdsResponse)(nil),          // 34: ont.rpc.protocol.ListProtocolGroupIdsResponse
+	(*ProtocolInfo_TagValue)(nil),                 // 35: ont.rpc.protocol.ProtocolInfo.TagValue
+	(*ProtocolInfo_TagExtractionResult)(nil),      // 36: ont.rpc.protocol.ProtocolInfo.TagExtractionResult
+	nil,                                           // 37: ont.rpc.protocol.ProtocolInfo.TagsEntry
+	nil,                                           // 38: ont.rpc.protocol.GetContextInfoResponse.ContextInfoEntry
+	nil,                                           // 39: ont.rpc.protocol.SetContextInfoRequest.ContextInfoEntry
+	(*wrappers.StringValue)(nil),                  // 40: google.protobuf.StringValue
+	(acquisition.StopRequest_DataAction)(0),       // 41: ont.rpc.acquisition.StopRequest.DataAction
+	(*timestamp.Timestamp)(nil),                   // 42: google.protobuf.Timestamp
+	(*device.GetDeviceInfoResponse)(nil),          // 43: ont.rpc.device.GetDeviceInfoResponse
+	(*device.GetFlowCellInfoResponse)(nil),        // 44: ont.rpc.device.GetFlowCellInfoResponse
+}
+var file_minknow_rpc_protocol_proto_depIdxs = []int32{
+	40, // 0: ont.rpc.protocol.ProtocolRunUserInfo.protocol_group_id:type_name -> google.protobuf.StringValue
+	40, // 1: ont.rpc.protocol.ProtocolRunUserInfo.sample_id:type_name -> google.protobuf.StringValue
+	2,  // 2: ont.rpc.protocol.StartProtocolRequest.user_info:type_name -> ont.rpc.protocol.ProtocolRunUserInfo
+	41, // 3: ont.rpc.protocol.StopProtocolRequest.data_action_on_stop:type_name -> ont.rpc.acquisition.StopRequest.DataAction
+	37, // 4: ont.rpc.protocol.ProtocolInfo.tags:type_name -> ont.rpc.protocol.ProtocolInfo.TagsEntry
+	36, // 5: ont.rpc.protocol.ProtocolInfo.tag_extraction_result:type_name -> ont.rpc.protocol.ProtocolInfo.TagExtractionResult
+	8,  // 6: ont.rpc.protocol.ListProtocolsResponse.protocols:type_name -> ont.rpc.protocol.ProtocolInfo
+	1,  // 7: ont.rpc.protocol.WaitForFinishedRequest.state:type_name -> ont.rpc.protocol.WaitForFinishedRequest.NotificationState
+	0,  // 8: ont.rpc.protocol.ProtocolRunInfo.state:type_name -> ont.rpc.protocol.ProtocolState
+	42, // 9: ont.rpc.protocol.ProtocolRunInfo.start_time:type_name -> google.protobuf.Timestamp
+	42, // 10: ont.rpc.protocol.ProtocolRunInfo.script_end_time:type_name -> google.protobuf.Timestamp
+	42, // 11: ont.rpc.protocol.ProtocolRunInfo.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 12: ont.rpc.protocol.ProtocolRunInfo.user_info:type_name -> ont.rpc.protocol.ProtocolRunUserInfo
+	12, // 13: ont.rpc.protocol.ProtocolRunInfo.epi2me_workflows:type_name -> ont.rpc.protocol.Epi2meWorkflowReference
+	43, // 14: ont.rpc.protocol.ProtocolRunInfo.device:type_name -> ont.rpc.device.GetDeviceInfoResponse
+	44, // 15: ont.rpc.protocol.ProtocolRunInfo.flow_cell:type_name -> ont.rpc.device.GetFlowCellInfoResponse
+	13, // 16: ont.rpc.protocol.GetCurrentProtocolRunResponse.run_info:type_name -> ont.rpc.protocol.ProtocolRunInfo
+	38, // 17: ont.rpc.protocol.GetContextInfoResponse.context_info:type_name -> ont.rpc.protocol.GetContextInfoResponse.ContextInfoEntry
+	39, // 18: ont.rpc.protocol.SetContextInfoRequest.context_info:type_name -> ont.rpc.protocol.SetContextInfoRequest.ContextInfoEntry
+	12, // 19: ont.rpc.protocol.AddEpi2meWorkflowRequest.epi2me_workflow:type_name -> ont.rpc.protocol.Epi2meWorkflowReference
+	35, // 20: ont.rpc.protocol.ProtocolInfo.TagsEntry.value:type_name -> ont.rpc.protocol.ProtocolInfo.TagValue
+	3,  // 21: ont.rpc.protocol.ProtocolService.start_protocol:input_type -> ont.rpc.protocol.StartProtocolRequest
+	5,  // 22: ont.rpc.protocol.ProtocolService.stop_protocol:input_type -> ont.rpc.protocol.StopProtocolRequest
+	10, // 23: ont.rpc.protocol.ProtocolService.wait_for_finished:input_type -> ont.rpc.protocol.WaitForFinishedRequest
+	11, // 24: ont.rpc.protocol.ProtocolService.get_run_info:input_type -> ont.rpc.protocol.GetRunInfoRequest
+	14, // 25: ont.rpc.protocol.ProtocolService.list_protocol_runs:input_type -> ont.rpc.protocol.ListProtocolRunsRequest
+	16, // 26: ont.rpc.protocol.ProtocolService.get_current_protocol_run:input_type -> ont.rpc.protocol.GetCurrentProtocolRunRequest
+	18, // 27: ont.rpc.protocol.ProtocolService.watch_current_protocol_run:input_type -> ont.rpc.protocol.WatchCurrentProtocolRunRequest
+	7,  // 28: ont.rpc.protocol.ProtocolService.list_protocols:input_type -> ont.rpc.protocol.ListProtocolsRequest
+	19, // 29: ont.rpc.protocol.ProtocolService.get_context_info:input_type -> ont.rpc.protocol.GetContextInfoRequest
+	21, // 30: ont.rpc.protocol.ProtocolService.set_context_info:input_type -> ont.rpc.protocol.SetContextInfoRequest
+	23, // 31: ont.rpc.protocol.ProtocolService.get_sample_id:input_type -> ont.rpc.protocol.GetSampleIdRequest
+	25, // 32: ont.rpc.protocol.ProtocolService.set_sample_id:input_type -> ont.rpc.protocol.SetSampleIdRequest
+	27, // 33: ont.rpc.protocol.ProtocolService.get_protocol_purpose:input_type -> ont.rpc.protocol.GetProtocolPurposeRequest
+	29, // 34: ont.rpc.protocol.ProtocolService.set_protocol_purpose:input_type -> ont.rpc.protocol.SetProtocolPurposeRequest
+	31, // 35: ont.rpc.protocol.ProtocolService.add_epi2me_workflow:input_type -> ont.rpc.protocol.AddEpi2meWorkflowRequest
+	33, // 36: ont.rpc.protocol.ProtocolService.list_protocol_group_ids:input_type -> ont.rpc.protocol.ListProtocolGroupIdsRequest
+	4,  // 37: ont.rpc.protocol.ProtocolService.start_protocol:output_type -> ont.rpc.protocol.StartProtocolResponse
+	6,  // 38: ont.rpc.protocol.ProtocolService.stop_protocol:output_type -> ont.rpc.protocol.StopProtocolResponse
+	13, // 39: ont.rpc.protocol.ProtocolService.wait_for_finished:output_type -> ont.rpc.protocol.ProtocolRunInfo
+	13, // 40: ont.rpc.protocol.ProtocolService.get_run_info:output_type -> ont.rpc.protocol.ProtocolRunInfo
+	15, // 41: ont.rpc.protocol.ProtocolService.list_protocol_runs:output_type -> ont.rpc.protocol.ListProtocolRunsResponse
+	13, // 42: ont.rpc.protocol.ProtocolService.get_current_protocol_run:output_type -> ont.rpc.protocol.ProtocolRunInfo
+	13, // 43: ont.rpc.protocol.ProtocolService.watch_current_protocol_run:output_type -> ont.rpc.protocol.ProtocolRunInfo
+	9,  // 44: ont.rpc.protocol.ProtocolService.list_protocols:output_type -> ont.rpc.protocol.ListProtocolsResponse
+	20, // 45: ont.rpc.protocol.ProtocolService.get_context_info:output_type -> ont.rpc.protocol.GetContextInfoResponse
+	22, // 46: ont.rpc.protocol.ProtocolService.set_context_info:output_type -> ont.rpc.protocol.SetContextInfoResponse
+	24, // 47: ont.rpc.protocol.ProtocolService.get_sample_id:output_type -> ont.rpc.protocol.GetSampleIdResponse
+	26, // 48: ont.rpc.protocol.ProtocolService.set_sample_id:output_type -> ont.rpc.protocol.SetSampleIdResponse
+	28, // 49: ont.rpc.protocol.ProtocolService.get_protocol_purpose:output_type -> ont.rpc.protocol.GetProtocolPurposeResponse
+	30, // 50: ont.rpc.protocol.ProtocolService.set_protocol_purpose:output_type -> ont.rpc.protocol.SetProtocolPurposeResponse
+	32, // 51: ont.rpc.protocol.ProtocolService.add_epi2me_workflow:output_type -> ont.rpc.protocol.AddEpi2meWorkflowResponse
+	34, // 52: ont.rpc.protocol.ProtocolService.list_protocol_group_ids:output_type -> ont.rpc.protocol.ListProtocolGroupIdsResponse
+	37, // [37:53] is the sub-list for method output_type
+	21, // [21:37] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_minknow_rpc_protocol_proto_init() }
+func file_minknow_rpc_protocol_proto_init() {
+	if File_minknow_rpc_protocol_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_minknow_rpc_protocol_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolRunUserInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartProtocolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartProtocolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopProtocolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopProtocolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForFinishedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Epi2MeWorkflowReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolRunInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentProtocolRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentProtocolRunResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCurrentProtocolRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContextInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContextInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContextInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContextInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSampleIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSampleIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSampleIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSampleIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProtocolPurposeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProtocolPurposeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProtocolPurposeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProtocolPurposeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEpi2MeWorkflowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEpi2MeWorkflowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolGroupIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtocolGroupIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolInfo_TagValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_minknow_rpc_protocol_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolInfo_TagExtractionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_minknow_rpc_protocol_proto_msgTypes[33].OneofWrappers = []interface{}{
+		(*ProtocolInfo_TagValue_StringValue)(nil),
+		(*ProtocolInfo_TagValue_BoolValue)(nil),
+		(*ProtocolInfo_TagValue_IntValue)(nil),
+		(*ProtocolInfo_TagValue_DoubleValue)(nil),
+		(*ProtocolInfo_TagValue_ArrayValue)(nil),
+		(*ProtocolInfo_TagValue_ObjectValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_minknow_rpc_protocol_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   38,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_minknow_rpc_protocol_proto_goTypes,
+		DependencyIndexes: file_minknow_rpc_protocol_proto_depIdxs,
+		EnumInfos:         file_minknow_rpc_protocol_proto_enumTypes,
+		MessageInfos:      file_minknow_rpc_protocol_proto_msgTypes,
+	}.Build()
+	File_minknow_rpc_protocol_proto = out.File
+	file_minknow_rpc_protocol_proto_rawDesc = nil
+	file_minknow_rpc_protocol_proto_goTypes = nil
+	file_minknow_rpc_protocol_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ProtocolServiceClient is the client API for ProtocolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProtocolServiceClient interface {
+	// Initiates a python instance that runs the script specified by the `path` argument.
+	// `list_protocols` will give back a list of protocol scripts that can be started by this call
+	StartProtocol(ctx context.Context, in *StartProtocolRequest, opts ...grpc.CallOption) (*StartProtocolResponse, error)
+	// Stops the currently running protocol script instance.
+	StopProtocol(ctx context.Context, in *StopProtocolRequest, opts ...grpc.CallOption) (*StopProtocolResponse, error)
+	// Wait for a protocol run to finish.
+	//
+	// The call blocks until the run with the given run ID has finished (or returns immediately if
+	// it had already finished) and returns information about the protocol run.
+	//
+	// If no run has been started with the provided run ID (or no run ID is given), an error is
+	// returned.
+	//
+	// If NOTIFY_BEFORE_TERMINATION is specified for state, the protocol end time is an estimate, including
+	// the allowed timeout.
+	//
+	// Since 1.10
+	WaitForFinished(ctx context.Context, in *WaitForFinishedRequest, opts ...grpc.CallOption) (*ProtocolRunInfo, error)
+	// Gets information about a protocol run.
+	//
+	// If no run ID is provided, information about the most recently started protocol run is
+	// provided.
+	//
+	// Since 1.10
+	GetRunInfo(ctx context.Context, in *GetRunInfoRequest, opts ...grpc.CallOption) (*ProtocolRunInfo, error)
+	// List previously started protocol run ids (including any current protocol), in order of starting.
+	//
+	// The returned object can be used to find protocol information with get_run_info.
+	//
+	// Since 1.11
+	ListProtocolRuns(ctx context.Context, in *ListProtocolRunsRequest, opts ...grpc.CallOption) (*ListProtocolRunsResponse, error)
+	// Returns the name and run id of the currently running protocol.
+	//
+	// Will fail with FAILED_PRECONDITION if there is no protocol running
+	//
+	// Since 1.11
+	GetCurrentProtocolRun(ctx context.Context, in *GetCurrentProtocolRunRequest, opts ...grpc.CallOption) (*ProtocolRunInfo, error)
+	// Returns current protocol run info and streams any changes to the current protocol
+	//
+	// This call can be made even if there is no current protocol running. In this case, the next streamed
+	// response will be the start of a new protocol instance and you will receive updates for that protocol
+	// until it finishes
+	//
+	// If a protocol finishes this stream will still continue to run and you will be notified when a new protocol starts
+	//
+	// Since 1.12
+	WatchCurrentProtocolRun(ctx context.Context, in *WatchCurrentProtocolRunRequest, opts ...grpc.CallOption) (ProtocolService_WatchCurrentProtocolRunClient, error)
+	// Gives back a list that contains info about each possible protocol script minknow is aware of.
+	// This will most likely be used to retrieve a suitable protocol script that can be passed on to `start_protocol`
+	//
+	// Since 1.10
+	ListProtocols(ctx context.Context, in *ListProtocolsRequest, opts ...grpc.CallOption) (*ListProtocolsResponse, error)
+	// Returns string-to-string map of the context tags
+	//
+	// Since 1.11
+	GetContextInfo(ctx context.Context, in *GetContextInfoRequest, opts ...grpc.CallOption) (*GetContextInfoResponse, error)
+	// Store context tags as arbitary string-to-string map
+	//
+	// Since 1.11
+	SetContextInfo(ctx context.Context, in *SetContextInfoRequest, opts ...grpc.CallOption) (*SetContextInfoResponse, error)
+	// Deprecated: Do not use.
+	// Sample id is a value set from the GUI that is defined by the user to identify an experiment.
+	// This call gets this value
+	// Deprecated: The sample_id is provided in the ProtocolRunUserInfo
+	//
+	// Since 1.11
+	GetSampleId(ctx context.Context, in *GetSampleIdRequest, opts ...grpc.CallOption) (*GetSampleIdResponse, error)
+	// Deprecated: Do not use.
+	// Sets the sample id. Refer to get_sample_id for what sample id is used for
+	// Deprecated: The sample_id should be set in the request when a protocol starts ( start_protocol() )
+	//
+	// Since 1.11
+	SetSampleId(ctx context.Context, in *SetSampleIdRequest, opts ...grpc.CallOption) (*SetSampleIdResponse, error)
+	// Value set by protocol scripts to define the purpose of the script. Gets this value
+	//
+	// Since 1.11
+	GetProtocolPurpose(ctx context.Context, in *GetProtocolPurposeRequest, opts ...grpc.CallOption) (*GetProtocolPurposeResponse, error)
+	// Sets the protocol purpose. See also get_protocol_purpose
+	//
+	// Since 1.11
+	SetProtocolPurpose(ctx context.Context, in *SetProtocolPurposeRequest, opts ...grpc.CallOption) (*SetProtocolPurposeResponse, error)
+	// Links an epi2me workflow reference to a run id.
+	//
+	// Since 1.15
+	AddEpi2MeWorkflow(ctx context.Context, in *AddEpi2MeWorkflowRequest, opts ...grpc.CallOption) (*AddEpi2MeWorkflowResponse, error)
+	// List all used protocol group ids used in any previous protocol on the box.
+	//
+	// Since 3.2
+	ListProtocolGroupIds(ctx context.Context, in *ListProtocolGroupIdsRequest, opts ...grpc.CallOption) (*ListProtocolGroupIdsResponse, error)
+}
+
+type protocolServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProtocolServiceClient(cc grpc.ClientConnInterface) ProtocolServiceClient {
+	return &protocolServiceClient{cc}
+}
+
+func (c *protocolServiceClient) StartProtocol(ctx context.Context, in *StartProtocolRequest, opts ...grpc.CallOption) (*StartProtocolResponse, error) {
+	out := new(StartProtocolResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/start_protocol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) StopProtocol(ctx context.Context, in *StopProtocolRequest, opts ...grpc.CallOption) (*StopProtocolResponse, error) {
+	out := new(StopProtocolResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/stop_protocol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) WaitForFinished(ctx context.Context, in *WaitForFinishedRequest, opts ...grpc.CallOption) (*ProtocolRunInfo, error) {
+	out := new(ProtocolRunInfo)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/wait_for_finished", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) GetRunInfo(ctx context.Context, in *GetRunInfoRequest, opts ...grpc.CallOption) (*ProtocolRunInfo, error) {
+	out := new(ProtocolRunInfo)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/get_run_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) ListProtocolRuns(ctx context.Context, in *ListProtocolRunsRequest, opts ...grpc.CallOption) (*ListProtocolRunsResponse, error) {
+	out := new(ListProtocolRunsResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/list_protocol_runs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) GetCurrentProtocolRun(ctx context.Context, in *GetCurrentProtocolRunRequest, opts ...grpc.CallOption) (*ProtocolRunInfo, error) {
+	out := new(ProtocolRunInfo)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/get_current_protocol_run", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) WatchCurrentProtocolRun(ctx context.Context, in *WatchCurrentProtocolRunRequest, opts ...grpc.CallOption) (ProtocolService_WatchCurrentProtocolRunClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProtocolService_serviceDesc.Streams[0], "/ont.rpc.protocol.ProtocolService/watch_current_protocol_run", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &protocolServiceWatchCurrentProtocolRunClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProtocolService_WatchCurrentProtocolRunClient interface {
+	Recv() (*ProtocolRunInfo, error)
+	grpc.ClientStream
+}
+
+type protocolServiceWatchCurrentProtocolRunClient struct {
+	grpc.ClientStream
+}
+
+func (x *protocolServiceWatchCurrentProtocolRunClient) Recv() (*ProtocolRunInfo, error) {
+	m := new(ProtocolRunInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *protocolServiceClient) ListProtocols(ctx context.Context, in *ListProtocolsRequest, opts ...grpc.CallOption) (*ListProtocolsResponse, error) {
+	out := new(ListProtocolsResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/list_protocols", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) GetContextInfo(ctx context.Context, in *GetContextInfoRequest, opts ...grpc.CallOption) (*GetContextInfoResponse, error) {
+	out := new(GetContextInfoResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/get_context_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) SetContextInfo(ctx context.Context, in *SetContextInfoRequest, opts ...grpc.CallOption) (*SetContextInfoResponse, error) {
+	out := new(SetContextInfoResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/set_context_info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *protocolServiceClient) GetSampleId(ctx context.Context, in *GetSampleIdRequest, opts ...grpc.CallOption) (*GetSampleIdResponse, error) {
+	out := new(GetSampleIdResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/get_sample_id", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *protocolServiceClient) SetSampleId(ctx context.Context, in *SetSampleIdRequest, opts ...grpc.CallOption) (*SetSampleIdResponse, error) {
+	out := new(SetSampleIdResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/set_sample_id", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) GetProtocolPurpose(ctx context.Context, in *GetProtocolPurposeRequest, opts ...grpc.CallOption) (*GetProtocolPurposeResponse, error) {
+	out := new(GetProtocolPurposeResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/get_protocol_purpose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) SetProtocolPurpose(ctx context.Context, in *SetProtocolPurposeRequest, opts ...grpc.CallOption) (*SetProtocolPurposeResponse, error) {
+	out := new(SetProtocolPurposeResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/set_protocol_purpose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) AddEpi2MeWorkflow(ctx context.Context, in *AddEpi2MeWorkflowRequest, opts ...grpc.CallOption) (*AddEpi2MeWorkflowResponse, error) {
+	out := new(AddEpi2MeWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/add_epi2me_workflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) ListProtocolGroupIds(ctx context.Context, in *ListProtocolGroupIdsRequest, opts ...grpc.CallOption) (*ListProtocolGroupIdsResponse, error) {
+	out := new(ListProtocolGroupIdsResponse)
+	err := c.cc.Invoke(ctx, "/ont.rpc.protocol.ProtocolService/list_protocol_group_ids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProtocolServiceServer is the server API for ProtocolService service.
+type ProtocolServiceServer interface {
+	// Initiates a python instance that runs the script specified by the `path` argument.
+	// `list_protocols` will give back a list of protocol scripts that can be started by this call
+	StartProtocol(context.Context, *StartProtocolRequest) (*StartProtocolResponse, error)
+	// Stops the currently running protocol script instance.
+	StopProtocol(context.Context, *StopProtocolRequest) (*StopProtocolResponse, error)
+	// Wait for a protocol run to finish.
+	//
+	// The call blocks until the run with the given run ID has finished (or returns immediately if
+	// it had already finished) and returns information about the protocol run.
+	//
+	// If no run has been started with the provided run ID (or no run ID is given), an error is
+	// returned.
+	//
+	// If NOTIFY_BEFORE_TERMINATION is specified for state, the protocol end time is an estimate, including
+	// the allowed timeout.
+	//
+	// Since 1.10
+	WaitForFinished(context.Context, *WaitForFinishedRequest) (*ProtocolRunInfo, error)
+	// Gets information about a protocol run.
+	//
+	// If no run ID is provided, information about the most recently started protocol run is
+	// provided.
+	//
+	// Since 1.10
+	GetRunInfo(context.Context, *GetRunInfoRequest) (*ProtocolRunInfo, error)
+	// List previously started protocol run ids (including any current protocol), in order of starting.
+	//
+	// The returned object can be used to find protocol information with get_run_info.
+	//
+	// Since 1.11
+	ListProtocolRuns(context.Context, *ListProtocolRunsRequest) (*ListProtocolRunsResponse, error)
+	// Returns the name and run id of the currently running protocol.
+	//
+	// Will fail with FAILED_PRECONDITION if there is no protocol running
+	//
+	// Since 1.11
+	GetCurrentProtocolRun(context.Context, *GetCurrentProtocolRunRequest) (*ProtocolRunInfo, error)
+	// Returns current protocol run info and streams any changes to the current protocol
+	//
+	// This call can be made even if there is no current protocol running. In this case, the next streamed
+	// response will be the start of a new protocol instance and you will receive updates for that protocol
+	// until it finishes
+	//
+	// If a protocol finishes this stream will still continue to run and you will be notified when a new protocol starts
+	//
+	// Since 1.12
+	WatchCurrentProtocolRun(*WatchCurrentProtocolRunRequest, ProtocolService_WatchCurrentProtocolRunServer) error
+	// Gives back a list that contains info about each possible protocol script minknow is aware of.
+	// This will most likely be used to retrieve a suitable protocol script that can be passed on to `start_protocol`
+	//
+	// Since 1.10
+	ListProtocols(context.Context, *ListProtocolsRequest) (*ListProtocolsResponse, error)
+	// Returns string-to-string map of the context tags
+	//
+	// Since 1.11
+	GetContextInfo(context.Context, *GetContextInfoRequest) (*GetContextInfoResponse, error)
+	// Store context tags as arbitary string-to-string map
+	//
+	// Since 1.11
+	SetContextInfo(context.Context, *SetContextInfoRequest) (*SetContextInfoResponse, error)
+	// Deprecated: Do not use.
+	// Sample id is a value set from the GUI that is defined by the user to identify an experiment.
+	// This call gets this value
+	// Deprecated: The sample_id is provided in the ProtocolRunUserInfo
+	//
+	// Since 1.11
+	GetSampleId(context.Context, *GetSampleIdRequest) (*GetSampleIdResponse, error)
+	// Deprecated: Do not use.
+	// Sets the sample id. Refer to get_sample_id for what sample id is used for
+	// Deprecated: The sample_id should be set in the request when a protocol starts ( start_protocol() )
+	//
+	// Since 1.11
+	SetSampleId(context.Context, *SetSampleIdRequest) (*SetSampleIdResponse, error)
+	// Value set by protocol scripts to define the purpose of the script. Gets this value
+	//
+	// Since 1.11
+	GetProtocolPurpose(context.Context, *GetProtocolPurposeRequest) (*GetProtocolPurposeResponse, error)
+	// Sets the protocol purpose. See also get_protocol_purpose
+	//
+	// Since 1.11
+	SetProtocolPurpose(context.Context, *SetProtocolPurposeRequest) (*SetProtocolPurposeResponse, error)
+	// Links an epi2me workflow reference to a run id.
+	//
+	// Since 1.15
+	AddEpi2MeWorkflow(context.Context, *AddEpi2MeWorkflowRequest) (*AddEpi2MeWorkflowResponse, error)
+	// List all used protocol group ids used in any previous protocol on the box.
+	//
+	// Since 3.2
+	ListProtocolGroupIds(context.Context, *ListProtocolGroupIdsRequest) (*ListProtocolGroupIdsResponse, error)
+}
+
+// UnimplementedProtocolServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProtocolServiceServer struct {
+}
+
+func (*UnimplementedProtocolServiceServer) StartProtocol(context.Context, *StartProtocolRequest) (*StartProtocolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartProtocol not implemented")
+}
+func (*UnimplementedProtocolServiceServer) StopProtocol(context.Context, *StopProtocolRequest) (*StopProtocolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopProtocol not implemented")
+}
+func (*UnimplementedProtocolServiceServer) WaitForFinished(context.Context, *WaitForFinishedRequest) (*ProtocolRunInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForFinished not implemented")
+}
+func (*UnimplementedProtocolServiceServer) GetRunInfo(context.Context, *GetRunInfoRequest) (*ProtocolRunInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunInfo not implemented")
+}
+func (*UnimplementedProtocolServiceServer) ListProtocolRuns(context.Context, *ListProtocolRunsRequest) (*ListProtocolRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtocolRuns not implemented")
+}
+func (*UnimplementedProtocolServiceServer) GetCurrentProtocolRun(context.Context, *GetCurrentProtocolRunRequest) (*ProtocolRunInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentProtocolRun not implemented")
+}
+func (*UnimplementedProtocolServiceServer) WatchCurrentProtocolRun(*WatchCurrentProtocolRunRequest, ProtocolService_WatchCurrentProtocolRunServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCurrentProtocolRun not implemented")
+}
+func (*UnimplementedProtocolServiceServer) ListProtocols(context.Context, *ListProtocolsRequest) (*ListProtocolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtocols not implemented")
+}
+func (*UnimplementedProtocolServiceServer) GetContextInfo(context.Context, *GetContextInfoRequest) (*GetContextInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContextInfo not implemented")
+}
+func (*UnimplementedProtocolServiceServer) SetContextInfo(context.Context, *SetContextInfoRequest) (*SetContextInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContextInfo not implemented")
+}
+func (*UnimplementedProtocolServiceServer) GetSampleId(context.Context, *GetSampleIdRequest) (*GetSampleIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSampleId not implemented")
+}
+func (*UnimplementedProtocolServiceServer) SetSampleId(context.Context, *SetSampleIdRequest) (*SetSampleIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSampleId not implemented")
+}
+func (*UnimplementedProtocolServiceServer) GetProtocolPurpose(context.Context, *GetProtocolPurposeRequest) (*GetProtocolPurposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtocolPurpose not implemented")
+}
+func (*UnimplementedProtocolServiceServer) SetProtocolPurpose(context.Context, *SetProtocolPurposeRequest) (*SetProtocolPurposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProtocolPurpose not implemented")
+}
+func (*UnimplementedProtocolServiceServer) AddEpi2MeWorkflow(context.Context, *AddEpi2MeWorkflowRequest) (*AddEpi2MeWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEpi2MeWorkflow not implemented")
+}
+func (*UnimplementedProtocolServiceServer) ListProtocolGroupIds(context.Context, *ListProtocolGroupIdsRequest) (*ListProtocolGroupIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtocolGroupIds not implemented")
+}
+
+func RegisterProtocolServiceServer(s *grpc.Server, srv ProtocolServiceServer) {
+	s.RegisterService(&_ProtocolService_serviceDesc, srv)
+}
+
+func _ProtocolService_StartProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartProtocolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).StartProtocol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/StartProtocol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).StartProtocol(ctx, req.(*StartProtocolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_StopProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopProtocolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).StopProtocol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/StopProtocol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).StopProtocol(ctx, req.(*StopProtocolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_WaitForFinished_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForFinishedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).WaitForFinished(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/WaitForFinished",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).WaitForFinished(ctx, req.(*WaitForFinishedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_GetRunInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).GetRunInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/GetRunInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).GetRunInfo(ctx, req.(*GetRunInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_ListProtocolRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProtocolRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).ListProtocolRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/ListProtocolRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).ListProtocolRuns(ctx, req.(*ListProtocolRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_GetCurrentProtocolRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentProtocolRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).GetCurrentProtocolRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/GetCurrentProtocolRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).GetCurrentProtocolRun(ctx, req.(*GetCurrentProtocolRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_WatchCurrentProtocolRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCurrentProtocolRunRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProtocolServiceServer).WatchCurrentProtocolRun(m, &protocolServiceWatchCurrentProtocolRunServer{stream})
+}
+
+type ProtocolService_WatchCurrentProtocolRunServer interface {
+	Send(*ProtocolRunInfo) error
+	grpc.ServerStream
+}
+
+type protocolServiceWatchCurrentProtocolRunServer struct {
+	grpc.ServerStream
+}
+
+func (x *protocolServiceWatchCurrentProtocolRunServer) Send(m *ProtocolRunInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProtocolService_ListProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProtocolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).ListProtocols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/ListProtocols",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).ListProtocols(ctx, req.(*ListProtocolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_GetContextInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContextInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).GetContextInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/GetContextInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).GetContextInfo(ctx, req.(*GetContextInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_SetContextInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContextInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).SetContextInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/SetContextInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).SetContextInfo(ctx, req.(*SetContextInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_GetSampleId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSampleIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).GetSampleId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/GetSampleId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).GetSampleId(ctx, req.(*GetSampleIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_SetSampleId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSampleIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).SetSampleId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/SetSampleId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).SetSampleId(ctx, req.(*SetSampleIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_GetProtocolPurpose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProtocolPurposeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).GetProtocolPurpose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/GetProtocolPurpose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).GetProtocolPurpose(ctx, req.(*GetProtocolPurposeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_SetProtocolPurpose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProtocolPurposeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).SetProtocolPurpose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/SetProtocolPurpose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).SetProtocolPurpose(ctx, req.(*SetProtocolPurposeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_AddEpi2MeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEpi2MeWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).AddEpi2MeWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/AddEpi2MeWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).AddEpi2MeWorkflow(ctx, req.(*AddEpi2MeWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_ListProtocolGroupIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProtocolGroupIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).ListProtocolGroupIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ont.rpc.protocol.ProtocolService/ListProtocolGroupIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).ListProtocolGroupIds(ctx, req.(*ListProtocolGroupIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProtocolService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ont.rpc.protocol.ProtocolService",
+	HandlerType: (*ProtocolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "start_protocol",
+			Handler:    _ProtocolService_StartProtocol_Handler,
+		},
+		{
+			MethodName: "stop_protocol",
+			Handler:    _ProtocolService_StopProtocol_Handler,
+		},
+		{
+			MethodName: "wait_for_finished",
+			Handler:    _ProtocolService_WaitForFinished_Handler,
+		},
+		{
+			MethodName: "get_run_info",
+			Handler:    _ProtocolService_GetRunInfo_Handler,
+		},
+		{
+			MethodName: "list_protocol_runs",
+			Handler:    _ProtocolService_ListProtocolRuns_Handler,
+		},
+		{
+			MethodName: "get_current_protocol_run",
+			Handler:    _ProtocolService_GetCurrentProtocolRun_Handler,
+		},
+		{
+			MethodName: "list_protocols",
+			Handler:    _ProtocolService_ListProtocols_Handler,
+		},
+		{
+			MethodName: "get_context_info",
+			Handler:    _ProtocolService_GetContextInfo_Handler,
+		},
+		{
+			MethodName: "set_context_info",
+			Handler:    _ProtocolService_SetContextInfo_Handler,
+		},
+		{
+			MethodName: "get_sample_id",
+			Handler:    _ProtocolService_GetSampleId_Handler,
+		},
+		{
+			MethodName: "set_sample_id",
+			Handler:    _ProtocolService_SetSampleId_Handler,
+		},
+		{
+			MethodName: "get_protocol_purpose",
+			Handler:    _ProtocolService_GetProtocolPurpose_Handler,
+		},
+		{
+			MethodName: "set_protocol_purpose",
+			Handler:    _ProtocolService_SetProtocolPurpose_Handler,
+		},
+		{
+			MethodName: "add_epi2me_workflow",
+			Handler:    _ProtocolService_AddEpi2MeWorkflow_Handler,
+		},
+		{
+			MethodName: "list_protocol_group_ids",
+			Handler:    _ProtocolService_ListProtocolGroupIds_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "watch_current_protocol_run",
+			Handler:       _ProtocolService_WatchCurrentProtocolRun_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "minknow/rpc/protocol.proto",
+}
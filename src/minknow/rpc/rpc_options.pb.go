@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: minknow/rpc/rpc_options.proto
+
+package rpc
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+var file_minknow_rpc_rpc_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptor.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50001,
+		Name:          "ont.rpc.rpc_required",
+		Tag:           "varint,50001,opt,name=rpc_required",
+		Filename:      "minknow/rpc/rpc_options.proto",
+	},
+	{
+		ExtendedType:  (*descriptor.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50002,
+		Name:          "ont.rpc.rpc_unwrap",
+		Tag:           "varint,50002,opt,name=rpc_unwrap",
+		Filename:      "minknow/rpc/rpc_options.proto",
+	},
+}
+
+// Extension fields to descriptor.FieldOptions.
+var (
+	// Marks a field as required.
+	//
+	// This is only used at the service layer, to error out before attempting
+	// the RPC if the value is not provided. It does not affect the wire format.
+	//
+	// optional bool rpc_required = 50001;
+	E_RpcRequired = &file_minknow_rpc_rpc_options_proto_extTypes[0]
+	// Unwrap a message field.
+	//
+	// If you have the following:
+	//
+	// message A {
+	//   bool  field1 = 1;
+	//   float field2 = 2;
+	// }
+	// message B {
+	//   A submessage = 1 [(rpc_unwrap) = true];
+	// }
+	//
+	// and message B is used as the arguments message for an RPC call, then the
+	// fields of message A will be accepted directly as parameters in the rpc
+	// call wrapper.
+	//
+	// Note that you must take care to avoid name clashes - if A and B both had
+	// a field with the same name, this would result in an error.
+	//
+	// optional bool rpc_unwrap = 50002;
+	E_RpcUnwrap = &file_minknow_rpc_rpc_options_proto_extTypes[1]
+)
+
+var File_minknow_rpc_rpc_options_proto protoreflect.FileDescriptor
+
+var file_minknow_rpc_rpc_options_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x70,
+	0x63, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x6f, 0x6e, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x42, 0x0a, 0x0c, 0x72, 0x70,
+	0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x72, 0x70, 0x63, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x3a, 0x3e,
+	0x0a, 0x0a, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x12, 0x1d, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd2, 0x86, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x70, 0x63, 0x55, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x42, 0x2d,
+	0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x69, 0x6c,
+	0x6c, 0x2d, 0x72, 0x6f, 0x77, 0x65, 0x2f, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x2f, 0x73, 0x72,
+	0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_minknow_rpc_rpc_options_proto_goTypes = []interface{}{
+	(*descriptor.FieldOptions)(nil), // 0: google.protobuf.FieldOptions
+}
+var file_minknow_rpc_rpc_options_proto_depIdxs = []int32{
+	0, // 0: ont.rpc.rpc_required:extendee -> google.protobuf.FieldOptions
+	0, // 1: ont.rpc.rpc_unwrap:extendee -> google.protobuf.FieldOptions
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	0, // [0:2] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_minknow_rpc_rpc_options_proto_init() }
+func file_minknow_rpc_rpc_options_proto_init() {
+	if File_minknow_rpc_rpc_options_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_minknow_rpc_rpc_options_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_minknow_rpc_rpc_options_proto_goTypes,
+		DependencyIndexes: file_minknow_rpc_rpc_options_proto_depIdxs,
+		ExtensionInfos:    file_minknow_rpc_rpc_options_proto_extTypes,
+	}.Build()
+	File_minknow_rpc_rpc_options_proto = out.File
+	file_minknow_rpc_rpc_options_proto_rawDesc = nil
+	file_minknow_rpc_rpc_options_proto_goTypes = nil
+	file_minknow_rpc_rpc_options_proto_depIdxs = nil
+}
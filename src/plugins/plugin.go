@@ -0,0 +1,120 @@
+// Package plugins defines the gRPC protocol that lets external programs offer services to Herald
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/will-rowe/herald/src/callbacks"
+)
+
+const (
+	// ProtocolVersion is the version of the plugin protocol.
+	ProtocolVersion = 1
+
+	// HandshakePrefix starts the line a plugin writes to
+	// stdout once it is ready, which is formatted as
+	// "HERALD_PLUGIN|<protocol version>|<address>".
+	HandshakePrefix = "HERALD_PLUGIN"
+
+	// EnvProtocol is the environment variable that Herald
+	// sets to the protocol version when it starts a plugin.
+	EnvProtocol = "HERALD_PLUGIN_PROTOCOL"
+
+	// EnvCallbackAddress is the environment variable that holds
+	// the address of the gRPC callback server.
+	EnvCallbackAddress = "HERALD_CALLBACK_ADDRESS"
+
+	// EnvCallbackHTTPAddress is the environment variable that
+	// holds the address of the HTTP callback server.
+	EnvCallbackHTTPAddress = "HERALD_CALLBACK_HTTP_ADDRESS"
+
+	// EnvCallbackToken is the environment variable that holds
+	// the token for reporting to the callback server.
+	EnvCallbackToken = "HERALD_CALLBACK_TOKEN"
+)
+
+var (
+	// ErrNotLaunched is returned if a plugin is run without being started by Herald.
+	ErrNotLaunched = errors.New("plugins must be started by Herald")
+
+	// ErrBadHandshake is returned if a plugin does not start with a valid handshake.
+	ErrBadHandshake = errors.New("invalid plugin handshake")
+)
+
+// Handshake returns the line a plugin writes
+// to stdout once it is listening on an address.
+func Handshake(address string) string {
+	return fmt.Sprintf("%v|%d|%v", HandshakePrefix, ProtocolVersion, address)
+}
+
+// ParseHandshake will check the handshake line from a
+// plugin and return the address it is listening on.
+func ParseHandshake(line string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 3 || parts[0] != HandshakePrefix || len(parts[2]) == 0 {
+		return "", ErrBadHandshake
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", ErrBadHandshake
+	}
+	if version != ProtocolVersion {
+		return "", fmt.Errorf("plugin uses protocol version %d, Herald uses %d", version, ProtocolVersion)
+	}
+	return parts[2], nil
+}
+
+// Serve will serve a plugin on a local port and write the
+// handshake for Herald. It blocks until Herald stops the
+// plugin.
+func Serve(plugin PluginServer) error {
+	if os.Getenv(EnvProtocol) != strconv.Itoa(ProtocolVersion) {
+		return ErrNotLaunched
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer()
+	RegisterPluginServer(server, plugin)
+
+	// stop gracefully when Herald stops the plugin
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		if _, ok := <-signals; ok {
+			server.GracefulStop()
+		}
+	}()
+	fmt.Println(Handshake(lis.Addr().String()))
+	return server.Serve(lis)
+}
+
+// Report will send a report to the Herald callback server,
+// using the address and token that Herald started the
+// plugin with.
+func Report(ctx context.Context, request *callbacks.ReportRequest) (*callbacks.ReportResponse, error) {
+	address := os.Getenv(EnvCallbackAddress)
+	if len(address) == 0 {
+		return nil, fmt.Errorf("no callback address set for the plugin")
+	}
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+os.Getenv(EnvCallbackToken))
+	return callbacks.NewCallbacksClient(conn).Report(ctx, request)
+}
@@ -0,0 +1,30 @@
+package plugins
+
+import (
+	"os"
+	"testing"
+)
+
+// TestHandshake checks the handshake is written and parsed
+func TestHandshake(t *testing.T) {
+	address, err := ParseHandshake(Handshake("127.0.0.1:1234") + "\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != "127.0.0.1:1234" {
+		t.Fatalf("wrong address from handshake: %v", address)
+	}
+	for _, line := range []string{"", "hello", "HERALD_PLUGIN|1|", "HERALD_PLUGIN|one|127.0.0.1:1234", "HERALD_PLUGIN|99|127.0.0.1:1234"} {
+		if _, err := ParseHandshake(line); err == nil {
+			t.Fatalf("bad handshake was accepted: %v", line)
+		}
+	}
+}
+
+// TestServe checks plugins can only be served when started by Herald
+func TestServe(t *testing.T) {
+	os.Unsetenv(EnvProtocol)
+	if err := Serve(&UnimplementedPluginServer{}); err != ErrNotLaunched {
+		t.Fatal("plugin was served without being started by Herald")
+	}
+}
@@ -0,0 +1,131 @@
+package records
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/will-rowe/herald/src/helpers"
+)
+
+// BarcodeKit describes a Nanopore barcoding
+// kit and the barcodes it contains.
+type BarcodeKit struct {
+	Name     string  // the kit name (e.g. EXP-NBD104)
+	Barcodes []int32 // the valid barcode IDs for the kit
+}
+
+// BarcodeKits are the barcoding kits that
+// Herald recognises, keyed by kit name.
+var BarcodeKits = map[string]*BarcodeKit{
+	"EXP-NBD104":    newBarcodeKit("EXP-NBD104", 1, 12),
+	"EXP-NBD114":    newBarcodeKit("EXP-NBD114", 13, 24),
+	"EXP-NBD196":    newBarcodeKit("EXP-NBD196", 1, 96),
+	"EXP-PBC001":    newBarcodeKit("EXP-PBC001", 1, 12),
+	"EXP-PBC096":    newBarcodeKit("EXP-PBC096", 1, 96),
+	"SQK-RBK004":    newBarcodeKit("SQK-RBK004", 1, 12),
+	"SQK-RBK110.96": newBarcodeKit("SQK-RBK110.96", 1, 96),
+}
+
+// newBarcodeKit returns a barcode kit which
+// contains a contiguous range of barcodes.
+func newBarcodeKit(name string, first, last int32) *BarcodeKit {
+	kit := &BarcodeKit{
+		Name:     name,
+		Barcodes: make([]int32, 0, last-first+1),
+	}
+	for barcode := first; barcode <= last; barcode++ {
+		kit.Barcodes = append(kit.Barcodes, barcode)
+	}
+	return kit
+}
+
+// GetBarcodeKitNames returns the names of the
+// recognised barcoding kits in sorted order.
+func GetBarcodeKitNames() []string {
+	names := make([]string, 0, len(BarcodeKits))
+	for name := range BarcodeKits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetBarcodeKit returns the barcoding kit for the
+// provided name, or an error if it is not recognised.
+func GetBarcodeKit(name string) (*BarcodeKit, error) {
+	kit, ok := BarcodeKits[name]
+	if !ok {
+		return nil, fmt.Errorf("unrecognised barcode kit: %v", name)
+	}
+	return kit, nil
+}
+
+// CheckBarcode returns an error if the barcode
+// is not part of the barcoding kit.
+func (kit *BarcodeKit) CheckBarcode(barcode int32) error {
+	for _, validBarcode := range kit.Barcodes {
+		if barcode == validBarcode {
+			return nil
+		}
+	}
+	return fmt.Errorf("barcode %d is not in the %v kit (%d-%d)", barcode, kit.Name, kit.Barcodes[0], kit.Barcodes[len(kit.Barcodes)-1])
+}
+
+// GetBarcodeDirName returns the name of the
+// directory that the basecaller writes the
+// demultiplexed reads of a barcode to.
+func GetBarcodeDirName(barcode int32) string {
+	return fmt.Sprintf("barcode%02d", barcode)
+}
+
+// GetBarcodes returns the barcodes that can be
+// used for samples in the Run. An unbarcoded
+// Run only offers barcode 0.
+func (r *Run) GetBarcodes() ([]int32, error) {
+	if len(r.GetBarcodeKit()) == 0 {
+		return []int32{0}, nil
+	}
+	kit, err := GetBarcodeKit(r.GetBarcodeKit())
+	if err != nil {
+		return nil, err
+	}
+	return kit.Barcodes, nil
+}
+
+// CheckBarcode returns an error if the barcode
+// can't be used for a sample in the Run.
+func (r *Run) CheckBarcode(barcode int32) error {
+	if barcode < 0 {
+		return fmt.Errorf("barcode can't be negative: %d", barcode)
+	}
+	if len(r.GetBarcodeKit()) == 0 {
+		if barcode != 0 {
+			return fmt.Errorf("run %v is unbarcoded, can't use barcode %d", r.GetMetadata().GetLabel(), barcode)
+		}
+		return nil
+	}
+	kit, err := GetBarcodeKit(r.GetBarcodeKit())
+	if err != nil {
+		return err
+	}
+	return kit.CheckBarcode(barcode)
+}
+
+// GetBarcodeDirectory returns the directory in the
+// Run FASTQ directory that holds the reads for a
+// barcode (e.g. fastq_pass/barcode01). An
+// unbarcoded Run returns the FASTQ directory.
+func (r *Run) GetBarcodeDirectory(barcode int32) (string, error) {
+	if len(r.GetFastqOutputDirectory()) == 0 {
+		return "", fmt.Errorf("no FASTQ directory found for run")
+	}
+	barcodeDir := r.GetFastqOutputDirectory()
+	if len(r.GetBarcodeKit()) != 0 {
+		barcodeDir = filepath.Join(barcodeDir, GetBarcodeDirName(barcode))
+	}
+	if err := helpers.CheckDirExists(barcodeDir); err != nil {
+		return "", err
+	}
+	return barcodeDir, nil
+}
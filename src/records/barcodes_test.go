@@ -0,0 +1,56 @@
+package records
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestBarcodeKits tests the barcode checks for runs
+func TestBarcodeKits(t *testing.T) {
+
+	// check an unrecognised kit
+	if _, err := GetBarcodeKit("bogus"); err == nil {
+		t.Fatal("GetBarcodeKit did not return error for unrecognised kit")
+	}
+
+	// check the barcodes for a barcoded run
+	run := InitRun("testRun", "", "", "", "", 0, "EXP-NBD104")
+	for _, barcode := range []int32{-1, 0, 13} {
+		if err := run.CheckBarcode(barcode); err == nil {
+			t.Fatalf("CheckBarcode accepted barcode outside of the kit range: %d", barcode)
+		}
+	}
+	if err := run.CheckBarcode(12); err != nil {
+		t.Fatal(err)
+	}
+
+	// check the barcodes for an unbarcoded run
+	run = InitRun("testRun", "", "", "", "", 0, "")
+	if err := run.CheckBarcode(1); err == nil {
+		t.Fatal("CheckBarcode accepted a barcode for an unbarcoded run")
+	}
+	if err := run.CheckBarcode(0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBarcodeDirectory tests the lookup of barcode directories
+func TestBarcodeDirectory(t *testing.T) {
+	fastqDir := filepath.Join("tmp", "fastq_pass")
+	if err := os.MkdirAll(filepath.Join(fastqDir, "barcode01"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("tmp")
+	run := InitRun("testRun", "tmp", "", fastqDir, "", 0, "EXP-NBD104")
+	barcodeDir, err := run.GetBarcodeDirectory(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if barcodeDir != filepath.Join(fastqDir, "barcode01") {
+		t.Fatalf("incorrect barcode directory: %v", barcodeDir)
+	}
+	if _, err := run.GetBarcodeDirectory(2); err == nil {
+		t.Fatal("GetBarcodeDirectory returned a directory that does not exist")
+	}
+}
@@ -0,0 +1,148 @@
+package records
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/will-rowe/herald/src/helpers"
+)
+
+// DefaultFastqExtensions is used to find the files in directories
+var DefaultFastqExtensions []string = []string{"*.fastq", "*.fq", "*.fastq.gz", "*.fq.gz"}
+
+// FastqSummary describes a set of FASTQ files.
+type FastqSummary struct {
+	NumFiles int   // the number of FASTQ files
+	NumBytes int64 // the combined size of the FASTQ files on disk
+	NumReads int   // the number of reads across the FASTQ files
+}
+
+// GetFastqFiles will return a list of all
+// fastq files found in the Run FASTQ
+// directory. For a barcoded Run, only the
+// barcode subdirectories of its kit are
+// searched, so unclassified reads are left out.
+func (r *Run) GetFastqFiles() ([]string, error) {
+	if len(r.GetFastqOutputDirectory()) == 0 {
+		return nil, errors.New("no FASTQ directory found for run")
+	}
+	if err := helpers.CheckDirExists(r.GetFastqOutputDirectory()); err != nil {
+		return nil, err
+	}
+
+	// an unbarcoded run has all its reads in the FASTQ directory
+	if len(r.GetBarcodeKit()) == 0 {
+		return helpers.GlobFiles(r.GetFastqOutputDirectory(), DefaultFastqExtensions)
+	}
+	barcodes, err := r.GetBarcodes()
+	if err != nil {
+		return nil, err
+	}
+	fastqFiles := []string{}
+	for _, barcode := range barcodes {
+		barcodeDir := filepath.Join(r.GetFastqOutputDirectory(), GetBarcodeDirName(barcode))
+		if helpers.CheckDirExists(barcodeDir) != nil {
+			continue
+		}
+		barcodeFiles, err := helpers.WalkFiles(barcodeDir, DefaultFastqExtensions)
+		if err != nil {
+			return nil, err
+		}
+		fastqFiles = append(fastqFiles, barcodeFiles...)
+	}
+	return fastqFiles, nil
+}
+
+// GetFastqFiles will return a list of all
+// fastq files for the Sample, found in the
+// barcode directory of the provided Run.
+func (s *Sample) GetFastqFiles(run *Run) ([]string, error) {
+	if s.GetParentRun() != run.GetMetadata().GetLabel() {
+		return nil, fmt.Errorf("sample %v does not belong to run %v", s.GetMetadata().GetLabel(), run.GetMetadata().GetLabel())
+	}
+	barcodeDir, err := run.GetBarcodeDirectory(s.GetBarcode())
+	if err != nil {
+		return nil, err
+	}
+
+	// an unbarcoded run has all its reads in the FASTQ directory
+	if len(run.GetBarcodeKit()) == 0 {
+		return helpers.GlobFiles(barcodeDir, DefaultFastqExtensions)
+	}
+	return helpers.WalkFiles(barcodeDir, DefaultFastqExtensions)
+}
+
+// GetFastqSummary will return a summary of
+// the provided FASTQ files. Gzipped files
+// are decompressed to count the reads.
+func GetFastqSummary(fastqFiles []string) (*FastqSummary, error) {
+	summary := &FastqSummary{}
+	for _, fastqFile := range fastqFiles {
+		info, err := os.Stat(fastqFile)
+		if err != nil {
+			return nil, err
+		}
+		numReads, err := countReads(fastqFile)
+		if err != nil {
+			return nil, err
+		}
+		summary.NumFiles++
+		summary.NumBytes += info.Size()
+		summary.NumReads += numReads
+	}
+	return summary, nil
+}
+
+// String returns a one line description of the summary.
+func (summary *FastqSummary) String() string {
+	return fmt.Sprintf("%d FASTQ files (%d bytes) containing %d reads", summary.NumFiles, summary.NumBytes, summary.NumReads)
+}
+
+// countReads will count the reads in a FASTQ
+// file, assuming four lines per read.
+func countReads(fastqFile string) (int, error) {
+	fh, err := os.Open(fastqFile)
+	if err != nil {
+		return 0, err
+	}
+	defer fh.Close()
+	var reader io.Reader = fh
+	if strings.HasSuffix(fastqFile, ".gz") {
+		gz, err := gzip.NewReader(fh)
+		if err != nil {
+			return 0, err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	// count the newlines
+	numLines := 0
+	lastByte := byte('\n')
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			numLines += bytes.Count(buf[:n], []byte{'\n'})
+			lastByte = buf[n-1]
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// account for a missing final newline
+	if lastByte != '\n' {
+		numLines++
+	}
+	return numLines / 4, nil
+}
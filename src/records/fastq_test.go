@@ -0,0 +1,88 @@
+package records
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testRead = []byte("@read\nACGT\n+\n!!!!\n")
+
+// TestSampleFastqFiles tests the FASTQ discovery and summary for samples
+func TestSampleFastqFiles(t *testing.T) {
+
+	// set up a demultiplexed run directory
+	fastqDir := filepath.Join("tmp", "fastq_pass")
+	for _, barcode := range []int32{1, 2} {
+		if err := os.MkdirAll(filepath.Join(fastqDir, GetBarcodeDirName(barcode)), 0777); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer os.RemoveAll("tmp")
+	if err := ioutil.WriteFile(filepath.Join(fastqDir, "barcode01", "reads.fastq"), testRead, 0644); err != nil {
+		t.Fatal(err)
+	}
+	fh, err := os.Create(filepath.Join(fastqDir, "barcode01", "reads.fastq.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gz := gzip.NewWriter(fh)
+	gz.Write(append(testRead, testRead...))
+	gz.Close()
+	fh.Close()
+	if err := ioutil.WriteFile(filepath.Join(fastqDir, "barcode02", "reads.fq"), testRead, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(fastqDir, "unclassified"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(fastqDir, "unclassified", "reads.fastq"), testRead, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// check the run finds all the barcoded files, but not the unclassified reads
+	run := InitRun("testRun", "tmp", "", fastqDir, "", 0, "EXP-NBD104")
+	runFiles, err := run.GetFastqFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runFiles) != 3 {
+		t.Fatalf("run FASTQ discovery found %d files, expected 3", len(runFiles))
+	}
+
+	// check the sample only finds the files for its barcode
+	sample := InitSample("testSample", "testRun", 1)
+	sampleFiles, err := sample.GetFastqFiles(run)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sampleFiles) != 2 {
+		t.Fatalf("sample FASTQ discovery found %d files, expected 2", len(sampleFiles))
+	}
+	summary, err := GetFastqSummary(sampleFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.NumReads != 3 {
+		t.Fatalf("summary counted %d reads, expected 3", summary.NumReads)
+	}
+
+	// check an unbarcoded run only uses the files in the FASTQ directory
+	if err := ioutil.WriteFile(filepath.Join(fastqDir, "reads.fastq"), testRead, 0644); err != nil {
+		t.Fatal(err)
+	}
+	runFiles, err = InitRun("unbarcodedRun", "tmp", "", fastqDir, "", 0, "").GetFastqFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runFiles) != 1 {
+		t.Fatalf("unbarcoded run FASTQ discovery found %d files, expected 1", len(runFiles))
+	}
+
+	// check a sample can't use another run
+	if _, err := InitSample("testSample", "otherRun", 1).GetFastqFiles(run); err == nil {
+		t.Fatal("GetFastqFiles did not return error for a sample from a different run")
+	}
+}
@@ -26,6 +26,59 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+//
+//CommentKind is used to differentiate
+//between Comments logged by Herald,
+//added by users and reported by services.
+type CommentKind int32
+
+const (
+	CommentKind_system  CommentKind = 0 // comment logged by Herald
+	CommentKind_user    CommentKind = 1 // comment added by a user
+	CommentKind_service CommentKind = 2 // comment reported by, or on behalf of, a service
+)
+
+// Enum value maps for CommentKind.
+var (
+	CommentKind_name = map[int32]string{
+		0: "system",
+		1: "user",
+		2: "service",
+	}
+	CommentKind_value = map[string]int32{
+		"system":  0,
+		"user":    1,
+		"service": 2,
+	}
+)
+
+func (x CommentKind) Enum() *CommentKind {
+	p := new(CommentKind)
+	*p = x
+	return p
+}
+
+func (x CommentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_herald_records_proto_enumTypes[0].Descriptor()
+}
+
+func (CommentKind) Type() protoreflect.EnumType {
+	return &file_herald_records_proto_enumTypes[0]
+}
+
+func (x CommentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentKind.Descriptor instead.
+func (CommentKind) EnumDescriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{0}
+}
+
 //
 //Status is used to determine if runs/samples have
 //tagged service requests and if they have been
@@ -38,6 +91,8 @@ const (
 	Status_tagsIncomplete Status = 2 // data is tagged with service requests, one or more of which are marked incomplete
 	Status_tagsComplete   Status = 3 // data is tagged with service requests, all of which are marked complete
 	Status_announced      Status = 4 // tagged service requests have been announced and we are waiting for completion notification
+	Status_announceFailed Status = 5 // tagged service requests could not be announced after repeated attempts
+	Status_serviceFailed  Status = 6 // a tagged service has reported that its job failed
 )
 
 // Enum value maps for Status.
@@ -48,6 +103,8 @@ var (
 		2: "tagsIncomplete",
 		3: "tagsComplete",
 		4: "announced",
+		5: "announceFailed",
+		6: "serviceFailed",
 	}
 	Status_value = map[string]int32{
 		"UN_INITIALIZED": 0,
@@ -55,6 +112,8 @@ var (
 		"tagsIncomplete": 2,
 		"tagsComplete":   3,
 		"announced":      4,
+		"announceFailed": 5,
+		"serviceFailed":  6,
 	}
 )
 
@@ -69,11 +128,11 @@ func (x Status) String() string {
 }
 
 func (Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_herald_records_proto_enumTypes[0].Descriptor()
+	return file_herald_records_proto_enumTypes[1].Descriptor()
 }
 
 func (Status) Type() protoreflect.EnumType {
-	return &file_herald_records_proto_enumTypes[0]
+	return &file_herald_records_proto_enumTypes[1]
 }
 
 func (x Status) Number() protoreflect.EnumNumber {
@@ -82,7 +141,7 @@ func (x Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Status.Descriptor instead.
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return file_herald_records_proto_rawDescGZIP(), []int{0}
+	return file_herald_records_proto_rawDescGZIP(), []int{1}
 }
 
 //
@@ -118,11 +177,11 @@ func (x RecordType) String() string {
 }
 
 func (RecordType) Descriptor() protoreflect.EnumDescriptor {
-	return file_herald_records_proto_enumTypes[1].Descriptor()
+	return file_herald_records_proto_enumTypes[2].Descriptor()
 }
 
 func (RecordType) Type() protoreflect.EnumType {
-	return &file_herald_records_proto_enumTypes[1]
+	return &file_herald_records_proto_enumTypes[2]
 }
 
 func (x RecordType) Number() protoreflect.EnumNumber {
@@ -131,7 +190,7 @@ func (x RecordType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RecordType.Descriptor instead.
 func (RecordType) EnumDescriptor() ([]byte, []int) {
-	return file_herald_records_proto_rawDescGZIP(), []int{1}
+	return file_herald_records_proto_rawDescGZIP(), []int{2}
 }
 
 //
@@ -143,8 +202,12 @@ type Comment struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Text      string               `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Timestamp   *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Text        string               `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Author      string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`                       // the name of the user who added the comment (user comments only)
+	Kind        CommentKind          `protobuf:"varint,4,opt,name=kind,proto3,enum=records.CommentKind" json:"kind,omitempty"` // who or what the comment came from
+	Service     string               `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`                     // the name of the service the comment relates to (optional)
+	Attachments []string             `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"`             // file paths of any attachments for the comment (optional)
 }
 
 func (x *Comment) Reset() {
@@ -193,24 +256,49 @@ func (x *Comment) GetText() string {
 	return ""
 }
 
+func (x *Comment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Comment) GetKind() CommentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CommentKind_system
+}
+
+func (x *Comment) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *Comment) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
 //
-//HeraldData is the base data type.
-//It is used by both Run and Sample.
-type HeraldData struct {
+//ManifestFile describes a file that a
+//service has written for a record.
+type ManifestFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created      *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
-	Label        string               `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`                                                                                        // the run or sample name
-	History      []*Comment           `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`                                                                                    // describes the history of the run
-	Status       Status               `protobuf:"varint,5,opt,name=status,proto3,enum=records.Status" json:"status,omitempty"`                                                                 // describes if untagged, tagged with complete/incomplete services and if announced
-	Tags         map[string]bool      `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // tagged services and their complete status (true=complete, false=incomplete)
-	RequestOrder []string             `protobuf:"bytes,7,rep,name=requestOrder,proto3" json:"requestOrder,omitempty"`                                                                          // the order to send requests to the tagged services
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`         // the path of the file, relative to the manifest location
+	Size     int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`        // the size of the file in bytes
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"` // the hex encoded SHA-256 checksum of the file
 }
 
-func (x *HeraldData) Reset() {
-	*x = HeraldData{}
+func (x *ManifestFile) Reset() {
+	*x = ManifestFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_herald_records_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,13 +306,13 @@ func (x *HeraldData) Reset() {
 	}
 }
 
-func (x *HeraldData) String() string {
+func (x *ManifestFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeraldData) ProtoMessage() {}
+func (*ManifestFile) ProtoMessage() {}
 
-func (x *HeraldData) ProtoReflect() protoreflect.Message {
+func (x *ManifestFile) ProtoReflect() protoreflect.Message {
 	mi := &file_herald_records_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -236,85 +324,137 @@ func (x *HeraldData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeraldData.ProtoReflect.Descriptor instead.
-func (*HeraldData) Descriptor() ([]byte, []int) {
+// Deprecated: Use ManifestFile.ProtoReflect.Descriptor instead.
+func (*ManifestFile) Descriptor() ([]byte, []int) {
 	return file_herald_records_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *HeraldData) GetCreated() *timestamp.Timestamp {
+func (x *ManifestFile) GetPath() string {
 	if x != nil {
-		return x.Created
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *HeraldData) GetLabel() string {
+func (x *ManifestFile) GetSize() int64 {
 	if x != nil {
-		return x.Label
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *HeraldData) GetHistory() []*Comment {
+func (x *ManifestFile) GetChecksum() string {
 	if x != nil {
-		return x.History
+		return x.Checksum
 	}
-	return nil
+	return ""
 }
 
-func (x *HeraldData) GetStatus() Status {
-	if x != nil {
-		return x.Status
+//
+//Manifest lists the files that a
+//service has written for a record.
+type Manifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created  *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
+	Location string               `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"` // where the files were written
+	Files    []*ManifestFile      `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`       // the files, with their checksums
+}
+
+func (x *Manifest) Reset() {
+	*x = Manifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_herald_records_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return Status_UN_INITIALIZED
 }
 
-func (x *HeraldData) GetTags() map[string]bool {
+func (x *Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manifest) ProtoMessage() {}
+
+func (x *Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_herald_records_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Manifest) GetCreated() *timestamp.Timestamp {
 	if x != nil {
-		return x.Tags
+		return x.Created
 	}
 	return nil
 }
 
-func (x *HeraldData) GetRequestOrder() []string {
+func (x *Manifest) GetLocation() string {
 	if x != nil {
-		return x.RequestOrder
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Manifest) GetFiles() []*ManifestFile {
+	if x != nil {
+		return x.Files
 	}
 	return nil
 }
 
 //
-//Run is used to describe a Nanopore
-//sequencing run.
-type Run struct {
+//AcquisitionSnapshot records the progress
+//of a MinKNOW acquisition (the data being
+//collected for a run) at a point in time.
+type AcquisitionSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata             *HeraldData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	OutputDirectory      string      `protobuf:"bytes,2,opt,name=outputDirectory,proto3" json:"outputDirectory,omitempty"`           // where the run is stored
-	Fast5OutputDirectory string      `protobuf:"bytes,3,opt,name=fast5OutputDirectory,proto3" json:"fast5OutputDirectory,omitempty"` // where the run fast5 data is stored
-	FastqOutputDirectory string      `protobuf:"bytes,4,opt,name=fastqOutputDirectory,proto3" json:"fastqOutputDirectory,omitempty"` // where the run fastq data is stored
-	PrimerScheme         string      `protobuf:"bytes,5,opt,name=primerScheme,proto3" json:"primerScheme,omitempty"`                 // the ARTIC primer scheme name for this run
+	Time             *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                           // when the snapshot was taken
+	AcquisitionRunID string               `protobuf:"bytes,2,opt,name=acquisitionRunID,proto3" json:"acquisitionRunID,omitempty"`   // the MinKNOW acquisition run ID
+	State            string               `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                         // the acquisition state (starting, running, finishing or completed)
+	StartTime        *timestamp.Timestamp `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`                 // when the acquisition started
+	StopReason       string               `protobuf:"bytes,5,opt,name=stopReason,proto3" json:"stopReason,omitempty"`               // why the acquisition stopped (empty until it has)
+	ReadCount        int64                `protobuf:"varint,6,opt,name=readCount,proto3" json:"readCount,omitempty"`                // the number of reads selected by MinKNOW
+	PassReadCount    int64                `protobuf:"varint,7,opt,name=passReadCount,proto3" json:"passReadCount,omitempty"`        // the number of reads that passed basecalling
+	FailReadCount    int64                `protobuf:"varint,8,opt,name=failReadCount,proto3" json:"failReadCount,omitempty"`        // the number of reads that failed basecalling
+	Bases            int64                `protobuf:"varint,9,opt,name=bases,proto3" json:"bases,omitempty"`                        // the number of bases called
+	SamplesAcquired  uint64               `protobuf:"varint,10,opt,name=samplesAcquired,proto3" json:"samplesAcquired,omitempty"`   // the raw samples (per channel) acquired from the device
+	SamplesProcessed uint64               `protobuf:"varint,11,opt,name=samplesProcessed,proto3" json:"samplesProcessed,omitempty"` // the raw samples (per channel) processed by the analysis pipeline
 }
 
-func (x *Run) Reset() {
-	*x = Run{}
+func (x *AcquisitionSnapshot) Reset() {
+	*x = AcquisitionSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_herald_records_proto_msgTypes[2]
+		mi := &file_herald_records_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Run) String() string {
+func (x *AcquisitionSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Run) ProtoMessage() {}
+func (*AcquisitionSnapshot) ProtoMessage() {}
 
-func (x *Run) ProtoReflect() protoreflect.Message {
-	mi := &file_herald_records_proto_msgTypes[2]
+func (x *AcquisitionSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_herald_records_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,77 +465,130 @@ func (x *Run) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Run.ProtoReflect.Descriptor instead.
-func (*Run) Descriptor() ([]byte, []int) {
-	return file_herald_records_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use AcquisitionSnapshot.ProtoReflect.Descriptor instead.
+func (*AcquisitionSnapshot) Descriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Run) GetMetadata() *HeraldData {
+func (x *AcquisitionSnapshot) GetTime() *timestamp.Timestamp {
 	if x != nil {
-		return x.Metadata
+		return x.Time
 	}
 	return nil
 }
 
-func (x *Run) GetOutputDirectory() string {
+func (x *AcquisitionSnapshot) GetAcquisitionRunID() string {
 	if x != nil {
-		return x.OutputDirectory
+		return x.AcquisitionRunID
 	}
 	return ""
 }
 
-func (x *Run) GetFast5OutputDirectory() string {
+func (x *AcquisitionSnapshot) GetState() string {
 	if x != nil {
-		return x.Fast5OutputDirectory
+		return x.State
 	}
 	return ""
 }
 
-func (x *Run) GetFastqOutputDirectory() string {
+func (x *AcquisitionSnapshot) GetStartTime() *timestamp.Timestamp {
 	if x != nil {
-		return x.FastqOutputDirectory
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *Run) GetPrimerScheme() string {
+func (x *AcquisitionSnapshot) GetStopReason() string {
 	if x != nil {
-		return x.PrimerScheme
+		return x.StopReason
 	}
 	return ""
 }
 
+func (x *AcquisitionSnapshot) GetReadCount() int64 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *AcquisitionSnapshot) GetPassReadCount() int64 {
+	if x != nil {
+		return x.PassReadCount
+	}
+	return 0
+}
+
+func (x *AcquisitionSnapshot) GetFailReadCount() int64 {
+	if x != nil {
+		return x.FailReadCount
+	}
+	return 0
+}
+
+func (x *AcquisitionSnapshot) GetBases() int64 {
+	if x != nil {
+		return x.Bases
+	}
+	return 0
+}
+
+func (x *AcquisitionSnapshot) GetSamplesAcquired() uint64 {
+	if x != nil {
+		return x.SamplesAcquired
+	}
+	return 0
+}
+
+func (x *AcquisitionSnapshot) GetSamplesProcessed() uint64 {
+	if x != nil {
+		return x.SamplesProcessed
+	}
+	return 0
+}
+
 //
-//Sample is used to describe a biological
-//sample which is being sequenced as part
-//of a Run.
-type Sample struct {
+//MinknowProtocolRun describes a MinKNOW
+//protocol run (the experiment started in
+//MinKNOW) that a run was sequenced by.
+type MinknowProtocolRun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata  *HeraldData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ParentRun string      `protobuf:"bytes,2,opt,name=parentRun,proto3" json:"parentRun,omitempty"` // the label of the parent run, used to perform lookups
-	Barcode   int32       `protobuf:"varint,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	ProtocolRunID       string               `protobuf:"bytes,1,opt,name=protocolRunID,proto3" json:"protocolRunID,omitempty"`             // the MinKNOW protocol run ID
+	ProtocolID          string               `protobuf:"bytes,2,opt,name=protocolID,proto3" json:"protocolID,omitempty"`                   // the protocol that was run
+	Position            string               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`                       // the flow cell position the protocol ran on
+	OutputPath          string               `protobuf:"bytes,4,opt,name=outputPath,proto3" json:"outputPath,omitempty"`                   // where MinKNOW wrote the data
+	State               string               `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`                             // the protocol state (e.g. running, completed or stopped by user)
+	StartTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`                     // when the protocol started
+	EndTime             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=endTime,proto3" json:"endTime,omitempty"`                         // when the protocol finished (unset while it is running)
+	FlowCellID          string               `protobuf:"bytes,8,opt,name=flowCellID,proto3" json:"flowCellID,omitempty"`                   // the flow cell ID
+	FlowCellProductCode string               `protobuf:"bytes,9,opt,name=flowCellProductCode,proto3" json:"flowCellProductCode,omitempty"` // the flow cell product code (e.g. FLO-MIN106)
+	DeviceID            string               `protobuf:"bytes,10,opt,name=deviceID,proto3" json:"deviceID,omitempty"`                      // the sequencing device ID
+	DeviceType          string               `protobuf:"bytes,11,opt,name=deviceType,proto3" json:"deviceType,omitempty"`                  // the sequencing device type (e.g. gridion)
+	SampleID            string               `protobuf:"bytes,12,opt,name=sampleID,proto3" json:"sampleID,omitempty"`                      // the sample ID given in MinKNOW
+	ProtocolGroupID     string               `protobuf:"bytes,13,opt,name=protocolGroupID,proto3" json:"protocolGroupID,omitempty"`        // the protocol group ID (experiment name) given in MinKNOW
+	AcquisitionRunIDs   []string             `protobuf:"bytes,14,rep,name=acquisitionRunIDs,proto3" json:"acquisitionRunIDs,omitempty"`    // the acquisitions started by the protocol
 }
 
-func (x *Sample) Reset() {
-	*x = Sample{}
+func (x *MinknowProtocolRun) Reset() {
+	*x = MinknowProtocolRun{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_herald_records_proto_msgTypes[3]
+		mi := &file_herald_records_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sample) String() string {
+func (x *MinknowProtocolRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sample) ProtoMessage() {}
+func (*MinknowProtocolRun) ProtoMessage() {}
 
-func (x *Sample) ProtoReflect() protoreflect.Message {
-	mi := &file_herald_records_proto_msgTypes[3]
+func (x *MinknowProtocolRun) ProtoReflect() protoreflect.Message {
+	mi := &file_herald_records_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,149 +599,875 @@ func (x *Sample) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sample.ProtoReflect.Descriptor instead.
-func (*Sample) Descriptor() ([]byte, []int) {
-	return file_herald_records_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MinknowProtocolRun.ProtoReflect.Descriptor instead.
+func (*MinknowProtocolRun) Descriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Sample) GetMetadata() *HeraldData {
+func (x *MinknowProtocolRun) GetProtocolRunID() string {
 	if x != nil {
-		return x.Metadata
+		return x.ProtocolRunID
 	}
-	return nil
+	return ""
 }
 
-func (x *Sample) GetParentRun() string {
+func (x *MinknowProtocolRun) GetProtocolID() string {
 	if x != nil {
-		return x.ParentRun
+		return x.ProtocolID
 	}
 	return ""
 }
 
-func (x *Sample) GetBarcode() int32 {
+func (x *MinknowProtocolRun) GetPosition() string {
 	if x != nil {
-		return x.Barcode
+		return x.Position
 	}
-	return 0
+	return ""
 }
 
-var File_herald_records_proto protoreflect.FileDescriptor
+func (x *MinknowProtocolRun) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
 
-var file_herald_records_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x57, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xbd, 0x02, 0x0a, 0x0a, 0x48, 0x65,
-	0x72, 0x61, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x2e, 0x48, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x67,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xec, 0x01, 0x0a, 0x03, 0x52, 0x75,
-	0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x48, 0x65,
-	0x72, 0x61, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x14,
-	0x66, 0x61, 0x73, 0x74, 0x35, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x61, 0x73, 0x74,
-	0x35, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x32, 0x0a, 0x14, 0x66, 0x61, 0x73, 0x74, 0x71, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
-	0x66, 0x61, 0x73, 0x74, 0x71, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d,
-	0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x48,
-	0x65, 0x72, 0x61, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0x5f, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x5f, 0x49, 0x4e, 0x49, 0x54,
-	0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x75, 0x6e, 0x74,
-	0x61, 0x67, 0x67, 0x65, 0x64, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x74, 0x61, 0x67, 0x73, 0x49,
-	0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x74,
-	0x61, 0x67, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x03, 0x12, 0x0d, 0x0a,
-	0x09, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x10, 0x04, 0x2a, 0x21, 0x0a, 0x0a,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x72, 0x75,
-	0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x10, 0x01, 0x42,
-	0x13, 0x5a, 0x11, 0x2e, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x3b, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *MinknowProtocolRun) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-var (
-	file_herald_records_proto_rawDescOnce sync.Once
-	file_herald_records_proto_rawDescData = file_herald_records_proto_rawDesc
-)
+func (x *MinknowProtocolRun) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
 
-func file_herald_records_proto_rawDescGZIP() []byte {
-	file_herald_records_proto_rawDescOnce.Do(func() {
-		file_herald_records_proto_rawDescData = protoimpl.X.CompressGZIP(file_herald_records_proto_rawDescData)
-	})
-	return file_herald_records_proto_rawDescData
+func (x *MinknowProtocolRun) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-var file_herald_records_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_herald_records_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_herald_records_proto_goTypes = []interface{}{
-	(Status)(0),                 // 0: records.Status
-	(RecordType)(0),             // 1: records.RecordType
-	(*Comment)(nil),             // 2: records.Comment
-	(*HeraldData)(nil),          // 3: records.HeraldData
-	(*Run)(nil),                 // 4: records.Run
-	(*Sample)(nil),              // 5: records.Sample
-	nil,                         // 6: records.HeraldData.TagsEntry
-	(*timestamp.Timestamp)(nil), // 7: google.protobuf.Timestamp
+func (x *MinknowProtocolRun) GetFlowCellID() string {
+	if x != nil {
+		return x.FlowCellID
+	}
+	return ""
 }
-var file_herald_records_proto_depIdxs = []int32{
-	7, // 0: records.Comment.timestamp:type_name -> google.protobuf.Timestamp
-	7, // 1: records.HeraldData.created:type_name -> google.protobuf.Timestamp
-	2, // 2: records.HeraldData.history:type_name -> records.Comment
-	0, // 3: records.HeraldData.status:type_name -> records.Status
-	6, // 4: records.HeraldData.tags:type_name -> records.HeraldData.TagsEntry
-	3, // 5: records.Run.metadata:type_name -> records.HeraldData
-	3, // 6: records.Sample.metadata:type_name -> records.HeraldData
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+
+func (x *MinknowProtocolRun) GetFlowCellProductCode() string {
+	if x != nil {
+		return x.FlowCellProductCode
+	}
+	return ""
 }
 
-func init() { file_herald_records_proto_init() }
-func file_herald_records_proto_init() {
-	if File_herald_records_proto != nil {
-		return
+func (x *MinknowProtocolRun) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_herald_records_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
-			case 0:
-				return &v.state
-			case 1:
+	return ""
+}
+
+func (x *MinknowProtocolRun) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *MinknowProtocolRun) GetSampleID() string {
+	if x != nil {
+		return x.SampleID
+	}
+	return ""
+}
+
+func (x *MinknowProtocolRun) GetProtocolGroupID() string {
+	if x != nil {
+		return x.ProtocolGroupID
+	}
+	return ""
+}
+
+func (x *MinknowProtocolRun) GetAcquisitionRunIDs() []string {
+	if x != nil {
+		return x.AcquisitionRunIDs
+	}
+	return nil
+}
+
+//
+//HeraldData is the base data type.
+//It is used by both Run and Sample.
+type HeraldData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created      *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
+	Label        string               `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`                                                                                                  // the run or sample name
+	History      []*Comment           `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`                                                                                              // describes the history of the run
+	Status       Status               `protobuf:"varint,5,opt,name=status,proto3,enum=records.Status" json:"status,omitempty"`                                                                           // describes if untagged, tagged with complete/incomplete services and if announced
+	Tags         map[string]bool      `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`           // tagged services and their complete status (true=complete, false=incomplete)
+	RequestOrder []string             `protobuf:"bytes,7,rep,name=requestOrder,proto3" json:"requestOrder,omitempty"`                                                                                    // the order to send requests to the tagged services
+	Results      map[string]string    `protobuf:"bytes,8,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`      // the results reported by tagged services, keyed by service name
+	JobIDs       map[string]string    `protobuf:"bytes,9,rep,name=jobIDs,proto3" json:"jobIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`        // the job identifiers returned by tagged services, keyed by service name
+	Manifests    map[string]*Manifest `protobuf:"bytes,10,rep,name=manifests,proto3" json:"manifests,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the files written by tagged services, keyed by service name
+}
+
+func (x *HeraldData) Reset() {
+	*x = HeraldData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_herald_records_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeraldData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeraldData) ProtoMessage() {}
+
+func (x *HeraldData) ProtoReflect() protoreflect.Message {
+	mi := &file_herald_records_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeraldData.ProtoReflect.Descriptor instead.
+func (*HeraldData) Descriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HeraldData) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *HeraldData) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *HeraldData) GetHistory() []*Comment {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *HeraldData) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UN_INITIALIZED
+}
+
+func (x *HeraldData) GetTags() map[string]bool {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *HeraldData) GetRequestOrder() []string {
+	if x != nil {
+		return x.RequestOrder
+	}
+	return nil
+}
+
+func (x *HeraldData) GetResults() map[string]string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *HeraldData) GetJobIDs() map[string]string {
+	if x != nil {
+		return x.JobIDs
+	}
+	return nil
+}
+
+func (x *HeraldData) GetManifests() map[string]*Manifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+//
+//Run is used to describe a Nanopore
+//sequencing run.
+type Run struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata             *HeraldData            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	OutputDirectory      string                 `protobuf:"bytes,2,opt,name=outputDirectory,proto3" json:"outputDirectory,omitempty"`           // where the run is stored
+	Fast5OutputDirectory string                 `protobuf:"bytes,3,opt,name=fast5OutputDirectory,proto3" json:"fast5OutputDirectory,omitempty"` // where the run fast5 data is stored
+	FastqOutputDirectory string                 `protobuf:"bytes,4,opt,name=fastqOutputDirectory,proto3" json:"fastqOutputDirectory,omitempty"` // where the run fastq data is stored
+	PrimerScheme         string                 `protobuf:"bytes,5,opt,name=primerScheme,proto3" json:"primerScheme,omitempty"`                 // the ARTIC primer scheme name for this run
+	SchemeVersion        int32                  `protobuf:"varint,6,opt,name=schemeVersion,proto3" json:"schemeVersion,omitempty"`              // the ARTIC primer scheme version for this run
+	BarcodeKit           string                 `protobuf:"bytes,7,opt,name=barcodeKit,proto3" json:"barcodeKit,omitempty"`                     // the barcoding kit used for this run (empty if unbarcoded)
+	MinknowPosition      string                 `protobuf:"bytes,8,opt,name=minknowPosition,proto3" json:"minknowPosition,omitempty"`           // the MinKNOW flow cell position the run is sequenced on (empty if not set)
+	AcquisitionSnapshots []*AcquisitionSnapshot `protobuf:"bytes,9,rep,name=acquisitionSnapshots,proto3" json:"acquisitionSnapshots,omitempty"` // the progress of the MinKNOW acquisition for the run, oldest first
+	AcquisitionFinished  bool                   `protobuf:"varint,10,opt,name=acquisitionFinished,proto3" json:"acquisitionFinished,omitempty"` // set once the MinKNOW acquisition for the run has finished
+	MinknowProtocolRun   *MinknowProtocolRun    `protobuf:"bytes,11,opt,name=minknowProtocolRun,proto3" json:"minknowProtocolRun,omitempty"`    // the MinKNOW protocol run the run was imported from or matched to (unset if not known)
+	FlowCellType         string                 `protobuf:"bytes,12,opt,name=flowCellType,proto3" json:"flowCellType,omitempty"`                // the flow cell product code (e.g. FLO-MIN106), used to estimate the disk space needed
+	RunHours             float32                `protobuf:"fixed32,13,opt,name=runHours,proto3" json:"runHours,omitempty"`                      // how long the run is expected to sequence for (0 to use the default for the flow cell)
+}
+
+func (x *Run) Reset() {
+	*x = Run{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_herald_records_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Run) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Run) ProtoMessage() {}
+
+func (x *Run) ProtoReflect() protoreflect.Message {
+	mi := &file_herald_records_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Run.ProtoReflect.Descriptor instead.
+func (*Run) Descriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Run) GetMetadata() *HeraldData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Run) GetOutputDirectory() string {
+	if x != nil {
+		return x.OutputDirectory
+	}
+	return ""
+}
+
+func (x *Run) GetFast5OutputDirectory() string {
+	if x != nil {
+		return x.Fast5OutputDirectory
+	}
+	return ""
+}
+
+func (x *Run) GetFastqOutputDirectory() string {
+	if x != nil {
+		return x.FastqOutputDirectory
+	}
+	return ""
+}
+
+func (x *Run) GetPrimerScheme() string {
+	if x != nil {
+		return x.PrimerScheme
+	}
+	return ""
+}
+
+func (x *Run) GetSchemeVersion() int32 {
+	if x != nil {
+		return x.SchemeVersion
+	}
+	return 0
+}
+
+func (x *Run) GetBarcodeKit() string {
+	if x != nil {
+		return x.BarcodeKit
+	}
+	return ""
+}
+
+func (x *Run) GetMinknowPosition() string {
+	if x != nil {
+		return x.MinknowPosition
+	}
+	return ""
+}
+
+func (x *Run) GetAcquisitionSnapshots() []*AcquisitionSnapshot {
+	if x != nil {
+		return x.AcquisitionSnapshots
+	}
+	return nil
+}
+
+func (x *Run) GetAcquisitionFinished() bool {
+	if x != nil {
+		return x.AcquisitionFinished
+	}
+	return false
+}
+
+func (x *Run) GetMinknowProtocolRun() *MinknowProtocolRun {
+	if x != nil {
+		return x.MinknowProtocolRun
+	}
+	return nil
+}
+
+func (x *Run) GetFlowCellType() string {
+	if x != nil {
+		return x.FlowCellType
+	}
+	return ""
+}
+
+func (x *Run) GetRunHours() float32 {
+	if x != nil {
+		return x.RunHours
+	}
+	return 0
+}
+
+//
+//Sample is used to describe a biological
+//sample which is being sequenced as part
+//of a Run.
+type Sample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata        *HeraldData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ParentRun       string      `protobuf:"bytes,2,opt,name=parentRun,proto3" json:"parentRun,omitempty"`             // the label of the parent run, used to perform lookups
+	Barcode         int32       `protobuf:"varint,3,opt,name=barcode,proto3" json:"barcode,omitempty"`                // the barcode ID for this sample (0 if unbarcoded)
+	InputFastqFiles []string    `protobuf:"bytes,4,rep,name=inputFastqFiles,proto3" json:"inputFastqFiles,omitempty"` // the FASTQ files for this sample, resolved from the parent run prior to announcing
+	PrimerScheme    string      `protobuf:"bytes,5,opt,name=primerScheme,proto3" json:"primerScheme,omitempty"`       // the ARTIC primer scheme name, resolved from the parent run prior to announcing
+	SchemeVersion   int32       `protobuf:"varint,6,opt,name=schemeVersion,proto3" json:"schemeVersion,omitempty"`    // the ARTIC primer scheme version, resolved from the parent run prior to announcing
+}
+
+func (x *Sample) Reset() {
+	*x = Sample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_herald_records_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample) ProtoMessage() {}
+
+func (x *Sample) ProtoReflect() protoreflect.Message {
+	mi := &file_herald_records_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample.ProtoReflect.Descriptor instead.
+func (*Sample) Descriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Sample) GetMetadata() *HeraldData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Sample) GetParentRun() string {
+	if x != nil {
+		return x.ParentRun
+	}
+	return ""
+}
+
+func (x *Sample) GetBarcode() int32 {
+	if x != nil {
+		return x.Barcode
+	}
+	return 0
+}
+
+func (x *Sample) GetInputFastqFiles() []string {
+	if x != nil {
+		return x.InputFastqFiles
+	}
+	return nil
+}
+
+func (x *Sample) GetPrimerScheme() string {
+	if x != nil {
+		return x.PrimerScheme
+	}
+	return ""
+}
+
+func (x *Sample) GetSchemeVersion() int32 {
+	if x != nil {
+		return x.SchemeVersion
+	}
+	return 0
+}
+
+//
+//Announcement is an item in the announcement
+//queue. It is persisted so that records which
+//are partly announced can be resumed after a
+//restart.
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordType  RecordType           `protobuf:"varint,1,opt,name=recordType,proto3,enum=records.RecordType" json:"recordType,omitempty"` // the type of record being announced
+	Label       string               `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                                    // the label of the record being announced
+	Enqueued    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=enqueued,proto3" json:"enqueued,omitempty"`                              // when the record was added to the queue
+	Pending     []string             `protobuf:"bytes,4,rep,name=pending,proto3" json:"pending,omitempty"`                                // the tagged services that have not been sent a request yet
+	Inflight    []string             `protobuf:"bytes,5,rep,name=inflight,proto3" json:"inflight,omitempty"`                              // the services that requests are being sent to
+	Attempts    int32                `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`                             // the number of failed attempts to announce the record
+	NextAttempt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=nextAttempt,proto3" json:"nextAttempt,omitempty"`                        // the time after which the next attempt can be made
+	Priority    int32                `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`                             // announcements with a higher priority are made first
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_herald_records_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_herald_records_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_herald_records_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Announcement) GetRecordType() RecordType {
+	if x != nil {
+		return x.RecordType
+	}
+	return RecordType_run
+}
+
+func (x *Announcement) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Announcement) GetEnqueued() *timestamp.Timestamp {
+	if x != nil {
+		return x.Enqueued
+	}
+	return nil
+}
+
+func (x *Announcement) GetPending() []string {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *Announcement) GetInflight() []string {
+	if x != nil {
+		return x.Inflight
+	}
+	return nil
+}
+
+func (x *Announcement) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Announcement) GetNextAttempt() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return nil
+}
+
+func (x *Announcement) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+var File_herald_records_proto protoreflect.FileDescriptor
+
+var file_herald_records_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xd5, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x0c, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x89, 0x01, 0x0a,
+	0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xb7, 0x03, 0x0a, 0x13, 0x41, 0x63, 0x71,
+	0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x75, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61,
+	0x73, 0x73, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x22, 0x9e, 0x04, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x44, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x49,
+	0x44, 0x12, 0x30, 0x0a, 0x13, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
+	0x49, 0x44, 0x73, 0x22, 0xbc, 0x05, 0x0a, 0x0a, 0x48, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2a,
+	0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x48, 0x65, 0x72, 0x61,
+	0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x48, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x2e, 0x48, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x73, 0x12,
+	0x40, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x48, 0x65, 0x72,
+	0x61, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x4f, 0x0a, 0x0e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xed, 0x04, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x48, 0x65, 0x72, 0x61, 0x6c, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x66, 0x61, 0x73, 0x74, 0x35, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x61, 0x73, 0x74, 0x35, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x66, 0x61, 0x73,
+	0x74, 0x71, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x61, 0x73, 0x74, 0x71, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a,
+	0x0c, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x4b, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x72,
+	0x63, 0x6f, 0x64, 0x65, 0x4b, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x50, 0x0a, 0x14, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x14, 0x61,
+	0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x12,
+	0x6d, 0x69, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x75, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65, 0x6c, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x65,
+	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2f, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x48, 0x65, 0x72, 0x61, 0x6c, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62,
+	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x46,
+	0x61, 0x73, 0x74, 0x71, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x61, 0x73, 0x74, 0x71, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x02, 0x0a, 0x0c, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x3c, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2a, 0x30, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x10, 0x02, 0x2a, 0x86, 0x01, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x5f, 0x49, 0x4e,
+	0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x75,
+	0x6e, 0x74, 0x61, 0x67, 0x67, 0x65, 0x64, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x74, 0x61, 0x67,
+	0x73, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x12, 0x10, 0x0a,
+	0x0c, 0x74, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x10, 0x04, 0x12, 0x12,
+	0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x06, 0x2a, 0x21, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x10, 0x01, 0x42, 0x13, 0x5a, 0x11, 0x2e, 0x2f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x3b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_herald_records_proto_rawDescOnce sync.Once
+	file_herald_records_proto_rawDescData = file_herald_records_proto_rawDesc
+)
+
+func file_herald_records_proto_rawDescGZIP() []byte {
+	file_herald_records_proto_rawDescOnce.Do(func() {
+		file_herald_records_proto_rawDescData = protoimpl.X.CompressGZIP(file_herald_records_proto_rawDescData)
+	})
+	return file_herald_records_proto_rawDescData
+}
+
+var file_herald_records_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_herald_records_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_herald_records_proto_goTypes = []interface{}{
+	(CommentKind)(0),            // 0: records.CommentKind
+	(Status)(0),                 // 1: records.Status
+	(RecordType)(0),             // 2: records.RecordType
+	(*Comment)(nil),             // 3: records.Comment
+	(*ManifestFile)(nil),        // 4: records.ManifestFile
+	(*Manifest)(nil),            // 5: records.Manifest
+	(*AcquisitionSnapshot)(nil), // 6: records.AcquisitionSnapshot
+	(*MinknowProtocolRun)(nil),  // 7: records.MinknowProtocolRun
+	(*HeraldData)(nil),          // 8: records.HeraldData
+	(*Run)(nil),                 // 9: records.Run
+	(*Sample)(nil),              // 10: records.Sample
+	(*Announcement)(nil),        // 11: records.Announcement
+	nil,                         // 12: records.HeraldData.TagsEntry
+	nil,                         // 13: records.HeraldData.ResultsEntry
+	nil,                         // 14: records.HeraldData.JobIDsEntry
+	nil,                         // 15: records.HeraldData.ManifestsEntry
+	(*timestamp.Timestamp)(nil), // 16: google.protobuf.Timestamp
+}
+var file_herald_records_proto_depIdxs = []int32{
+	16, // 0: records.Comment.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 1: records.Comment.kind:type_name -> records.CommentKind
+	16, // 2: records.Manifest.created:type_name -> google.protobuf.Timestamp
+	4,  // 3: records.Manifest.files:type_name -> records.ManifestFile
+	16, // 4: records.AcquisitionSnapshot.time:type_name -> google.protobuf.Timestamp
+	16, // 5: records.AcquisitionSnapshot.startTime:type_name -> google.protobuf.Timestamp
+	16, // 6: records.MinknowProtocolRun.startTime:type_name -> google.protobuf.Timestamp
+	16, // 7: records.MinknowProtocolRun.endTime:type_name -> google.protobuf.Timestamp
+	16, // 8: records.HeraldData.created:type_name -> google.protobuf.Timestamp
+	3,  // 9: records.HeraldData.history:type_name -> records.Comment
+	1,  // 10: records.HeraldData.status:type_name -> records.Status
+	12, // 11: records.HeraldData.tags:type_name -> records.HeraldData.TagsEntry
+	13, // 12: records.HeraldData.results:type_name -> records.HeraldData.ResultsEntry
+	14, // 13: records.HeraldData.jobIDs:type_name -> records.HeraldData.JobIDsEntry
+	15, // 14: records.HeraldData.manifests:type_name -> records.HeraldData.ManifestsEntry
+	8,  // 15: records.Run.metadata:type_name -> records.HeraldData
+	6,  // 16: records.Run.acquisitionSnapshots:type_name -> records.AcquisitionSnapshot
+	7,  // 17: records.Run.minknowProtocolRun:type_name -> records.MinknowProtocolRun
+	8,  // 18: records.Sample.metadata:type_name -> records.HeraldData
+	2,  // 19: records.Announcement.recordType:type_name -> records.RecordType
+	16, // 20: records.Announcement.enqueued:type_name -> google.protobuf.Timestamp
+	16, // 21: records.Announcement.nextAttempt:type_name -> google.protobuf.Timestamp
+	5,  // 22: records.HeraldData.ManifestsEntry.value:type_name -> records.Manifest
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_herald_records_proto_init() }
+func file_herald_records_proto_init() {
+	if File_herald_records_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_herald_records_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -557,7 +1476,7 @@ func file_herald_records_proto_init() {
 			}
 		}
 		file_herald_records_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeraldData); i {
+			switch v := v.(*ManifestFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -569,7 +1488,7 @@ func file_herald_records_proto_init() {
 			}
 		}
 		file_herald_records_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Run); i {
+			switch v := v.(*Manifest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -581,6 +1500,54 @@ func file_herald_records_proto_init() {
 			}
 		}
 		file_herald_records_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquisitionSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_herald_records_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinknowProtocolRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_herald_records_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeraldData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_herald_records_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Run); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_herald_records_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Sample); i {
 			case 0:
 				return &v.state
@@ -592,14 +1559,26 @@ func file_herald_records_proto_init() {
 				return nil
 			}
 		}
+		file_herald_records_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_herald_records_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,78 @@
+package records
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DumpJSON renders a record as JSON, using camelCase field names.
+	DumpJSON = "json"
+
+	// DumpYAML renders a record as YAML.
+	DumpYAML = "yaml"
+
+	// DumpText renders a record in the protobuf text format.
+	DumpText = "text"
+)
+
+// DumpFormats are the formats that a record can be dumped to.
+var DumpFormats = []string{DumpJSON, DumpYAML, DumpText}
+
+// DumpRecord returns a string dump of a Run or Sample in the
+// requested format.
+func DumpRecord(record proto.Message, format string) (string, error) {
+	switch record.(type) {
+	case *Run, *Sample:
+		break
+	default:
+		return "", fmt.Errorf("unsupported record type provided to DumpRecord: %T", record)
+	}
+	switch format {
+	case DumpJSON:
+		return dumpJSON(record)
+	case DumpYAML:
+		return dumpYAML(record)
+	case DumpText:
+		return proto.MarshalTextString(record), nil
+	default:
+		return "", fmt.Errorf("unsupported dump format: %v", format)
+	}
+}
+
+// dumpJSON returns a JSON dump of a record.
+func dumpJSON(record proto.Message) (string, error) {
+	buf := &bytes.Buffer{}
+	jsonMarshaller := jsonpb.Marshaler{
+		EnumsAsInts:  false, // Whether to render enum values as integers, as opposed to string values.
+		EmitDefaults: false, // Whether to render fields with zero values
+		Indent:       "\t",  // A string to indent each level by
+		OrigName:     false, // Whether to use the original (.proto) name for fields
+	}
+	if err := jsonMarshaller.Marshal(buf, record); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// dumpYAML returns a YAML dump of a record, converted
+// from the JSON dump so that field order is kept.
+func dumpYAML(record proto.Message) (string, error) {
+	jsonDump, err := dumpJSON(record)
+	if err != nil {
+		return "", err
+	}
+	fields := yaml.MapSlice{}
+	if err := yaml.Unmarshal([]byte(jsonDump), &fields); err != nil {
+		return "", err
+	}
+	yamlDump, err := yaml.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(yamlDump), nil
+}
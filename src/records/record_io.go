@@ -1,19 +1,33 @@
 package records
 
 import (
-	"errors"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
-
-	"github.com/will-rowe/herald/src/helpers"
 )
 
-// DefaultFastqExtensions is used to glob the files from directories
-var DefaultFastqExtensions []string = []string{"*.fastq", "*.fq"}
+// Record is a run or sample, as sent to services.
+type Record interface {
+	proto.Message
+	GetMetadata() *HeraldData  // returns the Herald metadata for the record
+	GetRecordType() RecordType // returns the type of record (run or sample)
+}
+
+// GetRecordType returns RecordType_run, so that
+// a Run satisfies the Record interface.
+func (r *Run) GetRecordType() RecordType {
+	return RecordType_run
+}
+
+// GetRecordType returns RecordType_sample, so that
+// a Sample satisfies the Record interface.
+func (s *Sample) GetRecordType() RecordType {
+	return RecordType_sample
+}
 
 // InitRun will init a run struct with the minimum required values
-func InitRun(label, outputDir, fast5Dir, fastqDir, primerScheme string) *Run {
+func InitRun(label, outputDir, fast5Dir, fastqDir, primerScheme string, schemeVersion int32, barcodeKit string) *Run {
 
 	// create the run
 	run := &Run{
@@ -29,6 +43,8 @@ func InitRun(label, outputDir, fast5Dir, fastqDir, primerScheme string) *Run {
 		Fast5OutputDirectory: fast5Dir,
 		FastqOutputDirectory: fastqDir,
 		PrimerScheme:         primerScheme,
+		SchemeVersion:        schemeVersion,
+		BarcodeKit:           barcodeKit,
 	}
 
 	// create the history
@@ -62,15 +78,56 @@ func InitSample(sampleLabel, runLabel string, barcode int32) *Sample {
 	return sample
 }
 
-// AddComment is a method to add a comment to the history of an run or sample
+// AddComment is a method to add a system comment to the history of an run or sample
 func (heraldData *HeraldData) AddComment(text string) error {
-	if len(text) == 0 {
-		return fmt.Errorf("no comment provided")
+	return heraldData.addComment(&Comment{
+		Text: text,
+		Kind: CommentKind_system,
+	})
+}
+
+// AddUserComment is a method to add a user comment, with optional attachments, to the history of an run or sample
+func (heraldData *HeraldData) AddUserComment(author, text string, attachments ...string) error {
+	if len(author) == 0 {
+		return fmt.Errorf("no author provided for comment")
+	}
+	return heraldData.addComment(&Comment{
+		Text:        text,
+		Author:      author,
+		Kind:        CommentKind_user,
+		Attachments: attachments,
+	})
+}
+
+// AddServiceComment is a method to add a comment reported by a service to the history of an run or sample
+func (heraldData *HeraldData) AddServiceComment(serviceName, text string) error {
+	if len(serviceName) == 0 {
+		return fmt.Errorf("no service provided for comment")
 	}
-	comment := &Comment{
-		Timestamp: ptypes.TimestampNow(),
-		Text:      text,
+	return heraldData.addComment(&Comment{
+		Text:    text,
+		Kind:    CommentKind_service,
+		Service: serviceName,
+	})
+}
+
+// GetHistoryByKind returns the comments in the history of an run or sample that are of the provided kind
+func (heraldData *HeraldData) GetHistoryByKind(kind CommentKind) []*Comment {
+	comments := []*Comment{}
+	for _, comment := range heraldData.GetHistory() {
+		if comment.GetKind() == kind {
+			comments = append(comments, comment)
+		}
+	}
+	return comments
+}
+
+// addComment timestamps a comment and adds it to the history
+func (heraldData *HeraldData) addComment(comment *Comment) error {
+	if len(comment.GetText()) == 0 {
+		return fmt.Errorf("no comment provided")
 	}
+	comment.Timestamp = ptypes.TimestampNow()
 	heraldData.History = append(heraldData.History, comment)
 	return nil
 }
@@ -118,10 +175,66 @@ func (heraldData *HeraldData) SetTag(serviceName string, value bool) error {
 		return fmt.Errorf("%v does not have tag: %v", heraldData.GetLabel(), serviceName)
 	}
 	heraldData.Tags[serviceName] = value
-	heraldData.AddComment(fmt.Sprintf("%v tag marked as %v.", serviceName, value))
+	return heraldData.addComment(&Comment{
+		Text:    fmt.Sprintf("%v tag marked as %v.", serviceName, value),
+		Kind:    CommentKind_system,
+		Service: serviceName,
+	})
+}
+
+// SetResult is a method to store the result reported by a tagged service
+func (heraldData *HeraldData) SetResult(serviceName, result string) error {
+	if _, ok := heraldData.Tags[serviceName]; !ok {
+		return fmt.Errorf("%v does not have tag: %v", heraldData.GetLabel(), serviceName)
+	}
+	if heraldData.Results == nil {
+		heraldData.Results = make(map[string]string)
+	}
+	heraldData.Results[serviceName] = result
+	return nil
+}
+
+// SetJobID is a method to store the job identifier returned by a tagged service
+func (heraldData *HeraldData) SetJobID(serviceName, jobID string) error {
+	if _, ok := heraldData.Tags[serviceName]; !ok {
+		return fmt.Errorf("%v does not have tag: %v", heraldData.GetLabel(), serviceName)
+	}
+	if heraldData.JobIDs == nil {
+		heraldData.JobIDs = make(map[string]string)
+	}
+	heraldData.JobIDs[serviceName] = jobID
+	return nil
+}
+
+// SetManifest is a method to store the files written by a tagged service
+func (heraldData *HeraldData) SetManifest(serviceName, location string, files []*ManifestFile) error {
+	if _, ok := heraldData.Tags[serviceName]; !ok {
+		return fmt.Errorf("%v does not have tag: %v", heraldData.GetLabel(), serviceName)
+	}
+	if heraldData.Manifests == nil {
+		heraldData.Manifests = make(map[string]*Manifest)
+	}
+	heraldData.Manifests[serviceName] = &Manifest{
+		Created:  ptypes.TimestampNow(),
+		Location: location,
+		Files:    files,
+	}
 	return nil
 }
 
+// CheckTagsComplete returns true if the data is tagged and all the tags are marked complete
+func (heraldData *HeraldData) CheckTagsComplete() bool {
+	if len(heraldData.GetTags()) == 0 {
+		return false
+	}
+	for _, complete := range heraldData.GetTags() {
+		if !complete {
+			return false
+		}
+	}
+	return true
+}
+
 // CheckStatus checks the tags and updates the status if all tags are now marked complete
 // TODO: this func is incomplete - it only checks for tagged services atm
 func (heraldData *HeraldData) CheckStatus() error {
@@ -158,13 +271,3 @@ func (heraldData *HeraldData) CheckStatus() error {
 		return fmt.Errorf("unknown status: %d", status)
 	}
 }
-
-// GetFastqFiles will return a list of all
-// fastq files found in the Run FASTQ
-// directory.
-func (r *Run) GetFastqFiles() ([]string, error) {
-	if len(r.GetFastqOutputDirectory()) == 0 {
-		return nil, errors.New("no FASTQ directory found for run")
-	}
-	return helpers.GlobFiles(r.GetFastqOutputDirectory(), DefaultFastqExtensions)
-}
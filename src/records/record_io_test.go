@@ -1,6 +1,7 @@
 package records
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -35,7 +36,7 @@ func TestProtobufSample(t *testing.T) {
 func TestProtobufRun(t *testing.T) {
 
 	// set up a basic run
-	test := InitRun("testRun", "", "", "", "")
+	test := InitRun("testRun", "", "", "", "", 0, "")
 
 	// marshal it
 	data, err := proto.Marshal(test)
@@ -60,7 +61,7 @@ func TestProtobufRun(t *testing.T) {
 func TestTaggingExp(t *testing.T) {
 
 	// set up a basic sample
-	test := InitRun("testRun", "", "", "", "")
+	test := InitRun("testRun", "", "", "", "", 0, "")
 
 	// check that tags are required to method call
 	if err := test.Metadata.AddTags(nil); err == nil {
@@ -94,3 +95,94 @@ func TestTaggingExp(t *testing.T) {
 		}
 	*/
 }
+
+// TestComments tests the adding and filtering of comments
+func TestComments(t *testing.T) {
+	test := InitSample("testSample", "testRun", 1)
+
+	// check authors and services are required
+	if err := test.Metadata.AddUserComment("", "a note"); err == nil {
+		t.Fatal("AddUserComment did not return error when called with no author")
+	}
+	if err := test.Metadata.AddServiceComment("", "a result"); err == nil {
+		t.Fatal("AddServiceComment did not return error when called with no service")
+	}
+
+	// add one of each kind (InitSample has already added a system comment)
+	if err := test.Metadata.AddUserComment("tester", "a note", "/tmp/gel.png"); err != nil {
+		t.Fatal(err)
+	}
+	if err := test.Metadata.AddServiceComment("testService", "a result"); err != nil {
+		t.Fatal(err)
+	}
+	userComments := test.Metadata.GetHistoryByKind(CommentKind_user)
+	if len(userComments) != 1 || userComments[0].GetAuthor() != "tester" || len(userComments[0].GetAttachments()) != 1 {
+		t.Fatal("user comment not added to history correctly")
+	}
+	if len(test.Metadata.GetHistoryByKind(CommentKind_service)) != 1 {
+		t.Fatal("service comment not added to history")
+	}
+	if len(test.Metadata.GetHistoryByKind(CommentKind_system)) != 1 {
+		t.Fatal("system comments mixed with other comment kinds")
+	}
+}
+
+// TestServiceResults tests the storing of job IDs and results from tagged services
+func TestServiceResults(t *testing.T) {
+	test := InitSample("testSample", "testRun", 1)
+	if test.Metadata.CheckTagsComplete() {
+		t.Fatal("untagged sample reported as complete")
+	}
+	if err := test.Metadata.AddTags([]string{"serviceA", "serviceB"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := test.Metadata.SetJobID("serviceC", "job1"); err == nil {
+		t.Fatal("job ID set for an untagged service")
+	}
+	if err := test.Metadata.SetJobID("serviceA", "job1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := test.Metadata.SetResult("serviceA", "/data/out"); err != nil {
+		t.Fatal(err)
+	}
+	if test.Metadata.GetJobIDs()["serviceA"] != "job1" || test.Metadata.GetResults()["serviceA"] != "/data/out" {
+		t.Fatal("job ID and result not stored")
+	}
+	if err := test.Metadata.SetManifest("serviceA", "/data/out", []*ManifestFile{{Path: "reads.fastq", Size: 10, Checksum: "abc"}}); err != nil {
+		t.Fatal(err)
+	}
+	if manifest := test.Metadata.GetManifests()["serviceA"]; manifest.GetLocation() != "/data/out" || len(manifest.GetFiles()) != 1 {
+		t.Fatal("manifest not stored")
+	}
+	for _, tag := range []string{"serviceA", "serviceB"} {
+		if test.Metadata.CheckTagsComplete() {
+			t.Fatal("sample with incomplete tags reported as complete")
+		}
+		if err := test.Metadata.SetTag(tag, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !test.Metadata.CheckTagsComplete() {
+		t.Fatal("sample with complete tags not reported as complete")
+	}
+}
+
+// TestDumpRecord tests the dumping of records to the supported formats
+func TestDumpRecord(t *testing.T) {
+	run := InitRun("testRun", "/tmp", "", "", "scov2", 3, "")
+	for _, format := range DumpFormats {
+		dump, err := DumpRecord(run, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(dump, "testRun") {
+			t.Fatalf("%v dump is missing the run label: %v", format, dump)
+		}
+	}
+	if _, err := DumpRecord(run, "bogus"); err == nil {
+		t.Fatal("DumpRecord did not return error for unsupported format")
+	}
+	if _, err := DumpRecord(run.GetMetadata(), DumpJSON); err == nil {
+		t.Fatal("DumpRecord did not return error for unsupported record type")
+	}
+}
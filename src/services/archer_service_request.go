@@ -3,15 +3,22 @@ package services
 import (
 	"context"
 	"fmt"
-	"net"
+	"log"
+	"strings"
+	"sync"
 	"time"
 
 	archer "github.com/will-rowe/archer/pkg/api/v1"
 	"google.golang.org/grpc"
 
+	"github.com/will-rowe/herald/src/callbacks"
 	"github.com/will-rowe/herald/src/records"
 )
 
+// ArcherWatchRetry is how long to wait before reconnecting
+// to Archer if a watch on a job is interrupted.
+var ArcherWatchRetry = 5 * time.Second
+
 // archerService is an adapter to submit requests
 // from Herald to an archer service.
 type archerService struct {
@@ -20,6 +27,13 @@ type archerService struct {
 	dependsOn  []string           // the other services that should have completed prior to this one being contacted
 	address    string             // the gRPC address of the service
 	port       int                // the gRPC port the service is accepting requests on
+	grpcConnection
+
+	// job tracking
+	sync.Mutex
+	ctx     context.Context    // cancelled to stop watching jobs
+	cancel  context.CancelFunc // cancels ctx
+	watches sync.WaitGroup     // used to wait for the job watchers to finish
 }
 
 // NewArcherService will construct a
@@ -51,18 +65,13 @@ func (a *archerService) GetRecordType() records.RecordType {
 // GetAddress will return the address of
 // the gRPC server running the service.
 func (a *archerService) GetAddress() string {
-	return fmt.Sprintf("%v:%d", a.address, a.port)
+	return a.getConnAddress(fmt.Sprintf("%v:%d", a.address, a.port))
 }
 
 // CheckAccess returns true if the service is
 // accessible.
-func (a *archerService) CheckAccess() bool {
-	conn, err := net.DialTimeout("tcp", a.GetAddress(), time.Second)
-	if err != nil {
-		return false
-	}
-	conn.Close()
-	return true
+func (a *archerService) CheckAccess(ctx context.Context) bool {
+	return checkTCP(ctx, a.GetAddress())
 }
 
 // GetDependencies will return a slice
@@ -72,39 +81,44 @@ func (a *archerService) GetDependencies() []string {
 }
 
 // SendRequest will establish an archer client, formulate
-// a request and submit it to the running service.
-func (a *archerService) SendRequest(record interface{}) error {
+// a request and submit it to the running service. The job
+// identifier returned by Archer is reported back to Herald
+// and the job is then watched until it finishes.
+func (a *archerService) SendRequest(ctx context.Context, record records.Record) (*Result, error) {
 
 	// assert we have a Sample, not a Run
 	var run *records.Run
 	switch record.(type) {
 	case *records.Sample:
-		return fmt.Errorf("can't submit Sample in data upload request, need a Run")
+		return nil, fmt.Errorf("can't submit Sample in data upload request, need a Run")
 	case *records.Run:
 		run = record.(*records.Run)
 	default:
-		return fmt.Errorf("unsupported Herald record type")
+		return nil, fmt.Errorf("unsupported Herald record type")
 	}
 
+	if len(run.GetPrimerScheme()) == 0 {
+		return nil, fmt.Errorf("can't submit run %v to archer without a primer scheme", run.GetMetadata().GetLabel())
+	}
 	fastqs, err := run.GetFastqFiles()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// form an archer request
+	label := run.GetMetadata().GetLabel()
 	request := &archer.ProcessRequest{
 		ApiVersion:      DefaultArcherVersion,
-		SampleID:        run.GetMetadata().GetLabel(),
+		SampleID:        label,
 		InputFASTQfiles: fastqs,
 		Scheme:          run.GetPrimerScheme(),
-		SchemeVersion:   3,
-		//SchemeVersion: run.GetSchemeVersion(),
+		SchemeVersion:   run.GetSchemeVersion(),
 	}
 
 	// connect to the gRPC server
-	conn, err := grpc.Dial(a.GetAddress(), grpc.WithInsecure())
+	conn, err := grpc.DialContext(ctx, a.GetAddress(), a.GetDialOptions()...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer conn.Close()
 
@@ -112,10 +126,146 @@ func (a *archerService) SendRequest(record interface{}) error {
 	client := archer.NewArcherClient(conn)
 
 	// send the request
-	resp, err := client.Process(context.Background(), request)
+	resp, err := client.Process(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetId()) == 0 {
+		return nil, fmt.Errorf("no job identifier in archer response for %v", label)
+	}
+
+	// keep the job identifier on the record and watch the job (it is
+	// watched even if the report fails, as Archer has accepted it)
+	result := &Result{
+		State:   callbacks.JobState_running,
+		JobID:   resp.GetId(),
+		Message: "submitted to archer",
+	}
+	if err := report(&callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: a.name,
+		RecordType:  a.recordType.String(),
+		State:       result.State,
+		Message:     result.Message,
+		JobID:       result.JobID,
+	}); err != nil {
+		log.Printf("%v: %v", a.name, err)
+	}
+	a.Watch(label, resp.GetId())
+	return result, nil
+}
+
+// Cancel will ask Archer to cancel a job, the job
+// is then reported as failed by its watcher.
+func (a *archerService) Cancel(ctx context.Context, label, jobID string) error {
+	conn, err := grpc.DialContext(ctx, a.GetAddress(), a.GetDialOptions()...)
 	if err != nil {
 		return err
 	}
+	defer conn.Close()
+	_, err = archer.NewArcherClient(conn).Cancel(ctx, &archer.CancelRequest{
+		ApiVersion: DefaultArcherVersion,
+		Id:         jobID,
+	})
+	return err
+}
 
-	return fmt.Errorf("upload request response: %s", resp)
+// Watch will start tracking an Archer job for a record,
+// reporting back to Herald once it finishes. The watch
+// reconnects if it is interrupted, until StopWatching
+// is called.
+func (a *archerService) Watch(label, jobID string) {
+	a.Lock()
+	if a.ctx == nil {
+		a.ctx, a.cancel = context.WithCancel(context.Background())
+	}
+	ctx := a.ctx
+	a.watches.Add(1)
+	a.Unlock()
+	go func() {
+		defer a.watches.Done()
+		for {
+			info, err := a.watchJob(ctx, jobID)
+			if err == nil {
+				a.reportJob(label, jobID, info)
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(ArcherWatchRetry):
+			}
+		}
+	}()
+}
+
+// StopWatching will stop tracking all the Archer jobs
+// and wait for the watchers to finish.
+func (a *archerService) StopWatching() {
+	a.Lock()
+	if a.cancel != nil {
+		a.cancel()
+	}
+	a.ctx, a.cancel = nil, nil
+	a.Unlock()
+	a.watches.Wait()
+}
+
+// watchJob will open a watch stream to Archer and return
+// the job info once the job has finished.
+func (a *archerService) watchJob(ctx context.Context, jobID string) (*archer.SampleInfo, error) {
+	conn, err := grpc.DialContext(ctx, a.GetAddress(), a.GetDialOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	stream, err := archer.NewArcherClient(conn).Watch(ctx, &archer.WatchRequest{
+		ApiVersion:   DefaultArcherVersion,
+		SendFinished: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range resp.GetSamples() {
+			if info.GetSampleID() != jobID {
+				continue
+			}
+			switch info.GetState() {
+			case archer.State_SUCCESS, archer.State_ERROR, archer.State_CANCELLED:
+				return info, nil
+			}
+		}
+	}
+}
+
+// reportJob will report a finished Archer job back to Herald.
+func (a *archerService) reportJob(label, jobID string, info *archer.SampleInfo) {
+	r := &callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: a.name,
+		RecordType:  a.recordType.String(),
+		JobID:       jobID,
+	}
+	switch info.GetState() {
+	case archer.State_SUCCESS:
+		r.State = callbacks.JobState_complete
+		r.Result = info.GetEndpoint()
+	case archer.State_CANCELLED:
+		r.State = callbacks.JobState_failed
+		r.Message = "archer job was cancelled"
+	default:
+		r.State = callbacks.JobState_failed
+		r.Message = "archer job failed"
+		if len(info.GetErrors()) != 0 {
+			r.Message = fmt.Sprintf("%v: %v", r.Message, strings.Join(info.GetErrors(), "; "))
+		}
+	}
+	if err := report(r); err != nil {
+		log.Printf("%v: %v", a.name, err)
+	}
 }
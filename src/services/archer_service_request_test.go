@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	archer "github.com/will-rowe/archer/pkg/api/v1"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services/archertest"
+)
+
+// waitForReport returns the next report, or fails the test
+func waitForReport(t *testing.T, reports chan *callbacks.ReportRequest) *callbacks.ReportRequest {
+	select {
+	case r := <-reports:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for report")
+	}
+	return nil
+}
+
+// TestArcherService checks requests are submitted and the jobs are watched until they finish
+func TestArcherService(t *testing.T) {
+
+	// start a fake Archer that leaves jobs running for the test to finish
+	server, err := archertest.NewServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	server.SetOutcome(archertest.Outcome{State: archer.State_RUNNING})
+
+	// collect the reports
+	reports := make(chan *callbacks.ReportRequest, 10)
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		reports <- r
+		return "", nil
+	})
+	defer SetReporter(nil)
+	service := NewArcherService("test archer", records.RecordType_run, nil, "127.0.0.1", server.Port())
+	defer service.(Watcher).StopWatching()
+	if !service.CheckAccess(context.Background()) {
+		t.Fatal("could not access test server")
+	}
+
+	// set up some runs with reads
+	if err := os.MkdirAll("./tmp/fastq_pass", 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./tmp")
+	if err := ioutil.WriteFile("./tmp/fastq_pass/reads.fastq", []byte("@r1\nACGT\n+\nIIII\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.SendRequest(context.Background(), records.InitSample("sample", "run", 0)); err == nil {
+		t.Fatal("archer accepted a sample")
+	}
+	if _, err := service.SendRequest(context.Background(), records.InitRun("no scheme run", "./tmp", "", "./tmp/fastq_pass", "", 0, "")); err == nil || len(server.Requests()) != 0 {
+		t.Fatal("archer was sent a run without a primer scheme")
+	}
+
+	// check a successful job is tracked through to its output location
+	for i, state := range []archer.State{archer.State_SUCCESS, archer.State_ERROR} {
+		label := fmt.Sprintf("run %d", i)
+		run := records.InitRun(label, "./tmp", "", "./tmp/fastq_pass", "scov2", 3, "")
+		if _, err := service.SendRequest(context.Background(), run); err != nil {
+			t.Fatal(err)
+		}
+		r := waitForReport(t, reports)
+		if r.GetState() != callbacks.JobState_running || r.GetJobID() != label || r.GetServiceName() != "test archer" {
+			t.Fatalf("unexpected submission report: %v", r)
+		}
+		if request := server.Requests()[i]; request.GetSampleID() != label || len(request.GetInputFASTQfiles()) != 1 || request.GetScheme() != "scov2" || request.GetSchemeVersion() != 3 {
+			t.Fatalf("unexpected archer request: %v", request)
+		}
+		if err := server.Finish(label, state, "s3://bucket/"+label, "bad reads"); err != nil {
+			t.Fatal(err)
+		}
+		r = waitForReport(t, reports)
+		switch state {
+		case archer.State_SUCCESS:
+			if r.GetState() != callbacks.JobState_complete || r.GetResult() != "s3://bucket/"+label {
+				t.Fatalf("unexpected completion report: %v", r)
+			}
+		case archer.State_ERROR:
+			if r.GetState() != callbacks.JobState_failed || r.GetMessage() != "archer job failed: bad reads" {
+				t.Fatalf("unexpected failure report: %v", r)
+			}
+		}
+	}
+
+	// check a job can be cancelled
+	run := records.InitRun("run 2", "./tmp", "", "./tmp/fastq_pass", "scov2", 3, "")
+	result, err := service.SendRequest(context.Background(), run)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetJobID() != result.JobID {
+		t.Fatalf("result does not match the submission report: %v %v", result, r)
+	}
+	if err := service.Cancel(context.Background(), "run 2", result.JobID); err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_failed || r.GetMessage() != "archer job was cancelled" {
+		t.Fatalf("unexpected cancellation report: %v", r)
+	}
+
+	// check an accepted job is still watched if it can't be reported
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		if r.GetState() == callbacks.JobState_running {
+			return "", fmt.Errorf("store is unavailable")
+		}
+		reports <- r
+		return "", nil
+	})
+	result, err = service.SendRequest(context.Background(), records.InitRun("run 3", "./tmp", "", "./tmp/fastq_pass", "scov2", 3, ""))
+	if err != nil || result.JobID != "run 3" {
+		t.Fatalf("accepted job was not returned: %v %v", result, err)
+	}
+	if err := server.Finish("run 3", archer.State_SUCCESS, ""); err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_complete || r.GetJobID() != "run 3" {
+		t.Fatalf("unexpected completion report: %v", r)
+	}
+
+	// check a request isn't sent once its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := service.SendRequest(ctx, run); err == nil {
+		t.Fatal("request was sent with a cancelled context")
+	}
+}
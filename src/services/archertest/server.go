@@ -0,0 +1,298 @@
+// Package archertest provides a fake Archer, which records
+// the requests it is sent and runs their jobs to a scripted
+// outcome, so that Herald can be tested without Archer.
+package archertest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	archer "github.com/will-rowe/archer/pkg/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Outcome describes how the fake handles the requests
+// it is sent. The zero Outcome accepts each request
+// straight away and the job then succeeds.
+type Outcome struct {
+	Delay    time.Duration // how long a request takes to be accepted
+	Reject   string        // if set, requests are refused with this message
+	State    archer.State  // the state jobs finish in (success if unset, running leaves them for Finish)
+	RunTime  time.Duration // how long jobs run before they finish
+	Endpoint string        // where the results of a successful job are (defaults to s3://archer-test/<job ID>)
+	Errors   []string      // the errors of jobs that don't succeed
+}
+
+// Server is a fake Archer gRPC server. A job
+// is identified by the sample ID it was sent.
+//
+// The job info it sends is never changed once
+// sent, an update replaces it instead.
+type Server struct {
+	archer.UnimplementedArcherServer
+	listener   net.Listener
+	grpcServer *grpc.Server
+	stopped    chan struct{} // closed, under the lock, when the server is stopped
+	stopOnce   sync.Once
+	wg         sync.WaitGroup // the jobs that are waiting to finish
+
+	lock     sync.Mutex
+	outcome  Outcome
+	requests []*archer.ProcessRequest // the requests received, in order
+	jobIDs   []string                 // the jobs, in the order they were accepted
+	jobs     map[string]*archer.SampleInfo
+	changed  chan struct{} // closed, then replaced, when a job changes
+}
+
+// New returns a fake Archer that isn't being served,
+// for use with Register.
+func New() *Server {
+	return &Server{
+		stopped: make(chan struct{}),
+		jobs:    make(map[string]*archer.SampleInfo),
+		changed: make(chan struct{}),
+	}
+}
+
+// NewServer will start a fake Archer on an address
+// (127.0.0.1 on any free port if empty).
+func NewServer(address string) (*Server, error) {
+	if len(address) == 0 {
+		address = "127.0.0.1:0"
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	server := New()
+	server.listener, server.grpcServer = listener, grpc.NewServer()
+	server.Register(server.grpcServer)
+	go server.grpcServer.Serve(listener)
+	return server, nil
+}
+
+// Register will offer the fake on a gRPC server,
+// such as one that needs TLS or interceptors.
+func (server *Server) Register(grpcServer *grpc.Server) {
+	archer.RegisterArcherServer(grpcServer, server)
+}
+
+// Address returns the host:port of the server
+// (only for servers started with NewServer).
+func (server *Server) Address() string {
+	return server.listener.Addr().String()
+}
+
+// Port returns the port of the server
+// (only for servers started with NewServer).
+func (server *Server) Port() int {
+	return server.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Stop will stop the server, leaving any running jobs
+// unfinished. Watches are ended, but a gRPC server the
+// fake was registered with must be stopped separately.
+func (server *Server) Stop() {
+	server.stopOnce.Do(func() {
+		server.lock.Lock()
+		close(server.stopped)
+		server.lock.Unlock()
+		if server.grpcServer != nil {
+			server.grpcServer.Stop()
+		}
+	})
+	server.wg.Wait()
+}
+
+// SetOutcome will change how the requests
+// received from now on are handled.
+func (server *Server) SetOutcome(outcome Outcome) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.outcome = outcome
+}
+
+// Requests returns the requests received so far,
+// including those that were refused.
+func (server *Server) Requests() []*archer.ProcessRequest {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	return append([]*archer.ProcessRequest{}, server.requests...)
+}
+
+// Job returns the info for a job, or nil
+// if the job hasn't been accepted.
+func (server *Server) Job(id string) *archer.SampleInfo {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	return server.jobs[id]
+}
+
+// Finish will finish a running job, such as one
+// left running by an outcome with the running state.
+func (server *Server) Finish(id string, state archer.State, endpoint string, errors ...string) error {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	job, ok := server.jobs[id]
+	if !ok {
+		return fmt.Errorf("no archer job with ID %q", id)
+	}
+	if job.GetState() != archer.State_RUNNING {
+		return fmt.Errorf("archer job %v has already finished (%v)", id, job.GetState())
+	}
+	server.finish(id, state, endpoint, errors)
+	return nil
+}
+
+// setJob will replace the info for a job and let
+// any watchers know it has changed.
+//
+// NOTE: the caller must hold the server lock.
+func (server *Server) setJob(info *archer.SampleInfo) {
+	if _, ok := server.jobs[info.GetSampleID()]; !ok {
+		server.jobIDs = append(server.jobIDs, info.GetSampleID())
+	}
+	server.jobs[info.GetSampleID()] = info
+	close(server.changed)
+	server.changed = make(chan struct{})
+}
+
+// finish will move a job to a finished state.
+//
+// NOTE: the caller must hold the server lock.
+func (server *Server) finish(id string, state archer.State, endpoint string, errors []string) {
+	info := proto.Clone(server.jobs[id]).(*archer.SampleInfo)
+	info.State, info.EndTime = state, ptypes.TimestampNow()
+	switch state {
+	case archer.State_SUCCESS:
+		info.Endpoint = endpoint
+		if len(info.Endpoint) == 0 {
+			info.Endpoint = fmt.Sprintf("s3://archer-test/%v", id)
+		}
+	default:
+		info.Errors = append([]string{}, errors...)
+	}
+	server.setJob(info)
+}
+
+// Process records a request, then accepts or refuses
+// it as the outcome says. Accepted jobs run until they
+// finish, unless one is already running for the sample.
+func (server *Server) Process(ctx context.Context, request *archer.ProcessRequest) (*archer.ProcessResponse, error) {
+	request = proto.Clone(request).(*archer.ProcessRequest)
+	server.lock.Lock()
+	server.requests = append(server.requests, request)
+	outcome := server.outcome
+	server.lock.Unlock()
+	if outcome.Delay != 0 {
+		select {
+		case <-time.After(outcome.Delay):
+		case <-ctx.Done():
+			return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		case <-server.stopped:
+			return nil, status.Error(codes.Unavailable, "archer is shutting down")
+		}
+	}
+	if len(outcome.Reject) != 0 {
+		return nil, status.Error(codes.InvalidArgument, outcome.Reject)
+	}
+	id := request.GetSampleID()
+	if len(id) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no sample ID in request")
+	}
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	if job, ok := server.jobs[id]; ok && job.GetState() == archer.State_RUNNING {
+		return nil, status.Errorf(codes.AlreadyExists, "archer job %v is already running", id)
+	}
+	server.setJob(&archer.SampleInfo{
+		SampleID:       id,
+		State:          archer.State_RUNNING,
+		ProcessRequest: request,
+		StartTime:      ptypes.TimestampNow(),
+	})
+
+	// finish the job in the background
+	state := outcome.State
+	if state == archer.State_UNKNOWN {
+		state = archer.State_SUCCESS
+	}
+	select {
+	case <-server.stopped:
+	default:
+		if state != archer.State_RUNNING {
+			server.wg.Add(1)
+			go func(job *archer.SampleInfo) {
+				defer server.wg.Done()
+				select {
+				case <-server.stopped:
+					return
+				case <-time.After(outcome.RunTime):
+				}
+				server.lock.Lock()
+				defer server.lock.Unlock()
+
+				// leave the job if it has been cancelled or finished since
+				if server.jobs[id] == job {
+					server.finish(id, state, outcome.Endpoint, outcome.Errors)
+				}
+			}(server.jobs[id])
+		}
+	}
+	return &archer.ProcessResponse{ApiVersion: request.GetApiVersion(), Id: id}, nil
+}
+
+// Cancel cancels a running job.
+func (server *Server) Cancel(ctx context.Context, request *archer.CancelRequest) (*archer.CancelResponse, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	job, ok := server.jobs[request.GetId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no archer job with ID %q", request.GetId())
+	}
+	if job.GetState() != archer.State_RUNNING {
+		return nil, status.Errorf(codes.FailedPrecondition, "archer job %v has already finished", request.GetId())
+	}
+	server.finish(request.GetId(), archer.State_CANCELLED, "", nil)
+	return &archer.CancelResponse{ApiVersion: request.GetApiVersion(), Id: request.GetId()}, nil
+}
+
+// Watch sends the jobs, then each job as it changes, until
+// the client goes away. Finished jobs are only sent if the
+// request asks for them.
+func (server *Server) Watch(request *archer.WatchRequest, stream archer.Archer_WatchServer) error {
+	sent := make(map[string]*archer.SampleInfo)
+	for {
+		resp := &archer.WatchResponse{}
+		server.lock.Lock()
+		for _, id := range server.jobIDs {
+			info := server.jobs[id]
+			if sent[id] == info || (!request.GetSendFinished() && info.GetState() != archer.State_RUNNING) {
+				continue
+			}
+			resp.Samples = append(resp.Samples, info)
+			sent[id] = info
+		}
+		changed := server.changed
+		server.lock.Unlock()
+		if len(resp.GetSamples()) != 0 {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-server.stopped:
+			return status.Error(codes.Unavailable, "archer is shutting down")
+		}
+	}
+}
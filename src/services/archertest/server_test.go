@@ -0,0 +1,112 @@
+package archertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	archer "github.com/will-rowe/archer/pkg/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchFor receives from a watch until a job reaches a state, or fails the test
+func watchFor(t *testing.T, stream archer.Archer_WatchClient, id string, state archer.State) *archer.SampleInfo {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, info := range resp.GetSamples() {
+			if info.GetSampleID() == id && info.GetState() == state {
+				return info
+			}
+		}
+	}
+}
+
+// TestServer checks requests are recorded and their jobs run to the scripted outcome
+func TestServer(t *testing.T) {
+	server, err := NewServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	conn, err := grpc.Dial(server.Address(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := archer.NewArcherClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.Watch(ctx, &archer.WatchRequest{SendFinished: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// check a job succeeds by default
+	resp, err := client.Process(ctx, &archer.ProcessRequest{ApiVersion: "1", SampleID: "run 1", InputFASTQfiles: []string{"reads.fastq"}, Scheme: "scov2", SchemeVersion: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetId() != "run 1" {
+		t.Fatalf("unexpected job ID: %v", resp.GetId())
+	}
+	if info := watchFor(t, stream, "run 1", archer.State_SUCCESS); info.GetEndpoint() != "s3://archer-test/run 1" || info.GetProcessRequest().GetScheme() != "scov2" || info.GetEndTime() == nil {
+		t.Fatalf("unexpected job info: %v", info)
+	}
+
+	// check a job can fail, or be left running to be finished or cancelled
+	server.SetOutcome(Outcome{State: archer.State_ERROR, Errors: []string{"bad reads"}})
+	if _, err := client.Process(ctx, &archer.ProcessRequest{SampleID: "run 2"}); err != nil {
+		t.Fatal(err)
+	}
+	if info := watchFor(t, stream, "run 2", archer.State_ERROR); len(info.GetErrors()) != 1 || info.GetErrors()[0] != "bad reads" {
+		t.Fatalf("unexpected job info: %v", info)
+	}
+	server.SetOutcome(Outcome{State: archer.State_RUNNING})
+	for _, id := range []string{"run 3", "run 4"} {
+		if _, err := client.Process(ctx, &archer.ProcessRequest{SampleID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.Process(ctx, &archer.ProcessRequest{SampleID: "run 3"}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected a running job to be refused, got: %v", err)
+	}
+	if err := server.Finish("run 3", archer.State_SUCCESS, "s3://bucket/run 3"); err != nil {
+		t.Fatal(err)
+	}
+	if info := watchFor(t, stream, "run 3", archer.State_SUCCESS); info.GetEndpoint() != "s3://bucket/run 3" {
+		t.Fatalf("unexpected job info: %v", info)
+	}
+	if err := server.Finish("run 3", archer.State_ERROR, ""); err == nil {
+		t.Fatal("finished a job twice")
+	}
+	if _, err := client.Cancel(ctx, &archer.CancelRequest{Id: "run 4"}); err != nil {
+		t.Fatal(err)
+	}
+	watchFor(t, stream, "run 4", archer.State_CANCELLED)
+	if _, err := client.Cancel(ctx, &archer.CancelRequest{Id: "run 5"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an unknown job to be not found, got: %v", err)
+	}
+
+	// check requests can be refused or slow, and are still recorded
+	server.SetOutcome(Outcome{Reject: "unknown primer scheme"})
+	if _, err := client.Process(ctx, &archer.ProcessRequest{SampleID: "run 5"}); status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != "unknown primer scheme" {
+		t.Fatalf("expected the request to be refused, got: %v", err)
+	}
+	server.SetOutcome(Outcome{Delay: time.Second})
+	slowCtx, slowCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer slowCancel()
+	if _, err := client.Process(slowCtx, &archer.ProcessRequest{SampleID: "run 6"}); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected the slow request to time out, got: %v", err)
+	}
+	if requests := server.Requests(); len(requests) != 7 || requests[0].GetInputFASTQfiles()[0] != "reads.fastq" || requests[6].GetSampleID() != "run 6" {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+	if server.Job("run 5") != nil || server.Job("run 1").GetState() != archer.State_SUCCESS {
+		t.Fatal("unexpected jobs were accepted")
+	}
+}
@@ -0,0 +1,392 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+)
+
+var (
+	// CommandOutputLines is the number of lines of command output that are kept in the record history.
+	CommandOutputLines = 20
+
+	// CommandLogName is the name of the file in the job output directory that the full command output is written to.
+	CommandLogName = "herald-command.log"
+)
+
+// commandPlaceholders are the placeholders that can be used in a
+// command template, they are replaced with the record details.
+//
+// {label}         - the run or sample label
+// {fastq}         - the FASTQ files (one argument per file if used on its own)
+// {outdir}        - the output directory for the job
+// {scheme}        - the primer scheme name
+// {schemeVersion} - the primer scheme version
+// {barcode}       - the sample barcode (0 for runs and unbarcoded samples)
+var commandPlaceholders = map[string]bool{
+	"{label}":         true,
+	"{fastq}":         true,
+	"{outdir}":        true,
+	"{scheme}":        true,
+	"{schemeVersion}": true,
+	"{barcode}":       true,
+}
+
+// placeholderRegex is used to find the placeholders in a command template.
+var placeholderRegex = regexp.MustCompile(`\{[A-Za-z]+\}`)
+
+// commandService is an adapter that runs a local
+// command (e.g. a pipeline or script) for each
+// request from Herald.
+type commandService struct {
+	name            string             // name of the service
+	recordType      records.RecordType // the type of Herald record this service operates on (run or sample)
+	dependsOn       []string           // the other services that should have completed prior to this one being contacted
+	command         []string           // the command template, split into arguments
+	outputDirectory string             // where the job output is written (a directory is made for each record)
+
+	sync.Mutex
+	ctx     context.Context               // cancelled to stop the running commands
+	cancel  context.CancelFunc            // cancels ctx
+	running map[string]context.CancelFunc // cancels each running command, keyed by job ID
+	jobs    sync.WaitGroup                // the running commands
+}
+
+// NewCommandService will construct a new command service
+// adaptor and return the Service interface. The command
+// template is split into arguments like a shell would
+// (quotes group words) but is not run by a shell.
+func NewCommandService(name string, recordType records.RecordType, dependsOn []string, command, outputDirectory string) (Service, error) {
+	args, err := splitCommand(command)
+	if err != nil {
+		return nil, err
+	}
+	for _, placeholder := range placeholderRegex.FindAllString(command, -1) {
+		if !commandPlaceholders[placeholder] {
+			return nil, fmt.Errorf("unknown placeholder in command for %v: %v", name, placeholder)
+		}
+	}
+	if len(outputDirectory) == 0 {
+		return nil, fmt.Errorf("no output directory provided for %v", name)
+	}
+	cs := &commandService{
+		name:            name,
+		recordType:      recordType,
+		dependsOn:       dependsOn,
+		command:         args,
+		outputDirectory: outputDirectory,
+		running:         make(map[string]context.CancelFunc),
+	}
+	return cs, nil
+}
+
+// GetServiceName returns the name of the service.
+func (c *commandService) GetServiceName() string {
+	return c.name
+}
+
+// GetRecordType returns the record type (sample/run)
+// that the service accepts.
+func (c *commandService) GetRecordType() records.RecordType {
+	return c.recordType
+}
+
+// GetAddress will return an empty string, as
+// the command is run locally.
+func (c *commandService) GetAddress() string {
+	return ""
+}
+
+// CheckAccess returns true if the command
+// can be found.
+func (c *commandService) CheckAccess(ctx context.Context) bool {
+	_, err := exec.LookPath(c.command[0])
+	return err == nil
+}
+
+// GetDependencies will return a slice
+// of the dependency names.
+func (c *commandService) GetDependencies() []string {
+	return c.dependsOn
+}
+
+// SendRequest will fill in the command template for the
+// record and start the command. The process ID is reported
+// back to Herald as the job identifier, and the tag is
+// marked complete or failed once the command exits.
+func (c *commandService) SendRequest(ctx context.Context, record records.Record) (*Result, error) {
+
+	// collect the record details for the placeholders
+	var label, scheme string
+	var schemeVersion, barcode int32
+	var fastqs []string
+	switch r := record.(type) {
+	case *records.Run:
+		var err error
+		if fastqs, err = r.GetFastqFiles(); err != nil {
+			return nil, err
+		}
+		label, scheme, schemeVersion = r.GetMetadata().GetLabel(), r.GetPrimerScheme(), r.GetSchemeVersion()
+	case *records.Sample:
+		fastqs = r.GetInputFastqFiles()
+		label, scheme, schemeVersion, barcode = r.GetMetadata().GetLabel(), r.GetPrimerScheme(), r.GetSchemeVersion(), r.GetBarcode()
+	default:
+		return nil, fmt.Errorf("unsupported Herald record type")
+	}
+
+	// make the output directory and fill in the template
+	if err := checkPathLabel(label); err != nil {
+		return nil, err
+	}
+	outdir, err := filepath.Abs(filepath.Join(c.outputDirectory, label))
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(outdir, 0777); err != nil {
+		return nil, err
+	}
+	args := expandCommand(c.command, map[string][]string{
+		"{label}":         {label},
+		"{fastq}":         fastqs,
+		"{outdir}":        {outdir},
+		"{scheme}":        {scheme},
+		"{schemeVersion}": {strconv.Itoa(int(schemeVersion))},
+		"{barcode}":       {strconv.Itoa(int(barcode))},
+	})
+
+	// start the command, writing the output to a log in the output directory
+	logFile, err := os.Create(filepath.Join(outdir, CommandLogName))
+	if err != nil {
+		return nil, err
+	}
+	c.Lock()
+	if c.ctx == nil {
+		c.ctx, c.cancel = context.WithCancel(context.Background())
+	}
+	jobCtx, jobCancel := context.WithCancel(c.ctx)
+	c.Unlock()
+	tail := &outputTail{}
+	cmd := exec.CommandContext(jobCtx, args[0], args[1:]...)
+	cmd.Dir = outdir
+	cmd.Stdout = io.MultiWriter(logFile, tail)
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		jobCancel()
+		logFile.Close()
+		return nil, err
+	}
+	jobID := strconv.Itoa(cmd.Process.Pid)
+	c.Lock()
+	c.running[jobID] = jobCancel
+	c.Unlock()
+
+	// keep the job identifier on the record and wait for the command to finish
+	// (the command has started, so the result is returned even if the report fails)
+	result := &Result{
+		State:   callbacks.JobState_running,
+		JobID:   jobID,
+		Message: fmt.Sprintf("started %v", strings.Join(args, " ")),
+		Output:  outdir,
+	}
+	if err := report(&callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: c.name,
+		RecordType:  c.recordType.String(),
+		State:       result.State,
+		Message:     result.Message,
+		JobID:       jobID,
+	}); err != nil {
+		log.Printf("%v: %v", c.name, err)
+	}
+	c.jobs.Add(1)
+	go func() {
+		defer c.jobs.Done()
+		err := cmd.Wait()
+		logFile.Close()
+		if err != nil && jobCtx.Err() != nil {
+			err = fmt.Errorf("cancelled")
+		}
+		c.Lock()
+		delete(c.running, jobID)
+		c.Unlock()
+		jobCancel()
+		c.reportJob(label, jobID, outdir, err, tail.String())
+	}()
+	return result, nil
+}
+
+// Cancel will stop a running command, the
+// job is then reported as failed.
+func (c *commandService) Cancel(ctx context.Context, label, jobID string) error {
+	c.Lock()
+	defer c.Unlock()
+	cancel, ok := c.running[jobID]
+	if !ok {
+		return fmt.Errorf("no command running for %v with job ID %v", label, jobID)
+	}
+	cancel()
+	return nil
+}
+
+// Watch is called for commands that were running when Herald
+// last stopped. The process can't be re-attached to, so the
+// job is reported as failed.
+func (c *commandService) Watch(label, jobID string) {
+	c.jobs.Add(1)
+	go func() {
+		defer c.jobs.Done()
+		if err := report(&callbacks.ReportRequest{
+			Label:       label,
+			ServiceName: c.name,
+			RecordType:  c.recordType.String(),
+			State:       callbacks.JobState_failed,
+			Message:     fmt.Sprintf("command (process %v) was interrupted when Herald stopped", jobID),
+			JobID:       jobID,
+		}); err != nil {
+			log.Printf("%v: %v", c.name, err)
+		}
+	}()
+}
+
+// StopWatching will stop any running commands and wait
+// for them to be reported.
+func (c *commandService) StopWatching() {
+	c.Lock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.ctx, c.cancel = nil, nil
+	c.Unlock()
+	c.jobs.Wait()
+}
+
+// reportJob will report a finished command back to
+// Herald, using the exit code to decide if the job
+// succeeded and including the end of the output.
+func (c *commandService) reportJob(label, jobID, outdir string, err error, output string) {
+	request := &callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: c.name,
+		RecordType:  c.recordType.String(),
+		State:       callbacks.JobState_complete,
+		Message:     "command finished",
+		Result:      outdir,
+		JobID:       jobID,
+	}
+	if err != nil {
+		request.State = callbacks.JobState_failed
+		request.Message = fmt.Sprintf("command failed (%v)", err)
+	}
+	if len(output) != 0 {
+		request.Message = fmt.Sprintf("%v, output:\n%v", request.Message, output)
+	}
+	if err := report(request); err != nil {
+		log.Printf("%v: %v", c.name, err)
+	}
+}
+
+// splitCommand will split a command template into
+// arguments on whitespace, keeping quoted text together.
+func splitCommand(command string) ([]string, error) {
+	args := []string{}
+	var current strings.Builder
+	var quote rune
+	inArg := false
+	for _, r := range command {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in command: %v", command)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no command provided")
+	}
+	return args, nil
+}
+
+// checkPathLabel will check a record label can be used as
+// the name of a directory, so that the output for a record
+// can't be written outside the directory it is given, or be
+// mistaken for an option when passed to a command.
+func checkPathLabel(label string) error {
+	if len(label) == 0 || label == "." || label == ".." || strings.HasPrefix(label, "-") || strings.ContainsAny(label, `/\`) {
+		return fmt.Errorf("record label can't be used as a directory name: %q", label)
+	}
+	return nil
+}
+
+// expandCommand will replace the placeholders in the command
+// arguments. A placeholder with several values is expanded
+// into one argument per value if it is the whole argument,
+// otherwise the values are joined with spaces.
+func expandCommand(command []string, values map[string][]string) []string {
+	args := []string{}
+	for _, arg := range command {
+		if value, ok := values[arg]; ok {
+			args = append(args, value...)
+			continue
+		}
+		args = append(args, placeholderRegex.ReplaceAllStringFunc(arg, func(placeholder string) string {
+			return strings.Join(values[placeholder], " ")
+		}))
+	}
+	return args
+}
+
+// outputTail keeps the end of the command output.
+type outputTail struct {
+	buf bytes.Buffer
+}
+
+// Write will add to the output, dropping the start
+// of the output once it gets large.
+func (t *outputTail) Write(p []byte) (int, error) {
+	t.buf.Write(p)
+	if t.buf.Len() > 64*1024 {
+		t.buf.Next(t.buf.Len() - 32*1024)
+	}
+	return len(p), nil
+}
+
+// String returns the last CommandOutputLines lines of the output.
+func (t *outputTail) String() string {
+	lines := strings.Split(strings.TrimRight(t.buf.String(), "\n"), "\n")
+	if len(lines) > CommandOutputLines {
+		lines = lines[len(lines)-CommandOutputLines:]
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+)
+
+// TestCommandTemplate checks command templates are split and filled in
+func TestCommandTemplate(t *testing.T) {
+	args, err := splitCommand(`artic minion --scheme "{scheme}/V{schemeVersion}" --read-file {fastq} 'barcode {barcode}'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	args = expandCommand(args, map[string][]string{
+		"{scheme}":        {"scov2"},
+		"{schemeVersion}": {"3"},
+		"{fastq}":         {"a.fastq", "b.fastq"},
+		"{barcode}":       {"1"},
+	})
+	expected := []string{"artic", "minion", "--scheme", "scov2/V3", "--read-file", "a.fastq", "b.fastq", "barcode 1"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("unexpected arguments: %q", args)
+	}
+	if _, err := NewCommandService("test", records.RecordType_sample, nil, `echo "{label}`, "./tmp"); err == nil {
+		t.Fatal("accepted an unterminated quote")
+	}
+	if _, err := NewCommandService("test", records.RecordType_sample, nil, `echo {sample}`, "./tmp"); err == nil {
+		t.Fatal("accepted an unknown placeholder")
+	}
+	if _, err := NewCommandService("test", records.RecordType_sample, nil, `echo {label}`, ""); err == nil {
+		t.Fatal("accepted a missing output directory")
+	}
+}
+
+// TestCommandService checks commands are run and their exit codes are reported
+func TestCommandService(t *testing.T) {
+	defer os.RemoveAll("./tmp_command")
+	reports := make(chan *callbacks.ReportRequest, 10)
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		reports <- r
+		return "", nil
+	})
+	defer SetReporter(nil)
+	service, err := NewCommandService("test command", records.RecordType_sample, nil, `sh -c "echo {label} barcode {barcode} {scheme}; echo $0 >&2; exit $1" {fastq}`, "./tmp_command")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer service.(Watcher).StopWatching()
+	if !service.CheckAccess(context.Background()) || len(service.GetAddress()) != 0 {
+		t.Fatal("command service is not accessible")
+	}
+
+	// run the command for a sample that succeeds and one that fails
+	for _, exitCode := range []string{"0", "3"} {
+		sample := records.InitSample("sample "+exitCode, "run", 2)
+		sample.InputFastqFiles, sample.PrimerScheme = []string{"reads.fastq", exitCode}, "scov2"
+		if _, err := service.SendRequest(context.Background(), sample); err != nil {
+			t.Fatal(err)
+		}
+		r := waitForReport(t, reports)
+		if r.GetState() != callbacks.JobState_running || len(r.GetJobID()) == 0 {
+			t.Fatalf("unexpected start report: %v", r)
+		}
+		r = waitForReport(t, reports)
+		outdir, _ := filepath.Abs("./tmp_command/sample " + exitCode)
+		if !strings.Contains(r.GetMessage(), "sample "+exitCode+" barcode 2 scov2\nreads.fastq") {
+			t.Fatalf("command output missing from report: %v", r.GetMessage())
+		}
+		switch exitCode {
+		case "0":
+			if r.GetState() != callbacks.JobState_complete || r.GetResult() != outdir {
+				t.Fatalf("unexpected completion report: %v", r)
+			}
+		default:
+			if r.GetState() != callbacks.JobState_failed || !strings.Contains(r.GetMessage(), "exit status 3") {
+				t.Fatalf("unexpected failure report: %v", r)
+			}
+		}
+		if _, err := os.Stat(filepath.Join(outdir, CommandLogName)); err != nil {
+			t.Fatal("command log was not written")
+		}
+	}
+
+	// check a label can't put the output outside the output directory, or be taken as an option
+	for _, label := range []string{"../sample 6", "..", "run/sample 6", "-rf"} {
+		if _, err := service.SendRequest(context.Background(), records.InitSample(label, "run", 0)); err == nil {
+			t.Fatalf("command was run for a sample labelled %q", label)
+		}
+	}
+
+	// check an interrupted command is reported as failed
+	service.(Watcher).Watch("sample 4", "1234")
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_failed {
+		t.Fatalf("unexpected report for interrupted command: %v", r)
+	}
+
+	// check a running command can be cancelled
+	sleeper, err := NewCommandService("test sleep", records.RecordType_sample, nil, "sleep 10", "./tmp_command")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sleeper.(Watcher).StopWatching()
+	result, err := sleeper.SendRequest(context.Background(), records.InitSample("sample 5", "run", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetJobID() != result.JobID || result.State != callbacks.JobState_running {
+		t.Fatalf("result does not match the start report: %v %v", result, r)
+	}
+	if err := sleeper.Cancel(context.Background(), "sample 5", result.JobID); err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_failed || !strings.Contains(r.GetMessage(), "cancelled") {
+		t.Fatalf("unexpected report for cancelled command: %v", r)
+	}
+	if err := sleeper.Cancel(context.Background(), "sample 5", result.JobID); err == nil {
+		t.Fatal("cancelled a command that has finished")
+	}
+
+	// check a started command is still returned if it can't be reported, so it isn't run again
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		if r.GetState() == callbacks.JobState_running {
+			return "", fmt.Errorf("store is unavailable")
+		}
+		reports <- r
+		return "", nil
+	})
+	if result, err = service.SendRequest(context.Background(), records.InitSample("sample 7", "run", 0)); err != nil || len(result.JobID) == 0 {
+		t.Fatalf("started command was not returned: %v %v", result, err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_complete || r.GetJobID() != result.JobID {
+		t.Fatalf("unexpected completion report: %v", r)
+	}
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/will-rowe/herald/src/records"
+)
+
+// FlowCellUsage describes how much data a type
+// of flow cell writes while it is sequencing.
+type FlowCellUsage struct {
+	BytesPerHour uint64  // the data written each hour (fast5 and fastq)
+	DefaultHours float32 // the run length used if a run doesn't give one
+}
+
+// FlowCellUsages are the data rates used to estimate the disk
+// space a run needs, keyed by flow cell product code. They
+// are generous estimates for a good flow cell, so that runs
+// aren't started without the room to finish.
+var FlowCellUsages = map[string]FlowCellUsage{
+	"FLO-FLG001": {BytesPerHour: 2e9, DefaultHours: 24},
+	"FLO-MIN106": {BytesPerHour: 10e9, DefaultHours: 72},
+	"FLO-MIN111": {BytesPerHour: 10e9, DefaultHours: 72},
+	"FLO-MIN112": {BytesPerHour: 10e9, DefaultHours: 72},
+	"FLO-PRO002": {BytesPerHour: 50e9, DefaultHours: 72},
+	"FLO-PRO111": {BytesPerHour: 50e9, DefaultHours: 72},
+	"FLO-PRO112": {BytesPerHour: 50e9, DefaultHours: 72},
+}
+
+// DiskSpace describes the free space where
+// the data for a run will be written.
+type DiskSpace struct {
+	Location  string // the MinKNOW file system or local directory that was checked
+	Available uint64 // the bytes available
+	Reserved  uint64 // the bytes MinKNOW needs to stop a run cleanly (0 for local directories)
+}
+
+// DiskSpaceChecker is an optional interface for services
+// that start runs, allowing Herald to check there is room
+// for a run before it is sequenced.
+type DiskSpaceChecker interface {
+	GetDiskSpace(ctx context.Context, run *records.Run) (*DiskSpace, error) // returns the free space where the run will be written
+}
+
+// GetFlowCellTypes returns the flow cell product
+// codes that runs can be estimated for.
+func GetFlowCellTypes() []string {
+	flowCellTypes := make([]string, 0, len(FlowCellUsages))
+	for flowCellType := range FlowCellUsages {
+		flowCellTypes = append(flowCellTypes, flowCellType)
+	}
+	sort.Strings(flowCellTypes)
+	return flowCellTypes
+}
+
+// EstimateRunSize returns the bytes a run is expected to
+// write, from its flow cell type and run length.
+func EstimateRunSize(run *records.Run) (uint64, error) {
+	usage, ok := FlowCellUsages[run.GetFlowCellType()]
+	if !ok {
+		return 0, fmt.Errorf("can't estimate the size of run %v, unknown flow cell type: %q", run.GetMetadata().GetLabel(), run.GetFlowCellType())
+	}
+	hours := run.GetRunHours()
+	if hours <= 0 {
+		hours = usage.DefaultHours
+	}
+	return uint64(float64(usage.BytesPerHour) * float64(hours)), nil
+}
+
+// GetLocalDiskSpace returns the free space for a local
+// directory. The directory doesn't need to exist yet, the
+// nearest existing parent is checked instead.
+func GetLocalDiskSpace(dir string) (*DiskSpace, error) {
+	if len(dir) == 0 {
+		return nil, fmt.Errorf("no directory given to check the disk space of")
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, err := os.Stat(dir); err == nil {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, fmt.Errorf("could not find a directory to check the disk space of")
+		}
+		dir = parent
+	}
+	available, err := getAvailableBytes(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the disk space for %v: %v", dir, err)
+	}
+	return &DiskSpace{
+		Location:  dir,
+		Available: available,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/will-rowe/herald/src/records"
+)
+
+// TestDiskSpace checks run sizes are estimated and local disk space is found
+func TestDiskSpace(t *testing.T) {
+	run := records.InitRun("disk run", "", "", "", "scov2", 3, "")
+	if _, err := EstimateRunSize(run); err == nil {
+		t.Fatal("estimated the size of a run without a flow cell type")
+	}
+	run.FlowCellType = "FLO-MIN106"
+	if size, err := EstimateRunSize(run); err != nil || size != 720e9 {
+		t.Fatalf("unexpected size for the default run length: %d %v", size, err)
+	}
+	run.RunHours = 1.5
+	if size, err := EstimateRunSize(run); err != nil || size != 15e9 {
+		t.Fatalf("unexpected size for a 1.5 hour run: %d %v", size, err)
+	}
+	if flowCellTypes := GetFlowCellTypes(); len(flowCellTypes) != len(FlowCellUsages) || !sort.StringsAreSorted(flowCellTypes) {
+		t.Fatalf("unexpected flow cell types: %v", flowCellTypes)
+	}
+
+	// check the nearest existing directory is used for an output directory that hasn't been made yet
+	defer os.RemoveAll("./tmp_disk")
+	if err := os.MkdirAll("./tmp_disk", 0777); err != nil {
+		t.Fatal(err)
+	}
+	space, err := GetLocalDiskSpace("./tmp_disk/run/fastq_pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dir, _ := filepath.Abs("./tmp_disk"); space.Location != dir || space.Available == 0 {
+		t.Fatalf("unexpected disk space: %+v", space)
+	}
+	if _, err := GetLocalDiskSpace(""); err == nil {
+		t.Fatal("disk space was returned without a directory")
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+// +build !windows
+
+package services
+
+import "syscall"
+
+// getAvailableBytes returns the bytes available
+// to Herald on the file system of a directory.
+func getAvailableBytes(dir string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
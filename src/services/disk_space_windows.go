@@ -0,0 +1,20 @@
+//go:build windows
+// +build windows
+
+package services
+
+import "golang.org/x/sys/windows"
+
+// getAvailableBytes returns the bytes available
+// to Herald on the volume of a directory.
+func getAvailableBytes(dir string) (uint64, error) {
+	dirPtr, err := windows.UTF16PtrFromString(dir)
+	if err != nil {
+		return 0, err
+	}
+	var available, total, free uint64
+	if err := windows.GetDiskFreeSpaceEx(dirPtr, &available, &total, &free); err != nil {
+		return 0, err
+	}
+	return available, nil
+}
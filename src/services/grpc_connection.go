@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Connection holds the settings used to connect
+// to a service offered by a gRPC server.
+type Connection struct {
+	Address    string            // the host:port of the service (empty to use the default)
+	TLS        bool              // connect using TLS
+	CAFile     string            // the PEM CA bundle used to check the server certificate (empty to use the system roots)
+	CertFile   string            // the PEM client certificate, for mutual TLS (optional)
+	KeyFile    string            // the PEM key for the client certificate (optional)
+	ServerName string            // overrides the server name checked against the server certificate (optional)
+	Token      string            // sent with each call as a bearer token (optional)
+	Metadata   map[string]string // extra metadata sent with each call (optional)
+}
+
+// Connector is an optional interface for services
+// that connect to a gRPC server, allowing Herald to
+// set how they connect from the config.
+type Connector interface {
+	SetConnection(connection *Connection) error // sets the connection settings (nil to use the defaults)
+	GetDialOptions() []grpc.DialOption          // returns the options used to dial the server
+}
+
+// grpcConnection is embedded by the services that
+// connect to a gRPC server to implement Connector.
+type grpcConnection struct {
+	connLock    sync.RWMutex
+	connAddress string            // overrides the service address (empty to use the default)
+	connTLS     bool              // true if the connection uses TLS
+	dialOptions []grpc.DialOption // the options used to dial the server (nil for an insecure connection)
+}
+
+// SetConnection will check the connection settings,
+// loading any certificates, and then use them for
+// the next connection to the server.
+func (g *grpcConnection) SetConnection(connection *Connection) error {
+	if connection == nil {
+		connection = &Connection{}
+	}
+	options, err := getDialOptions(connection)
+	if err != nil {
+		return err
+	}
+	g.connLock.Lock()
+	defer g.connLock.Unlock()
+	g.connAddress = connection.Address
+	g.connTLS = connection.TLS
+	g.dialOptions = options
+	return nil
+}
+
+// GetDialOptions returns the options used
+// to dial the server.
+func (g *grpcConnection) GetDialOptions() []grpc.DialOption {
+	g.connLock.RLock()
+	defer g.connLock.RUnlock()
+	if g.dialOptions == nil {
+		return []grpc.DialOption{grpc.WithInsecure()}
+	}
+	return append([]grpc.DialOption{}, g.dialOptions...)
+}
+
+// getConnAddress returns the address set in the
+// connection settings, or the default if none is set.
+func (g *grpcConnection) getConnAddress(defaultAddress string) string {
+	g.connLock.RLock()
+	defer g.connLock.RUnlock()
+	if len(g.connAddress) != 0 {
+		return g.connAddress
+	}
+	return defaultAddress
+}
+
+// usesTLS returns true if the connection uses TLS.
+func (g *grpcConnection) usesTLS() bool {
+	g.connLock.RLock()
+	defer g.connLock.RUnlock()
+	return g.connTLS
+}
+
+// getDialOptions will convert connection
+// settings to gRPC dial options.
+func getDialOptions(connection *Connection) ([]grpc.DialOption, error) {
+	options := []grpc.DialOption{grpc.WithInsecure()}
+	if connection.TLS {
+		tlsConfig, err := getTLSConfig(connection)
+		if err != nil {
+			return nil, err
+		}
+		options = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+	} else if len(connection.CAFile) != 0 || len(connection.CertFile) != 0 || len(connection.ServerName) != 0 {
+		return nil, fmt.Errorf("TLS settings were given but TLS is not enabled")
+	}
+
+	// add the token and metadata to each call
+	md := make(map[string]string)
+	for key, value := range connection.Metadata {
+		md[key] = value
+	}
+	if len(connection.Token) != 0 {
+		md["authorization"] = "Bearer " + connection.Token
+	}
+	if len(md) != 0 {
+		options = append(options, grpc.WithPerRPCCredentials(callMetadata(md)))
+	}
+	return options, nil
+}
+
+// getTLSConfig will load the certificates
+// for a TLS connection.
+func getTLSConfig(connection *Connection) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName: connection.ServerName,
+	}
+	if len(connection.CAFile) != 0 {
+		pem, err := ioutil.ReadFile(connection.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA bundle: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle: %v", connection.CAFile)
+		}
+	}
+	if len(connection.CertFile) != 0 || len(connection.KeyFile) != 0 {
+		if len(connection.CertFile) == 0 || len(connection.KeyFile) == 0 {
+			return nil, fmt.Errorf("a client certificate needs both a certificate and a key file")
+		}
+		cert, err := tls.LoadX509KeyPair(connection.CertFile, connection.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// callMetadata is sent with each call to the server.
+type callMetadata map[string]string
+
+// GetRequestMetadata returns the metadata for a call.
+func (md callMetadata) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return md, nil
+}
+
+// RequireTransportSecurity returns false so that
+// metadata can be sent to services on a local
+// insecure port, such as MinKNOW's local-auth
+// token. Tokens should otherwise be used with TLS.
+func (md callMetadata) RequireTransportSecurity() bool {
+	return false
+}
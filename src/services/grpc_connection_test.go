@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	archer "github.com/will-rowe/archer/pkg/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+	"github.com/will-rowe/herald/src/services/archertest"
+)
+
+// writeCert creates a certificate and key in dir, signed by the parent (or self-signed if parent is nil)
+func writeCert(t *testing.T, dir, name string, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.NotBefore, template.NotAfter = time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// checkMetadata returns an error unless a call has the test token and metadata
+func checkMetadata(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if auth := md.Get("authorization"); len(auth) != 1 || auth[0] != "Bearer test token" {
+		return status.Error(codes.Unauthenticated, "bad token")
+	}
+	if site := md.Get("x-site"); len(site) != 1 || site[0] != "lab 1" {
+		return status.Error(codes.Unauthenticated, "missing site")
+	}
+	return nil
+}
+
+// TestGRPCConnection checks services connect to a TLS server using client certificates and tokens
+func TestGRPCConnection(t *testing.T) {
+	defer os.RemoveAll("./tmp_tls")
+	if err := os.MkdirAll("./tmp_tls/fastq_pass", 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile("./tmp_tls/fastq_pass/reads.fastq", []byte("@r1\nACGT\n+\nIIII\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// create a CA, a server certificate and a client certificate
+	ca, caKey := writeCert(t, "./tmp_tls", "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	writeCert(t, "./tmp_tls", "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "archer.test"},
+		DNSNames:     []string{"archer.test"},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+	writeCert(t, "./tmp_tls", "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "herald"},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	// start a TLS server that requires a client certificate, a token and some metadata
+	serverCert, err := tls.LoadX509KeyPair("./tmp_tls/server.pem", "./tmp_tls/server.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{serverCert},
+			ClientCAs:    clientCAs,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+		})),
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := checkMetadata(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := checkMetadata(stream.Context()); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}),
+	)
+	server := archertest.New()
+	server.SetOutcome(archertest.Outcome{State: archer.State_RUNNING})
+	server.Register(grpcServer)
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+	defer server.Stop()
+
+	reports := make(chan *callbacks.ReportRequest, 10)
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		reports <- r
+		return "", nil
+	})
+	defer SetReporter(nil)
+	service := NewArcherService("tls archer", records.RecordType_run, nil, "127.0.0.1", 1)
+	defer service.(Watcher).StopWatching()
+	connector, ok := service.(Connector)
+	if !ok {
+		t.Fatal("archer service does not implement Connector")
+	}
+	connection := &Connection{
+		Address:    lis.Addr().String(),
+		TLS:        true,
+		CAFile:     "./tmp_tls/ca.pem",
+		CertFile:   "./tmp_tls/client.pem",
+		KeyFile:    "./tmp_tls/client.key",
+		ServerName: "archer.test",
+		Token:      "test token",
+		Metadata:   map[string]string{"x-site": "lab 1"},
+	}
+	run := records.InitRun("tls run", "./tmp_tls", "", "./tmp_tls/fastq_pass", "scov2", 3, "")
+
+	// check the connection is refused without the right settings
+	for _, change := range []func(c Connection) *Connection{
+		func(c Connection) *Connection {
+			c.TLS, c.CAFile, c.CertFile, c.KeyFile, c.ServerName = false, "", "", "", ""
+			return &c
+		},
+		func(c Connection) *Connection { c.CertFile, c.KeyFile = "", ""; return &c },
+		func(c Connection) *Connection { c.ServerName = "other.test"; return &c },
+		func(c Connection) *Connection { c.Token = "wrong token"; return &c },
+		func(c Connection) *Connection { c.Metadata = nil; return &c },
+	} {
+		if err := connector.SetConnection(change(*connection)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.SendRequest(context.Background(), run); err == nil {
+			t.Fatal("request was accepted without the right connection settings")
+		}
+	}
+
+	// check requests are sent and watched over the secure connection
+	if err := connector.SetConnection(connection); err != nil {
+		t.Fatal(err)
+	}
+	if service.GetAddress() != lis.Addr().String() {
+		t.Fatalf("connection address was not used: %v", service.GetAddress())
+	}
+	if _, err := service.SendRequest(context.Background(), run); err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_running {
+		t.Fatalf("unexpected submission report: %v", r)
+	}
+	if err := server.Finish("tls run", archer.State_SUCCESS, "s3://bucket/tls run"); err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_complete {
+		t.Fatalf("unexpected completion report: %v", r)
+	}
+
+	// check the health probe uses the connection settings
+	if result, _ := probe(service, false); !result.Up || result.Method != "grpc" {
+		t.Fatalf("unexpected health probe over TLS: %+v", result)
+	}
+
+	// check bad settings are rejected and the defaults can be restored
+	for _, bad := range []*Connection{
+		{TLS: true, CAFile: "./tmp_tls/missing.pem"},
+		{TLS: true, CAFile: "./tmp_tls/client.key"},
+		{TLS: true, CertFile: "./tmp_tls/client.pem"},
+		{CAFile: "./tmp_tls/ca.pem"},
+	} {
+		if err := connector.SetConnection(bad); err == nil {
+			t.Fatalf("bad connection settings were accepted: %+v", bad)
+		}
+	}
+	if err := connector.SetConnection(nil); err != nil {
+		t.Fatal(err)
+	}
+	if service.GetAddress() != "127.0.0.1:1" {
+		t.Fatalf("default address was not restored: %v", service.GetAddress())
+	}
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// DefaultHealthInterval is how often the health monitor probes the services.
+	DefaultHealthInterval = 30 * time.Second
+
+	// DefaultHealthHistory is the number of probes kept for each service.
+	DefaultHealthHistory = 120
+
+	// HealthProbeTimeout is how long a probe waits for a service to respond.
+	HealthProbeTimeout = 2 * time.Second
+
+	// HealthEventHistory is the number of state changes kept by the health monitor.
+	HealthEventHistory = 100
+)
+
+// HealthState describes whether a service is reachable.
+type HealthState int
+
+const (
+	// HealthUnknown means the service has not been probed yet.
+	HealthUnknown HealthState = iota
+
+	// HealthUp means the service responded to the last probe.
+	HealthUp
+
+	// HealthDown means the service did not respond to the last probe.
+	HealthDown
+)
+
+// String returns the name of the health state.
+func (state HealthState) String() string {
+	switch state {
+	case HealthUp:
+		return "up"
+	case HealthDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON encodes the health state as its name.
+func (state HealthState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(state.String())
+}
+
+// HealthCheck is the result of a single probe.
+type HealthCheck struct {
+	Time    time.Time     `json:"time"`
+	Up      bool          `json:"up"`
+	Latency time.Duration `json:"latency"`
+	Method  string        `json:"method"` // grpc (the gRPC health checking protocol) or access (the service CheckAccess method)
+	Error   string        `json:"error,omitempty"`
+}
+
+// HealthEvent is sent when the state of a service changes.
+type HealthEvent struct {
+	Service string      `json:"service"`
+	From    HealthState `json:"from"`
+	To      HealthState `json:"to"`
+	Time    time.Time   `json:"time"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// ServiceHealth summarises the health history of a service.
+type ServiceHealth struct {
+	Service        string        `json:"service"`
+	State          HealthState   `json:"state"`
+	LastCheck      *HealthCheck  `json:"lastCheck"`
+	Uptime         float64       `json:"uptime"`         // the fraction of the probes in the history that were up
+	AverageLatency time.Duration `json:"averageLatency"` // the average latency of the probes that were up
+	History        []HealthCheck `json:"history"`
+}
+
+// serviceHealth holds the probe history of a service.
+type serviceHealth struct {
+	state   HealthState
+	noGRPC  bool // set once the service is found not to offer the gRPC health checking protocol
+	history []HealthCheck
+}
+
+// HealthMonitor probes the registered services in the
+// background, keeping a history of the probes and
+// sending events when a service goes up or down.
+type HealthMonitor struct {
+	sync.RWMutex
+	interval    time.Duration             // how often to probe the services
+	historySize int                       // the number of probes kept for each service
+	services    map[string]*serviceHealth // the health of each service, keyed by service name
+	events      []HealthEvent             // the most recent state changes
+	subscribers []chan HealthEvent        // receive state changes as they happen
+	stop        chan struct{}             // closed to stop the monitor
+	stopOnce    sync.Once
+	wg          sync.WaitGroup
+}
+
+// NewHealthMonitor returns a health monitor that probes
+// the registered services every interval, keeping the
+// last historySize probes for each service.
+func NewHealthMonitor(interval time.Duration, historySize int) *HealthMonitor {
+	if historySize < 1 {
+		historySize = 1
+	}
+	return &HealthMonitor{
+		interval:    interval,
+		historySize: historySize,
+		services:    make(map[string]*serviceHealth),
+		stop:        make(chan struct{}),
+	}
+}
+
+// Start will probe the services now and then every
+// interval in the background, until Stop is called.
+func (m *HealthMonitor) Start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.CheckNow()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.stop:
+				return
+			case <-ticker.C:
+				m.CheckNow()
+			}
+		}
+	}()
+}
+
+// Stop will stop the background probes and close
+// the subscriber channels.
+func (m *HealthMonitor) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+		m.wg.Wait()
+		m.Lock()
+		defer m.Unlock()
+		for _, subscriber := range m.subscribers {
+			close(subscriber)
+		}
+		m.subscribers = nil
+	})
+}
+
+// Subscribe returns a channel that receives an event
+// each time a service changes state. Events are
+// dropped if the channel is full.
+func (m *HealthMonitor) Subscribe() <-chan HealthEvent {
+	m.Lock()
+	defer m.Unlock()
+	subscriber := make(chan HealthEvent, 16)
+	m.subscribers = append(m.subscribers, subscriber)
+	return subscriber
+}
+
+// CheckNow will probe all the registered services
+// concurrently and wait for the results.
+func (m *HealthMonitor) CheckNow() {
+	var wg sync.WaitGroup
+	for name, service := range GetServices() {
+		wg.Add(1)
+		go func(name string, service Service) {
+			defer wg.Done()
+			m.check(name, service)
+		}(name, service)
+	}
+	wg.Wait()
+}
+
+// IsUp returns true if the service responded to its last
+// probe. A service that has not been probed yet is
+// probed now.
+func (m *HealthMonitor) IsUp(serviceName string) bool {
+	m.RLock()
+	state := HealthUnknown
+	if health, ok := m.services[serviceName]; ok {
+		state = health.state
+	}
+	m.RUnlock()
+	if state != HealthUnknown {
+		return state == HealthUp
+	}
+	service, ok := GetService(serviceName)
+	if !ok {
+		return false
+	}
+	return m.check(serviceName, service).Up
+}
+
+// GetHealth returns a summary of the health history of
+// a service, or nil if it has not been probed.
+func (m *HealthMonitor) GetHealth(serviceName string) *ServiceHealth {
+	m.RLock()
+	defer m.RUnlock()
+	health, ok := m.services[serviceName]
+	if !ok {
+		return nil
+	}
+	return health.summarise(serviceName)
+}
+
+// GetAllHealth returns a summary of the health history
+// of all the registered services, sorted by name. Services
+// that have not been probed yet are in the unknown state.
+func (m *HealthMonitor) GetAllHealth() []*ServiceHealth {
+	m.RLock()
+	defer m.RUnlock()
+	registered := GetServices()
+	summaries := make([]*ServiceHealth, 0, len(registered))
+	for name := range registered {
+		health, ok := m.services[name]
+		if !ok {
+			summaries = append(summaries, &ServiceHealth{Service: name, History: []HealthCheck{}})
+			continue
+		}
+		summaries = append(summaries, health.summarise(name))
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Service < summaries[j].Service
+	})
+	return summaries
+}
+
+// GetEvents returns the most recent state changes, oldest first.
+func (m *HealthMonitor) GetEvents() []HealthEvent {
+	m.RLock()
+	defer m.RUnlock()
+	return append([]HealthEvent{}, m.events...)
+}
+
+// check will probe a service and record the result,
+// sending an event if the state has changed.
+func (m *HealthMonitor) check(name string, service Service) HealthCheck {
+	m.RLock()
+	noGRPC := m.services[name] != nil && m.services[name].noGRPC
+	m.RUnlock()
+	result, grpcUnavailable := probe(service, noGRPC)
+
+	// record the probe
+	m.Lock()
+	defer m.Unlock()
+	health, ok := m.services[name]
+	if !ok {
+		health = &serviceHealth{}
+		m.services[name] = health
+	}
+	if grpcUnavailable {
+		health.noGRPC = true
+	}
+	health.history = append(health.history, result)
+	if len(health.history) > m.historySize {
+		health.history = health.history[len(health.history)-m.historySize:]
+	}
+
+	// send an event if the state has changed
+	newState := HealthDown
+	if result.Up {
+		newState = HealthUp
+	}
+	if newState != health.state {
+		event := HealthEvent{
+			Service: name,
+			From:    health.state,
+			To:      newState,
+			Time:    result.Time,
+			Error:   result.Error,
+		}
+		health.state = newState
+		m.events = append(m.events, event)
+		if len(m.events) > HealthEventHistory {
+			m.events = m.events[len(m.events)-HealthEventHistory:]
+		}
+		for _, subscriber := range m.subscribers {
+			select {
+			case subscriber <- event:
+			default:
+			}
+		}
+	}
+	return result
+}
+
+// probe will check a gRPC service (one implementing Connector)
+// using the gRPC health checking protocol, falling back to the
+// service CheckAccess method if the service doesn't offer it.
+// Other services, such as webhooks and transfers, only use
+// CheckAccess. The second return value is true if the service
+// was found not to offer the gRPC health checking protocol.
+func probe(service Service, skipGRPC bool) (HealthCheck, bool) {
+	start := time.Now()
+	if connector, ok := service.(Connector); ok && !skipGRPC && len(service.GetAddress()) != 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), HealthProbeTimeout)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, service.GetAddress(), connector.GetDialOptions()...)
+		if err == nil {
+			defer conn.Close()
+			resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if err == nil {
+				result := HealthCheck{
+					Time:    start,
+					Up:      resp.GetStatus() == healthpb.HealthCheckResponse_SERVING,
+					Latency: time.Since(start),
+					Method:  "grpc",
+				}
+				if !result.Up {
+					result.Error = resp.GetStatus().String()
+				}
+				return result, false
+			}
+			if status.Code(err) == codes.Unimplemented {
+				skipGRPC = true
+			}
+		}
+	}
+
+	// fall back to the service's own access check
+	ctx, cancel := context.WithTimeout(context.Background(), HealthProbeTimeout)
+	defer cancel()
+	start = time.Now()
+	result := HealthCheck{
+		Time:    start,
+		Up:      service.CheckAccess(ctx),
+		Latency: time.Since(start),
+		Method:  "access",
+	}
+	if !result.Up {
+		result.Error = "service is not accessible"
+	}
+	return result, skipGRPC
+}
+
+// summarise returns a summary of the health history.
+func (health *serviceHealth) summarise(name string) *ServiceHealth {
+	summary := &ServiceHealth{
+		Service: name,
+		State:   health.state,
+		History: append([]HealthCheck{}, health.history...),
+	}
+	if len(health.history) == 0 {
+		return summary
+	}
+	last := health.history[len(health.history)-1]
+	summary.LastCheck = &last
+	up := 0
+	var latency time.Duration
+	for _, check := range health.history {
+		if check.Up {
+			up++
+			latency += check.Latency
+		}
+	}
+	summary.Uptime = float64(up) / float64(len(health.history))
+	if up != 0 {
+		summary.AverageLatency = latency / time.Duration(up)
+	}
+	return summary
+}
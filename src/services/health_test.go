@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/will-rowe/herald/src/records"
+)
+
+// probeService is a service that can be toggled offline
+type probeService struct {
+	name    string
+	address string
+	online  bool
+	sync.Mutex
+}
+
+func (s *probeService) GetServiceName() string            { return s.name }
+func (s *probeService) GetRecordType() records.RecordType { return records.RecordType_sample }
+func (s *probeService) GetAddress() string                { return s.address }
+func (s *probeService) GetDependencies() []string         { return nil }
+func (s *probeService) SendRequest(ctx context.Context, record records.Record) (*Result, error) {
+	return &Result{}, nil
+}
+func (s *probeService) Cancel(ctx context.Context, label, jobID string) error { return nil }
+func (s *probeService) CheckAccess(ctx context.Context) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.online
+}
+func (s *probeService) setOnline(online bool) {
+	s.Lock()
+	s.online = online
+	s.Unlock()
+}
+
+// grpcProbeService is a probeService that connects over gRPC
+type grpcProbeService struct {
+	probeService
+}
+
+func (s *grpcProbeService) SetConnection(connection *Connection) error { return nil }
+func (s *grpcProbeService) GetDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithInsecure()}
+}
+
+// TestHealthMonitorGRPC checks the monitor uses the gRPC health checking protocol
+func TestHealthMonitorGRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	healthServer := health.NewServer()
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	// the access check reports offline, so the service is only up if the gRPC probe is used
+	service := &grpcProbeService{probeService{name: "test grpc health", address: lis.Addr().String()}}
+	if err := RegisterService(service); err != nil {
+		t.Fatal(err)
+	}
+	defer DeregisterService(service.name)
+
+	// a service that doesn't connect over gRPC isn't sent the gRPC probe, even if it has an address
+	other := &probeService{name: "test webhook health", address: lis.Addr().String(), online: true}
+	if err := RegisterService(other); err != nil {
+		t.Fatal(err)
+	}
+	defer DeregisterService(other.name)
+	monitor := NewHealthMonitor(time.Hour, 3)
+	events := monitor.Subscribe()
+	monitor.CheckNow()
+	if check := monitor.GetHealth(other.name).LastCheck; check.Method != "access" {
+		t.Fatalf("expected an access probe for a service that isn't a Connector, got %v", check.Method)
+	}
+	if !monitor.IsUp(service.name) {
+		t.Fatal("service offering the gRPC health protocol was not reported as up")
+	}
+	if check := monitor.GetHealth(service.name).LastCheck; check.Method != "grpc" {
+		t.Fatalf("expected a grpc probe, got %v", check.Method)
+	}
+	if event := nextEvent(t, events, service.name); event.From != HealthUnknown || event.To != HealthUp {
+		t.Fatalf("unexpected event: %v", event)
+	}
+
+	// set the service to not serving and check it goes down
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	monitor.CheckNow()
+	if monitor.IsUp(service.name) {
+		t.Fatal("service that is not serving was reported as up")
+	}
+	if event := nextEvent(t, events, service.name); event.From != HealthUp || event.To != HealthDown {
+		t.Fatalf("unexpected event: %v", event)
+	}
+	monitor.Stop()
+	for range events {
+	}
+}
+
+// TestHealthMonitorFallback checks the monitor falls back to the access check and keeps a history
+func TestHealthMonitorFallback(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	go server.Serve(lis)
+	defer server.Stop()
+
+	// a gRPC server without the health service is checked with the access check
+	service := &grpcProbeService{probeService{name: "test access health", address: lis.Addr().String(), online: true}}
+	if err := RegisterService(service); err != nil {
+		t.Fatal(err)
+	}
+	defer DeregisterService(service.name)
+	monitor := NewHealthMonitor(time.Hour, 3)
+	defer monitor.Stop()
+	if !monitor.IsUp(service.name) {
+		t.Fatal("accessible service was not reported as up")
+	}
+	if check := monitor.GetHealth(service.name).LastCheck; check.Method != "access" {
+		t.Fatalf("expected an access probe, got %v", check.Method)
+	}
+	service.setOnline(false)
+	monitor.CheckNow()
+	monitor.CheckNow()
+	monitor.CheckNow()
+
+	// check the history is capped and the uptime reflects it
+	summary := monitor.GetHealth(service.name)
+	if len(summary.History) != 3 || summary.State != HealthDown || summary.Uptime != 0 {
+		t.Fatalf("unexpected health summary: %+v", summary)
+	}
+	service.setOnline(true)
+	monitor.CheckNow()
+	summary = monitor.GetHealth(service.name)
+	if summary.State != HealthUp || summary.Uptime < 0.3 || summary.Uptime > 0.34 {
+		t.Fatalf("unexpected health summary: %+v", summary)
+	}
+	changes := 0
+	for _, event := range monitor.GetEvents() {
+		if event.Service == service.name {
+			changes++
+		}
+	}
+	if changes != 3 {
+		t.Fatalf("expected 3 state changes, got %d", changes)
+	}
+}
+
+// nextEvent returns the next event for a service, or fails the test
+func nextEvent(t *testing.T, events <-chan HealthEvent, serviceName string) HealthEvent {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatal("subscriber channel was closed")
+			}
+			if event.Service == serviceName {
+				return event
+			}
+		case <-timeout:
+			t.Fatalf("no event for %v", serviceName)
+		}
+	}
+}
@@ -1,13 +1,75 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/minknow/acquisition"
+	"github.com/will-rowe/herald/src/minknow/instance"
+	"github.com/will-rowe/herald/src/minknow/manager"
+	"github.com/will-rowe/herald/src/minknow/protocol"
 	"github.com/will-rowe/herald/src/records"
 )
 
+var (
+	// MinknowTimeout is how long to wait for MinKNOW to respond
+	// to the calls Herald makes outside of service requests.
+	MinknowTimeout = 5 * time.Second
+
+	// AcquisitionSnapshotInterval is how often the progress
+	// of an acquisition is sent while it is running.
+	AcquisitionSnapshotInterval = time.Minute
+
+	// ErrPositionNotFound is returned if a MinKNOW doesn't
+	// offer the requested flow cell position.
+	ErrPositionNotFound = errors.New("flow cell position not found")
+)
+
+// MinknowPosition describes a flow cell position
+// offered by a MinKNOW manager (e.g. one of the
+// five positions on a GridION).
+type MinknowPosition struct {
+	Service      string `json:"service"`         // the name of the MinKNOW service offering the position
+	Name         string `json:"name"`            // the position name (e.g. X1 or MN12345)
+	State        string `json:"state"`           // the position state (e.g. running or hardware error)
+	SecurePort   uint32 `json:"securePort"`      // the port offering gRPC over TLS (0 if not running)
+	InsecurePort uint32 `json:"insecurePort"`    // the port offering gRPC without TLS (0 if not running)
+	Address      string `json:"address"`         // the address Herald uses for the position (empty if not running)
+	Error        string `json:"error,omitempty"` // describes the error state of the position
+}
+
+// PositionLister is an optional interface for
+// services that offer flow cell positions.
+type PositionLister interface {
+	ListPositions(ctx context.Context) ([]*MinknowPosition, error) // returns the flow cell positions, sorted by name
+}
+
+// AcquisitionWatcher is an optional interface for services
+// that can follow the data being acquired on a position.
+type AcquisitionWatcher interface {
+	WatchAcquisition(ctx context.Context, position string, snapshots chan<- *records.AcquisitionSnapshot) error // sends the progress of the acquisitions on a position until ctx is done
+}
+
+// ProtocolRunLister is an optional interface for services
+// that keep a history of the protocols run on their positions.
+type ProtocolRunLister interface {
+	ListProtocolRuns(ctx context.Context) ([]*records.MinknowProtocolRun, error) // returns the protocol runs on each position, oldest first
+}
+
 // minknowService is an adapter to submit requests
 // from Herald to a Minknow service.
 type minknowService struct {
@@ -15,7 +77,8 @@ type minknowService struct {
 	recordType records.RecordType // the type of Herald record this service operates on (sun or rample)
 	dependsOn  []string           // the other services that should have completed prior to this one being contacted
 	address    string             // the gRPC address of the service
-	port       int                // the gRPC port the service is accepting requests on
+	port       int                // the gRPC port the manager is accepting requests on
+	grpcConnection
 }
 
 // NewMinknowService will construct a
@@ -44,21 +107,16 @@ func (m *minknowService) GetRecordType() records.RecordType {
 	return m.recordType
 }
 
-// GetAddress will return the address of
-// the gRPC server running the service.
+// GetAddress will return the address of the
+// gRPC server running the MinKNOW manager.
 func (m *minknowService) GetAddress() string {
-	return fmt.Sprintf("%v:%d", m.address, m.port)
+	return m.getConnAddress(fmt.Sprintf("%v:%d", m.address, m.port))
 }
 
 // CheckAccess returns true if the service is
 // accessible.
-func (m *minknowService) CheckAccess() bool {
-	conn, err := net.DialTimeout("tcp", m.GetAddress(), time.Second)
-	if err != nil {
-		return false
-	}
-	conn.Close()
-	return true
+func (m *minknowService) CheckAccess(ctx context.Context) bool {
+	return checkTCP(ctx, m.GetAddress())
 }
 
 // GetDependencies will return a slice
@@ -67,13 +125,436 @@ func (m *minknowService) GetDependencies() []string {
 	return m.dependsOn
 }
 
-// SendRequest will establish a minknow client, formulate
-// a request and submit it to the running service.
-func (m *minknowService) SendRequest(record interface{}) error {
+// SendRequest will get the MinKNOW position for a run ready
+// for sequencing, setting the sample ID to the run label and
+// adding context info that links the protocol back to the
+// Herald record. MinKNOW only takes a protocol group ID when
+// a protocol is started, so it is added to the context info
+// for whoever starts the protocol.
+func (m *minknowService) SendRequest(ctx context.Context, record records.Record) (*Result, error) {
+
+	// assert we have a Run, not a Sample
+	run, ok := record.(*records.Run)
+	if !ok {
+		return nil, fmt.Errorf("can't submit %T in a MinKNOW request, need a Run", record)
+	}
+	label := run.GetMetadata().GetLabel()
+	position := run.GetMinknowPosition()
+	if len(position) == 0 {
+		return nil, fmt.Errorf("no MinKNOW position is set for run %v", label)
+	}
+
+	// connect to the position
+	address, err := m.getPositionAddress(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.DialContext(ctx, address, m.GetDialOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protocol.NewProtocolServiceClient(conn)
+
+	// set the sample ID and add to the existing context info
+	if _, err := client.SetSampleId(ctx, &protocol.SetSampleIdRequest{SampleId: label}); err != nil {
+		return nil, fmt.Errorf("could not set the sample ID for %v: %v", label, err)
+	}
+	existing, err := client.GetContextInfo(ctx, &protocol.GetContextInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get the context info for %v: %v", label, err)
+	}
+	contextInfo := make(map[string]string)
+	for key, value := range existing.GetContextInfo() {
+		contextInfo[key] = value
+	}
+	for key, value := range getContextInfo(run) {
+		contextInfo[key] = value
+	}
+	if _, err := client.SetContextInfo(ctx, &protocol.SetContextInfoRequest{ContextInfo: contextInfo}); err != nil {
+		return nil, fmt.Errorf("could not set the context info for %v: %v", label, err)
+	}
+	result := &Result{
+		State:   callbacks.JobState_complete,
+		Message: fmt.Sprintf("MinKNOW position %v is set up for sample ID %v", position, label),
+		Output:  position,
+	}
+
+	// the position has been set up, so the result is returned even if the report fails
+	if err := report(&callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: m.name,
+		RecordType:  m.recordType.String(),
+		State:       result.State,
+		Message:     result.Message,
+		Result:      result.Output,
+	}); err != nil {
+		log.Printf("%v: %v", m.name, err)
+	}
+	return result, nil
+}
+
+// getContextInfo returns the context info
+// that links MinKNOW to a Herald run.
+func getContextInfo(run *records.Run) map[string]string {
+	contextInfo := map[string]string{
+		"herald_run":               run.GetMetadata().GetLabel(),
+		"herald_protocol_group_id": run.GetMetadata().GetLabel(),
+	}
+	if created, err := ptypes.Timestamp(run.GetMetadata().GetCreated()); err == nil {
+		contextInfo["herald_run_created"] = created.UTC().Format(time.RFC3339)
+	}
+	if len(run.GetPrimerScheme()) != 0 {
+		contextInfo["herald_primer_scheme"] = run.GetPrimerScheme()
+		contextInfo["herald_primer_scheme_version"] = strconv.Itoa(int(run.GetSchemeVersion()))
+	}
+	if len(run.GetBarcodeKit()) != 0 {
+		contextInfo["herald_barcode_kit"] = run.GetBarcodeKit()
+	}
+	return contextInfo
+}
+
+// Cancel is not supported by Minknow requests.
+func (m *minknowService) Cancel(ctx context.Context, label, jobID string) error {
+	return ErrCancelNotSupported
+}
+
+// ListPositions will ask the MinKNOW manager for its flow
+// cell positions and the ports each one is offered on.
+// Managers older than MinKNOW 3.6 are asked to list their
+// devices instead.
+func (m *minknowService) ListPositions(ctx context.Context) ([]*MinknowPosition, error) {
+	conn, err := grpc.DialContext(ctx, m.GetAddress(), m.GetDialOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := manager.NewManagerServiceClient(conn)
+	positions, err := m.flowCellPositions(ctx, client)
+	if status.Code(err) == codes.Unimplemented {
+		positions, err = m.listDevices(ctx, client)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not list the MinKNOW positions: %v", err)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		return positions[i].Name < positions[j].Name
+	})
+	return positions, nil
+}
 
-	// TODO: this is yet to be implemented
-	// Minknow is included as a service template.
-	// refer to the Archer service for a complete example.
+// flowCellPositions collects the positions from the
+// manager flow_cell_positions stream.
+func (m *minknowService) flowCellPositions(ctx context.Context, client manager.ManagerServiceClient) ([]*MinknowPosition, error) {
+	stream, err := client.FlowCellPositions(ctx, &manager.FlowCellPositionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	positions := []*MinknowPosition{}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return positions, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, position := range resp.GetPositions() {
+			state := strings.TrimPrefix(position.GetState().String(), "STATE_")
+			positions = append(positions, m.newPosition(position.GetName(), state, position.GetRpcPorts().GetSecure(), position.GetRpcPorts().GetInsecure(), position.GetErrorInfo()))
+		}
+	}
+}
 
-	return nil
+// listDevices collects the positions from the
+// deprecated manager list_devices call.
+func (m *minknowService) listDevices(ctx context.Context, client manager.ManagerServiceClient) ([]*MinknowPosition, error) {
+	resp, err := client.ListDevices(ctx, &manager.ListDevicesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	positions := []*MinknowPosition{}
+	for _, device := range resp.GetActive() {
+		positions = append(positions, m.newPosition(device.GetName(), "running", device.GetPorts().GetSecure(), device.GetPorts().GetInsecureGrpc(), ""))
+	}
+	for _, name := range resp.GetPending() {
+		positions = append(positions, m.newPosition(name, "initialising", 0, 0, ""))
+	}
+	for _, name := range resp.GetInactive() {
+		positions = append(positions, m.newPosition(name, "inactive", 0, 0, ""))
+	}
+	return positions, nil
+}
+
+// newPosition returns a position offered by the service,
+// working out the address to use for it from the manager
+// address and whether the connection uses TLS.
+func (m *minknowService) newPosition(name, state string, securePort, insecurePort uint32, errorInfo string) *MinknowPosition {
+	position := &MinknowPosition{
+		Service:      m.name,
+		Name:         name,
+		State:        strings.ReplaceAll(strings.ToLower(state), "_", " "),
+		SecurePort:   securePort,
+		InsecurePort: insecurePort,
+		Error:        errorInfo,
+	}
+	port := insecurePort
+	if m.usesTLS() {
+		port = securePort
+	}
+	if host, _, err := net.SplitHostPort(m.GetAddress()); err == nil && port != 0 {
+		position.Address = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	return position
+}
+
+// getPositionAddress returns the address to use
+// for a flow cell position.
+func (m *minknowService) getPositionAddress(ctx context.Context, name string) (string, error) {
+	positions, err := m.ListPositions(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, position := range positions {
+		if position.Name != name {
+			continue
+		}
+		if len(position.Address) == 0 {
+			return "", fmt.Errorf("position %v is not available (%v)", name, position.State)
+		}
+		return position.Address, nil
+	}
+	return "", ErrPositionNotFound
+}
+
+// WatchAcquisition will follow the acquisitions on a flow
+// cell position, sending a snapshot of the progress when
+// an acquisition changes state and then every interval
+// while it is running. It returns once ctx is done or the
+// connection to MinKNOW is lost.
+func (m *minknowService) WatchAcquisition(ctx context.Context, position string, snapshots chan<- *records.AcquisitionSnapshot) error {
+	lookupCtx, cancelLookup := context.WithTimeout(ctx, MinknowTimeout)
+	address, err := m.getPositionAddress(lookupCtx, position)
+	cancelLookup()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, m.GetDialOptions()...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := acquisition.NewAcquisitionServiceClient(conn)
+	stream, err := client.WatchCurrentAcquisitionRun(ctx, &acquisition.WatchCurrentAcquisitionRunRequest{})
+	if err != nil {
+		return err
+	}
+
+	// receive the acquisition changes in the background
+	changes := make(chan *acquisition.AcquisitionRunInfo)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			info, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case changes <- info:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	ticker := time.NewTicker(AcquisitionSnapshotInterval)
+	defer ticker.Stop()
+	var current *acquisition.AcquisitionRunInfo
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			return fmt.Errorf("lost the acquisition stream for position %v: %v", position, err)
+		case info := <-changes:
+			changed := current == nil || info.GetRunId() != current.GetRunId() || info.GetState() != current.GetState()
+			current = info
+			if !changed {
+				continue
+			}
+		case <-ticker.C:
+			if current == nil || current.GetState() == acquisition.AcquisitionState_ACQUISITION_COMPLETED {
+				continue
+			}
+			if current, err = client.GetAcquisitionInfo(ctx, &acquisition.GetAcquisitionRunInfoRequest{RunId: current.GetRunId()}); err != nil {
+				return err
+			}
+		}
+		snapshot, err := getAcquisitionSnapshot(ctx, client, current)
+		if err != nil {
+			return err
+		}
+		select {
+		case snapshots <- snapshot:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// getAcquisitionSnapshot will combine the acquisition info
+// with the current progress of MinKNOW into a snapshot.
+func getAcquisitionSnapshot(ctx context.Context, client acquisition.AcquisitionServiceClient, info *acquisition.AcquisitionRunInfo) (*records.AcquisitionSnapshot, error) {
+	snapshot := &records.AcquisitionSnapshot{
+		Time:             ptypes.TimestampNow(),
+		AcquisitionRunID: info.GetRunId(),
+		State:            strings.ToLower(strings.TrimPrefix(info.GetState().String(), "ACQUISITION_")),
+		StartTime:        info.GetStartTime(),
+		ReadCount:        info.GetYieldSummary().GetReadCount(),
+		PassReadCount:    info.GetYieldSummary().GetBasecalledPassReadCount(),
+		FailReadCount:    info.GetYieldSummary().GetBasecalledFailReadCount(),
+		Bases:            info.GetYieldSummary().GetBasecalledBases(),
+	}
+	if info.GetStopReason() != acquisition.AcquisitionStopReason_STOPPED_NOT_SET {
+		snapshot.StopReason = strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(info.GetStopReason().String(), "STOPPED_")), "_", " ")
+	}
+
+	// the raw data progress is only for the current acquisition
+	if info.GetState() != acquisition.AcquisitionState_ACQUISITION_COMPLETED {
+		progress, err := client.GetProgress(ctx, &acquisition.GetProgressRequest{})
+		if err != nil {
+			return nil, err
+		}
+		snapshot.SamplesAcquired = progress.GetRawPerChannel().GetAcquired()
+		snapshot.SamplesProcessed = progress.GetRawPerChannel().GetProcessed()
+	}
+	return snapshot, nil
+}
+
+// ListProtocolRuns will ask each running flow cell position
+// for the protocols it has run. A position that can't be
+// reached is skipped, an error is only returned if none of
+// the running positions could be reached.
+func (m *minknowService) ListProtocolRuns(ctx context.Context) ([]*records.MinknowProtocolRun, error) {
+	positions, err := m.ListPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protocolRuns := []*records.MinknowProtocolRun{}
+	var lastErr error
+	reached := 0
+	for _, position := range positions {
+		if len(position.Address) == 0 {
+			continue
+		}
+		positionRuns, err := m.listPositionProtocolRuns(ctx, position)
+		if err != nil {
+			lastErr = fmt.Errorf("could not list the protocol runs for position %v: %v", position.Name, err)
+			continue
+		}
+		reached++
+		protocolRuns = append(protocolRuns, positionRuns...)
+	}
+	if reached == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	sort.SliceStable(protocolRuns, func(i, j int) bool {
+		return protocolRuns[i].GetStartTime().AsTime().Before(protocolRuns[j].GetStartTime().AsTime())
+	})
+	return protocolRuns, nil
+}
+
+// listPositionProtocolRuns gets the info for each
+// protocol run on a flow cell position.
+func (m *minknowService) listPositionProtocolRuns(ctx context.Context, position *MinknowPosition) ([]*records.MinknowProtocolRun, error) {
+	conn, err := grpc.DialContext(ctx, position.Address, m.GetDialOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protocol.NewProtocolServiceClient(conn)
+	resp, err := client.ListProtocolRuns(ctx, &protocol.ListProtocolRunsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	protocolRuns := []*records.MinknowProtocolRun{}
+	for _, runID := range resp.GetRunIds() {
+		info, err := client.GetRunInfo(ctx, &protocol.GetRunInfoRequest{RunId: runID})
+		if err != nil {
+			return nil, err
+		}
+		protocolRuns = append(protocolRuns, &records.MinknowProtocolRun{
+			ProtocolRunID:       info.GetRunId(),
+			ProtocolID:          info.GetProtocolId(),
+			Position:            position.Name,
+			OutputPath:          info.GetOutputPath(),
+			State:               strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(info.GetState().String(), "PROTOCOL_")), "_", " "),
+			StartTime:           info.GetStartTime(),
+			EndTime:             info.GetEndTime(),
+			FlowCellID:          info.GetFlowCell().GetFlowCellId(),
+			FlowCellProductCode: info.GetFlowCell().GetProductCode(),
+			DeviceID:            info.GetDevice().GetDeviceId(),
+			DeviceType:          strings.ToLower(info.GetDevice().GetDeviceType().String()),
+			SampleID:            info.GetUserInfo().GetSampleId().GetValue(),
+			ProtocolGroupID:     info.GetUserInfo().GetProtocolGroupId().GetValue(),
+			AcquisitionRunIDs:   info.GetAcquisitionRunIds(),
+		})
+	}
+	return protocolRuns, nil
+}
+
+// GetDiskSpace will ask MinKNOW how much space is left on the
+// file system it writes reads to for the run's position. If
+// the run has no position, or MinKNOW can't be reached, the
+// run output directory is checked locally instead.
+func (m *minknowService) GetDiskSpace(ctx context.Context, run *records.Run) (*DiskSpace, error) {
+	if len(run.GetMinknowPosition()) != 0 {
+		space, err := m.getMinknowDiskSpace(ctx, run.GetMinknowPosition())
+		if err == nil || len(run.GetOutputDirectory()) == 0 {
+			return space, err
+		}
+	}
+	return GetLocalDiskSpace(run.GetOutputDirectory())
+}
+
+// getMinknowDiskSpace returns the space on the file system
+// that MinKNOW writes reads to for a position, or the
+// fullest file system if MinKNOW doesn't say where reads go.
+func (m *minknowService) getMinknowDiskSpace(ctx context.Context, position string) (*DiskSpace, error) {
+	address, err := m.getPositionAddress(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.DialContext(ctx, address, m.GetDialOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	resp, err := instance.NewInstanceServiceClient(conn).GetDiskSpaceInfo(ctx, &instance.GetDiskSpaceInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get the disk space for position %v: %v", position, err)
+	}
+	var chosen *instance.FilesystemDiskSpaceInfo
+	for _, info := range resp.GetFilesystemDiskSpaceInfo() {
+		if chosen == nil || info.GetBytesAvailable() < chosen.GetBytesAvailable() {
+			chosen = info
+		}
+	}
+	for _, info := range resp.GetFilesystemDiskSpaceInfo() {
+		for _, what := range info.GetWhat() {
+			if what == "reads" {
+				chosen = info
+			}
+		}
+	}
+	if chosen == nil {
+		return nil, fmt.Errorf("MinKNOW gave no disk space info for position %v", position)
+	}
+	return &DiskSpace{
+		Location:  fmt.Sprintf("%v (%v)", chosen.GetFilesystemId(), position),
+		Available: chosen.GetBytesAvailable(),
+		Reserved:  chosen.GetBytesToStopCleanly(),
+	}, nil
 }
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/minknow/acquisition"
+	"github.com/will-rowe/herald/src/minknow/device"
+	"github.com/will-rowe/herald/src/minknow/instance"
+	"github.com/will-rowe/herald/src/minknow/manager"
+	"github.com/will-rowe/herald/src/minknow/minknowtest"
+	"github.com/will-rowe/herald/src/minknow/protocol"
+	"github.com/will-rowe/herald/src/records"
+)
+
+// startFakeMinknow starts a fake MinKNOW offering the named positions, and returns a MinKNOW service connected to it
+func startFakeMinknow(t *testing.T, names ...string) (*minknowtest.Server, Service) {
+	fake, err := minknowtest.NewServer("", names...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fake, NewMinknowService("test minknow", records.RecordType_run, nil, "127.0.0.1", fake.Port())
+}
+
+// TestMinknowPositions checks the flow cell positions are listed by the manager
+func TestMinknowPositions(t *testing.T) {
+	fake, service := startFakeMinknow(t, "X2", "X1", "X3")
+	defer fake.Stop()
+	fake.Position("X3").SetState(manager.FlowCellPosition_STATE_HARDWARE_ERROR, "no flow cell")
+	positions, err := service.(PositionLister).ListPositions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 3 {
+		t.Fatalf("expected 3 positions, got %d", len(positions))
+	}
+	if p := positions[0]; p.Name != "X1" || p.State != "running" || p.Address != fake.Position("X1").Address() || p.Service != "test minknow" {
+		t.Fatalf("unexpected position: %+v", p)
+	}
+	if p := positions[2]; p.Name != "X3" || p.State != "hardware error" || p.Address != "" || p.Error != "no flow cell" {
+		t.Fatalf("unexpected position: %+v", p)
+	}
+
+	// check the secure port is used over TLS
+	service.(*minknowService).connTLS = true
+	if p := service.(*minknowService).newPosition("X1", "running", 8000, 8001, ""); p.Address != "127.0.0.1:8000" {
+		t.Fatalf("secure port was not used over TLS: %+v", p)
+	}
+	service.(*minknowService).connTLS = false
+
+	// check older managers are asked for their devices
+	fake.SetLegacy(true)
+	if positions, err = service.(PositionLister).ListPositions(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 3 || positions[0].Name != "X1" || positions[0].Address != fake.Position("X1").Address() || positions[2].State != "inactive" {
+		t.Fatalf("unexpected positions from list_devices: %+v %+v", positions[0], positions[2])
+	}
+
+	// check an offline manager is an error
+	fake.Stop()
+	if _, err := service.(PositionLister).ListPositions(context.Background()); err == nil {
+		t.Fatal("positions were listed from an offline manager")
+	}
+}
+
+// waitForSnapshot returns the next snapshot, or fails the test
+func waitForSnapshot(t *testing.T, snapshots chan *records.AcquisitionSnapshot) *records.AcquisitionSnapshot {
+	select {
+	case snapshot := <-snapshots:
+		return snapshot
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for snapshot")
+	}
+	return nil
+}
+
+// TestMinknowAcquisition checks the progress of the acquisitions on a position is followed
+func TestMinknowAcquisition(t *testing.T) {
+	defer func(interval time.Duration) { AcquisitionSnapshotInterval = interval }(AcquisitionSnapshotInterval)
+	AcquisitionSnapshotInterval = 20 * time.Millisecond
+	fake, service := startFakeMinknow(t, "X1", "X3")
+	defer fake.Stop()
+	fake.Position("X3").SetState(manager.FlowCellPosition_STATE_HARDWARE_ERROR, "no flow cell")
+	watcher := service.(AcquisitionWatcher)
+	snapshots := make(chan *records.AcquisitionSnapshot, 10)
+	if err := watcher.WatchAcquisition(context.Background(), "X9", snapshots); err != ErrPositionNotFound {
+		t.Fatalf("expected an unknown position to be an error, got %v", err)
+	}
+	if err := watcher.WatchAcquisition(context.Background(), "X3", snapshots); err == nil {
+		t.Fatal("watched a position that is not running")
+	}
+
+	// the stream starts with the last acquisition, which has finished
+	_, played := fake.Position("X1").Play(&minknowtest.Script{Steps: []minknowtest.Step{{State: acquisition.AcquisitionState_ACQUISITION_COMPLETED, StopReason: acquisition.AcquisitionStopReason_STOPPED_USER_REQUESTED}}})
+	<-played
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.WatchAcquisition(ctx, "X1", snapshots)
+	}()
+	if snapshot := waitForSnapshot(t, snapshots); snapshot.GetAcquisitionRunID() != "x1-acquisition-1" || snapshot.GetState() != "completed" || snapshot.GetStopReason() != "user requested" || snapshot.GetSamplesAcquired() != 0 {
+		t.Fatalf("unexpected snapshot: %v", snapshot)
+	}
+
+	// check a running acquisition is sent straight away and then every interval, until it ends
+	fake.Position("X1").Play(&minknowtest.Script{Steps: []minknowtest.Step{
+		{State: acquisition.AcquisitionState_ACQUISITION_RUNNING, Reads: 10, Bases: 500},
+		{After: 200 * time.Millisecond, State: acquisition.AcquisitionState_ACQUISITION_RUNNING, Reads: 20, PassReads: 15, FailReads: 5, Bases: 9000},
+		{After: 200 * time.Millisecond, State: acquisition.AcquisitionState_ACQUISITION_COMPLETED, StopReason: acquisition.AcquisitionStopReason_STOPPED_PROTOCOL_ENDED, Reads: 30},
+	}})
+	if snapshot := waitForSnapshot(t, snapshots); snapshot.GetAcquisitionRunID() != "x1-acquisition-2" || snapshot.GetState() != "running" || snapshot.GetReadCount() != 10 || snapshot.GetSamplesAcquired() != 500*minknowtest.SamplesPerBase || snapshot.GetSamplesProcessed() != 500*minknowtest.SamplesPerBase {
+		t.Fatalf("unexpected snapshot: %v", snapshot)
+	}
+	intervals := 0
+	for {
+		snapshot := waitForSnapshot(t, snapshots)
+		if snapshot.GetReadCount() == 10 {
+			intervals++
+		}
+		if snapshot.GetReadCount() == 20 {
+			if snapshot.GetPassReadCount() != 15 || snapshot.GetFailReadCount() != 5 || snapshot.GetBases() != 9000 {
+				t.Fatalf("unexpected snapshot: %v", snapshot)
+			}
+			break
+		}
+	}
+	if intervals == 0 {
+		t.Fatal("no snapshots were taken between the changes to the acquisition")
+	}
+	for {
+		snapshot := waitForSnapshot(t, snapshots)
+		if snapshot.GetState() == "completed" {
+			if snapshot.GetReadCount() != 30 || snapshot.GetStopReason() != "protocol ended" {
+				t.Fatalf("unexpected snapshot: %v", snapshot)
+			}
+			break
+		}
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("expected the watch to stop when cancelled, got %v", err)
+	}
+}
+
+// TestMinknowProtocolRuns checks the protocol history is collected from the running positions
+func TestMinknowProtocolRuns(t *testing.T) {
+	started, err := ptypes.TimestampProto(time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ended, err := ptypes.TimestampProto(time.Date(2021, 3, 2, 9, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake, service := startFakeMinknow(t, "X1")
+	defer fake.Stop()
+	for _, info := range []*protocol.ProtocolRunInfo{
+		{
+			RunId:      "later run",
+			State:      protocol.ProtocolState_PROTOCOL_RUNNING,
+			StartTime:  ended,
+			OutputPath: "/data/later",
+		},
+		{
+			RunId:             "first run",
+			ProtocolId:        "sequencing/sequencing_MIN106_DNA:FLO-MIN106:SQK-LSK109",
+			State:             protocol.ProtocolState_PROTOCOL_STOPPED_BY_USER,
+			StartTime:         started,
+			EndTime:           ended,
+			OutputPath:        "/data/first",
+			AcquisitionRunIds: []string{"acq 1", "acq 2"},
+			UserInfo:          &protocol.ProtocolRunUserInfo{SampleId: &wrappers.StringValue{Value: "sample 1"}, ProtocolGroupId: &wrappers.StringValue{Value: "group 1"}},
+			Device:            &device.GetDeviceInfoResponse{DeviceId: "GA10000", DeviceType: device.GetDeviceInfoResponse_GRIDION},
+			FlowCell:          &device.GetFlowCellInfoResponse{FlowCellId: "FAO12345", ProductCode: "FLO-MIN106"},
+		},
+	} {
+		fake.Position("X1").AddProtocolRun(info)
+	}
+	protocolRuns, err := service.(ProtocolRunLister).ListProtocolRuns(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(protocolRuns) != 2 || protocolRuns[1].GetProtocolRunID() != "later run" {
+		t.Fatalf("unexpected protocol runs: %v", protocolRuns)
+	}
+	if r := protocolRuns[0]; r.GetPosition() != "X1" || r.GetState() != "stopped by user" || r.GetOutputPath() != "/data/first" ||
+		r.GetSampleID() != "sample 1" || r.GetProtocolGroupID() != "group 1" || r.GetDeviceID() != "GA10000" || r.GetDeviceType() != "gridion" ||
+		r.GetFlowCellID() != "FAO12345" || r.GetFlowCellProductCode() != "FLO-MIN106" || len(r.GetAcquisitionRunIDs()) != 2 || r.GetEndTime().GetSeconds() != ended.GetSeconds() {
+		t.Fatalf("unexpected protocol run: %v", r)
+	}
+
+	// check an error is returned if no position can be reached
+	fake.Stop()
+	if _, err := service.(ProtocolRunLister).ListProtocolRuns(context.Background()); err == nil {
+		t.Fatal("protocol runs were listed from an offline MinKNOW")
+	}
+}
+
+// TestMinknowRequest checks the sample ID and context info are set on the position for a run
+func TestMinknowRequest(t *testing.T) {
+	reports := make(chan *callbacks.ReportRequest, 10)
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		reports <- r
+		return "", nil
+	})
+	defer SetReporter(nil)
+	fake, service := startFakeMinknow(t, "X1")
+	defer fake.Stop()
+	fake.Position("X1").SetContextInfo(map[string]string{"lab": "lab 1"})
+
+	// check samples and runs without a position are rejected
+	if _, err := service.SendRequest(context.Background(), records.InitSample("sample", "run", 1)); err == nil {
+		t.Fatal("MinKNOW request was sent for a sample")
+	}
+	run := records.InitRun("minknow run", "", "", "", "scov2", 3, "EXP-NBD104")
+	if _, err := service.SendRequest(context.Background(), run); err == nil {
+		t.Fatal("MinKNOW request was sent for a run without a position")
+	}
+	run.MinknowPosition = "X9"
+	if _, err := service.SendRequest(context.Background(), run); err != ErrPositionNotFound {
+		t.Fatalf("expected an unknown position to be an error, got %v", err)
+	}
+
+	// check the position is set up for the run
+	run.MinknowPosition = "X1"
+	result, err := service.SendRequest(context.Background(), run)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.State != callbacks.JobState_complete || result.Output != "X1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_complete || r.GetLabel() != "minknow run" {
+		t.Fatalf("unexpected report: %v", r)
+	}
+	if sampleID := fake.Position("X1").SampleID(); sampleID != "minknow run" {
+		t.Fatalf("sample ID was not set: %v", sampleID)
+	}
+	contextInfo := fake.Position("X1").ContextInfo()
+	for key, value := range map[string]string{
+		"lab":                          "lab 1",
+		"herald_run":                   "minknow run",
+		"herald_protocol_group_id":     "minknow run",
+		"herald_primer_scheme":         "scov2",
+		"herald_primer_scheme_version": "3",
+		"herald_barcode_kit":           "EXP-NBD104",
+	} {
+		if contextInfo[key] != value {
+			t.Fatalf("context info %v was %q, expected %q", key, contextInfo[key], value)
+		}
+	}
+	if _, ok := contextInfo["herald_run_created"]; !ok {
+		t.Fatal("context info is missing the run creation time")
+	}
+}
+
+// TestMinknowDiskSpace checks the disk space for a run is taken from MinKNOW, or the local output directory
+func TestMinknowDiskSpace(t *testing.T) {
+	fake, service := startFakeMinknow(t, "X1")
+	defer fake.Stop()
+	filesystems := []*instance.FilesystemDiskSpaceInfo{
+		{FilesystemId: "/", BytesAvailable: 1e9, What: []string{"logs"}},
+		{FilesystemId: "/data", BytesAvailable: 500e9, BytesToStopCleanly: 5e9, What: []string{"intermediate-files", "reads"}},
+	}
+	fake.Position("X1").SetDiskSpace(filesystems...)
+	checker := service.(DiskSpaceChecker)
+	run := records.InitRun("disk run", ".", "", "", "scov2", 3, "")
+	run.MinknowPosition = "X1"
+	space, err := checker.GetDiskSpace(context.Background(), run)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if space.Location != "/data (X1)" || space.Available != 500e9 || space.Reserved != 5e9 {
+		t.Fatalf("unexpected disk space: %+v", space)
+	}
+
+	// check the fullest file system is used if MinKNOW doesn't say where the reads go
+	filesystems[1].What = nil
+	fake.Position("X1").SetDiskSpace(filesystems...)
+	if space, err = checker.GetDiskSpace(context.Background(), run); err != nil || space.Location != "/ (X1)" {
+		t.Fatalf("unexpected disk space: %+v %v", space, err)
+	}
+
+	// check the output directory is used if MinKNOW can't be reached
+	fake.Stop()
+	if space, err = checker.GetDiskSpace(context.Background(), run); err != nil || space.Reserved != 0 || space.Available == 0 {
+		t.Fatalf("unexpected local disk space: %+v %v", space, err)
+	}
+	run.OutputDirectory = ""
+	if _, err := checker.GetDiskSpace(context.Background(), run); err == nil {
+		t.Fatal("disk space was returned for an offline MinKNOW without an output directory")
+	}
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/plugins"
+	"github.com/will-rowe/herald/src/records"
+)
+
+var (
+	// PluginStartTimeout is how long to wait for a plugin to start and describe its service.
+	PluginStartTimeout = 10 * time.Second
+
+	// PluginRequestTimeout is how long to wait for a plugin to accept a request.
+	PluginRequestTimeout = 30 * time.Second
+
+	// PluginStopTimeout is how long to wait for a plugin to stop before it is killed.
+	PluginStopTimeout = 5 * time.Second
+)
+
+// pluginService is an adapter for a service
+// offered by a plugin executable, which Herald
+// talks to over the plugin protocol.
+type pluginService struct {
+	name       string             // name of the service
+	recordType records.RecordType // the type of Herald record this service operates on (run or sample)
+	dependsOn  []string           // the other services that should have completed prior to this one being contacted
+	cmd        *exec.Cmd          // the running plugin
+	exited     chan struct{}      // closed once the plugin has exited
+	conn       *grpc.ClientConn   // the connection to the plugin
+	client     plugins.PluginClient
+}
+
+// LaunchPlugin will start a plugin executable and ask it
+// to describe its service, returning the Service interface.
+// The plugin is given the environment (as "key=value"
+// strings) on top of the Herald environment. The service
+// must be closed to stop the plugin.
+func LaunchPlugin(path string, env []string) (Service, error) {
+
+	// start the plugin and wait for the handshake
+	handshake := make(chan string, 1)
+	ps := &pluginService{
+		exited: make(chan struct{}),
+	}
+	ps.cmd = exec.Command(path)
+	ps.cmd.Env = append(append(os.Environ(), env...), fmt.Sprintf("%v=%d", plugins.EnvProtocol, plugins.ProtocolVersion))
+	ps.cmd.Stdout = &pluginOutput{plugin: filepath.Base(path), handshake: handshake}
+	ps.cmd.Stderr = &pluginOutput{plugin: filepath.Base(path)}
+	if err := ps.cmd.Start(); err != nil {
+		return nil, err
+	}
+	go func() {
+		ps.cmd.Wait()
+		close(ps.exited)
+	}()
+	var address string
+	var err error
+	select {
+	case line := <-handshake:
+		address, err = plugins.ParseHandshake(line)
+	case <-ps.exited:
+		err = fmt.Errorf("plugin exited before the handshake")
+	case <-time.After(PluginStartTimeout):
+		err = fmt.Errorf("timed out waiting for the plugin handshake")
+	}
+	if err != nil {
+		ps.Close()
+		return nil, err
+	}
+
+	// connect and get the service description
+	if ps.conn, err = grpc.Dial(address, grpc.WithInsecure()); err != nil {
+		ps.Close()
+		return nil, err
+	}
+	ps.client = plugins.NewPluginClient(ps.conn)
+	ctx, cancel := context.WithTimeout(context.Background(), PluginStartTimeout)
+	defer cancel()
+	description, err := ps.client.Describe(ctx, &plugins.DescribeRequest{ProtocolVersion: plugins.ProtocolVersion})
+	if err == nil {
+		err = ps.setDescription(description)
+	}
+	if err != nil {
+		ps.Close()
+		return nil, err
+	}
+	return ps, nil
+}
+
+// setDescription will check the description of the
+// service offered by a plugin and store it.
+func (p *pluginService) setDescription(description *plugins.DescribeResponse) error {
+	if description.GetProtocolVersion() != plugins.ProtocolVersion {
+		return fmt.Errorf("plugin uses protocol version %d, Herald uses %d", description.GetProtocolVersion(), plugins.ProtocolVersion)
+	}
+	if len(description.GetName()) == 0 {
+		return fmt.Errorf("plugin did not give a service name")
+	}
+	recordType, ok := records.RecordType_value[description.GetRecordType()]
+	if !ok {
+		return fmt.Errorf("unsupported record type for %v: %v", description.GetName(), description.GetRecordType())
+	}
+	p.name, p.recordType, p.dependsOn = description.GetName(), records.RecordType(recordType), description.GetDependsOn()
+	return nil
+}
+
+// DiscoverPlugins will launch each executable in a
+// directory as a plugin. It returns the services that
+// started, along with an error for each that didn't. A
+// missing directory is treated as having no plugins.
+func DiscoverPlugins(dir string, env []string) ([]Service, []error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, []error{err}
+	}
+	services := []Service{}
+	errs := []error{}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if info, err := os.Stat(path); err != nil || !isExecutable(info) {
+			continue
+		}
+		service, err := LaunchPlugin(path, env)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not start plugin %v: %v", entry.Name(), err))
+			continue
+		}
+		services = append(services, service)
+	}
+	return services, errs
+}
+
+// isExecutable returns true if a file can be run.
+func isExecutable(info os.FileInfo) bool {
+	if !info.Mode().IsRegular() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(info.Name()), ".exe")
+	}
+	return info.Mode()&0111 != 0
+}
+
+// GetServiceName returns the name of the service.
+func (p *pluginService) GetServiceName() string {
+	return p.name
+}
+
+// GetRecordType returns the record type (sample/run)
+// that the service accepts.
+func (p *pluginService) GetRecordType() records.RecordType {
+	return p.recordType
+}
+
+// GetAddress will return an empty string, as the
+// plugin is run locally and checks its own access.
+func (p *pluginService) GetAddress() string {
+	return ""
+}
+
+// CheckAccess returns true if the plugin is
+// running and says its service is accessible.
+func (p *pluginService) CheckAccess(ctx context.Context) bool {
+	select {
+	case <-p.exited:
+		return false
+	default:
+	}
+	resp, err := p.client.CheckAccess(ctx, &plugins.CheckAccessRequest{})
+	return err == nil && resp.GetAccessible()
+}
+
+// GetDependencies will return a slice
+// of the dependency names.
+func (p *pluginService) GetDependencies() []string {
+	return p.dependsOn
+}
+
+// SendRequest will send the record to the plugin as JSON.
+// The tag is marked complete if the plugin finishes the
+// job during the request, otherwise the plugin is expected
+// to report back via the callback server.
+func (p *pluginService) SendRequest(ctx context.Context, record records.Record) (*Result, error) {
+	label := record.GetMetadata().GetLabel()
+	body, err := records.DumpRecord(record, records.DumpJSON)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, PluginRequestTimeout)
+	defer cancel()
+	resp, err := p.client.SendRequest(ctx, &plugins.SendRequestRequest{
+		Label:      label,
+		RecordType: p.recordType.String(),
+		Record:     []byte(body),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("plugin request for %v failed: %v", label, err)
+	}
+	result := &Result{
+		State:   callbacks.JobState_running,
+		JobID:   resp.GetJobID(),
+		Message: resp.GetMessage(),
+		Output:  resp.GetResult(),
+	}
+	switch {
+	case resp.GetComplete():
+		result.State = callbacks.JobState_complete
+		if len(result.Message) == 0 {
+			result.Message = "plugin finished"
+		}
+	case len(resp.GetJobID()) == 0:
+		return result, nil
+	}
+
+	// the plugin has accepted the job, so the result is returned even if the report fails
+	if err := report(&callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: p.name,
+		RecordType:  p.recordType.String(),
+		State:       result.State,
+		Message:     result.Message,
+		Result:      result.Output,
+		JobID:       result.JobID,
+	}); err != nil {
+		log.Printf("%v: %v", p.name, err)
+	}
+	return result, nil
+}
+
+// Cancel will ask the plugin to cancel a job, plugins
+// that don't implement Cancel return ErrCancelNotSupported.
+func (p *pluginService) Cancel(ctx context.Context, label, jobID string) error {
+	_, err := p.client.Cancel(ctx, &plugins.CancelRequest{Label: label, JobID: jobID})
+	if status.Code(err) == codes.Unimplemented {
+		return ErrCancelNotSupported
+	}
+	return err
+}
+
+// Close will stop the plugin, killing it if it
+// doesn't stop within the PluginStopTimeout.
+func (p *pluginService) Close() error {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	if err := p.cmd.Process.Signal(os.Interrupt); err != nil {
+		p.cmd.Process.Kill()
+	}
+	select {
+	case <-p.exited:
+	case <-time.After(PluginStopTimeout):
+		p.cmd.Process.Kill()
+		<-p.exited
+	}
+	return nil
+}
+
+// pluginOutput logs the output of a plugin, the first
+// line of stdout is passed on as the handshake.
+type pluginOutput struct {
+	plugin    string      // the plugin name, used in the log
+	handshake chan string // receives the first line (nil for stderr)
+	buf       []byte      // holds any incomplete line
+}
+
+// Write will log each complete line of output.
+func (o *pluginOutput) Write(p []byte) (int, error) {
+	o.buf = append(o.buf, p...)
+	for {
+		i := bytes.IndexByte(o.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := strings.TrimSpace(string(o.buf[:i]))
+		o.buf = o.buf[i+1:]
+		if o.handshake != nil {
+			o.handshake <- line
+			o.handshake = nil
+			continue
+		}
+		log.Printf("plugin %v: %v", o.plugin, line)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/plugins"
+	"github.com/will-rowe/herald/src/records"
+)
+
+// testPluginEnv is set when the test binary is started as a plugin
+var testPluginEnv = "HERALD_TEST_PLUGIN"
+
+// testPlugin offers a sample service that completes each request straight away
+type testPlugin struct {
+	plugins.UnimplementedPluginServer
+}
+
+func (p *testPlugin) Describe(ctx context.Context, request *plugins.DescribeRequest) (*plugins.DescribeResponse, error) {
+	return &plugins.DescribeResponse{Name: "test plugin", RecordType: "sample", ProtocolVersion: plugins.ProtocolVersion}, nil
+}
+
+func (p *testPlugin) CheckAccess(ctx context.Context, request *plugins.CheckAccessRequest) (*plugins.CheckAccessResponse, error) {
+	return &plugins.CheckAccessResponse{Accessible: true}, nil
+}
+
+func (p *testPlugin) SendRequest(ctx context.Context, request *plugins.SendRequestRequest) (*plugins.SendRequestResponse, error) {
+	sample := &records.Sample{}
+	if err := jsonpb.UnmarshalString(string(request.GetRecord()), sample); err != nil {
+		return nil, err
+	}
+	return &plugins.SendRequestResponse{Complete: true, Result: fmt.Sprintf("barcode %d", sample.GetBarcode())}, nil
+}
+
+// TestMain lets the test binary act as a plugin, so that the plugins can be launched by the tests
+func TestMain(m *testing.M) {
+	if os.Getenv(testPluginEnv) == "1" {
+		if err := plugins.Serve(&testPlugin{}); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// TestPluginService checks plugins are discovered, described and sent requests
+func TestPluginService(t *testing.T) {
+	defer os.RemoveAll("./tmp_plugins")
+	if err := os.MkdirAll("./tmp_plugins", 0777); err != nil {
+		t.Fatal(err)
+	}
+	reports := make(chan *callbacks.ReportRequest, 10)
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		reports <- r
+		return "", nil
+	})
+	defer SetReporter(nil)
+
+	// set up a plugin directory with the test binary, a file that isn't executable and a program that isn't a plugin
+	testBinary, err := filepath.Abs(os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(testBinary, "./tmp_plugins/test-plugin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile("./tmp_plugins/README", []byte("not a plugin"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile("./tmp_plugins/not-a-plugin", []byte("#!/bin/sh\necho hello\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if services, errs := DiscoverPlugins("./tmp_plugins/missing", nil); len(services) != 0 || len(errs) != 0 {
+		t.Fatal("missing plugin directory was not ignored")
+	}
+	services, errs := DiscoverPlugins("./tmp_plugins", []string{testPluginEnv + "=1"})
+	if len(services) != 1 || len(errs) != 1 {
+		t.Fatalf("expected 1 plugin and 1 error, got %d and %d (%v)", len(services), len(errs), errs)
+	}
+	service := services[0]
+	defer service.(io.Closer).Close()
+	if service.GetServiceName() != "test plugin" || service.GetRecordType() != records.RecordType_sample {
+		t.Fatalf("plugin was not described correctly: %v %v", service.GetServiceName(), service.GetRecordType())
+	}
+	if !service.CheckAccess(context.Background()) {
+		t.Fatal("plugin is not accessible")
+	}
+
+	// send a request
+	if _, err := service.SendRequest(context.Background(), records.InitSample("plugin sample", "plugin run", 7)); err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_complete || r.GetLabel() != "plugin sample" || r.GetResult() != "barcode 7" {
+		t.Fatalf("unexpected report: %v", r)
+	}
+
+	if err := service.Cancel(context.Background(), "plugin sample", ""); err != ErrCancelNotSupported {
+		t.Fatalf("expected cancel to be unsupported, got %v", err)
+	}
+
+	// check the plugin stops
+	if err := service.(io.Closer).Close(); err != nil {
+		t.Fatal(err)
+	}
+	if service.CheckAccess(context.Background()) {
+		t.Fatal("plugin is still accessible after it was closed")
+	}
+}
@@ -1,8 +1,15 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
 
+	"github.com/will-rowe/herald/src/callbacks"
 	"github.com/will-rowe/herald/src/records"
 )
 
@@ -14,9 +21,6 @@ var DefaultArcherVersion string = "1"
 // TODO: add more docs on this...
 func init() {
 
-	// init the process checker
-	ServiceRegister = make(map[string]Service)
-
 	// create the service definitions
 	archerService := NewArcherService("Archer upload", records.RecordType_run, nil, "127.0.0.1", 60742)
 	minknowService := NewMinknowService("Minknow test", records.RecordType_run, nil, "127.0.0.1", 9501)
@@ -27,61 +31,184 @@ func init() {
 
 // Service is an interface that allows Herald to submit requests to a service.
 type Service interface {
-	GetServiceName() string               // returns the name of the service
-	GetRecordType() records.RecordType    // returns the record type (sample/run) that the service accepts
-	GetAddress() string                   // returns the address of the server offering the service
-	CheckAccess() bool                    // returns true if the service is accessible
-	GetDependencies() []string            // retuns service dependencies as a slice of service names
-	SendRequest(record interface{}) error // function to establish a client and submit the service request
+	GetServiceName() string                                                  // returns the name of the service
+	GetRecordType() records.RecordType                                       // returns the record type (sample/run) that the service accepts
+	GetAddress() string                                                      // returns the address of the server offering the service
+	CheckAccess(ctx context.Context) bool                                    // returns true if the service is accessible
+	GetDependencies() []string                                               // retuns service dependencies as a slice of service names
+	SendRequest(ctx context.Context, record records.Record) (*Result, error) // function to establish a client and submit the service request
+	Cancel(ctx context.Context, label, jobID string) error                   // cancels a job the service is running for a record
+}
+
+// Result is returned by a service once it has
+// accepted a request. Any later progress on the
+// job is reported back to Herald separately.
+type Result struct {
+	State   callbacks.JobState // the state of the job when the request returned
+	JobID   string             // the identifier the service has given the job (empty if the service doesn't track jobs)
+	Message string             // a description of what the service did (optional)
+	Output  string             // the job result, such as an output location (optional)
 }
 
-// ServiceRegister is used to register all the
-// available services to the current Herald
-// runtime.
-var ServiceRegister map[string]Service
+// ErrCancelNotSupported is returned by services
+// that can't cancel the jobs they have been sent.
+var ErrCancelNotSupported = errors.New("the service does not support cancelling jobs")
+
+var (
+	serviceRegister     = make(map[string]Service) // the services available to the current Herald runtime
+	serviceRegisterLock sync.RWMutex               // guards the service register
+)
+
+// GetService returns a registered service by name.
+func GetService(serviceName string) (Service, bool) {
+	serviceRegisterLock.RLock()
+	defer serviceRegisterLock.RUnlock()
+	service, ok := serviceRegister[serviceName]
+	return service, ok
+}
+
+// GetServices returns a copy of the service register,
+// so that the services can be used while others are
+// registered or deregistered.
+func GetServices() map[string]Service {
+	serviceRegisterLock.RLock()
+	defer serviceRegisterLock.RUnlock()
+	registered := make(map[string]Service, len(serviceRegister))
+	for name, service := range serviceRegister {
+		registered[name] = service
+	}
+	return registered
+}
+
+// Watcher is an optional interface for services that
+// track the jobs they have been sent, reporting back
+// to Herald until the jobs finish.
+type Watcher interface {
+	Watch(label, jobID string) // start tracking a job for a record
+	StopWatching()             // stop tracking all jobs
+}
+
+// Reporter is used by services to report the progress
+// and outcome of their jobs back to Herald. It returns
+// the status of the record after the update.
+type Reporter func(report *callbacks.ReportRequest) (string, error)
+
+var (
+	reporter     Reporter     // set by the Herald runtime via SetReporter
+	reporterLock sync.RWMutex // guards the reporter
+)
+
+// SetReporter sets the function services use to report
+// back to Herald (nil stops reports being sent).
+func SetReporter(r Reporter) {
+	reporterLock.Lock()
+	defer reporterLock.Unlock()
+	reporter = r
+}
+
+// report will send a report to Herald, if a reporter is set.
+func report(r *callbacks.ReportRequest) error {
+	reporterLock.RLock()
+	send := reporter
+	reporterLock.RUnlock()
+	if send == nil {
+		return fmt.Errorf("no reporter set, can't report on %v for %v", r.GetServiceName(), r.GetLabel())
+	}
+	_, err := send(r)
+	return err
+}
+
+// checkTCP returns true if a TCP connection
+// can be made to an address.
+func checkTCP(ctx context.Context, address string) bool {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// StopWatchers will stop all the registered
+// services that are tracking jobs.
+func StopWatchers() {
+	for _, service := range GetServices() {
+		if watcher, ok := service.(Watcher); ok {
+			watcher.StopWatching()
+		}
+	}
+}
 
 // checkAndRegister will perform a few sanity checks
 // and then register a service.
 func checkAndRegister(services ...Service) {
-
 	for _, service := range services {
-		// check service name isn't taken
-		if name, ok := ServiceRegister[service.GetServiceName()]; ok {
-			panic(fmt.Sprintf("service name already exists: %v", name))
+		if err := RegisterService(service); err != nil {
+			panic(err)
 		}
+	}
+}
+
+// RegisterService will perform a few sanity checks
+// and then register a service at runtime.
+func RegisterService(service Service) error {
+	serviceRegisterLock.Lock()
+	defer serviceRegisterLock.Unlock()
 
-		// check the record type is either sample or run
-		switch service.GetRecordType() {
-		case records.RecordType_run:
-			break
-		case records.RecordType_sample:
-			break
-		default:
-			panic(fmt.Sprintf("unsupported record type: %v", service.GetRecordType()))
+	// check service name isn't taken
+	if name, ok := serviceRegister[service.GetServiceName()]; ok {
+		return fmt.Errorf("service name already exists: %v", name.GetServiceName())
+	}
+
+	// check the record type is either sample or run
+	switch service.GetRecordType() {
+	case records.RecordType_run:
+		break
+	case records.RecordType_sample:
+		break
+	default:
+		return fmt.Errorf("unsupported record type: %v", service.GetRecordType())
+	}
+
+	// check the dependencies
+	for _, depName := range service.GetDependencies() {
+
+		// can't depend on itself
+		if depName == service.GetServiceName() {
+			return fmt.Errorf("service can't depend on itself: %v", depName)
 		}
 
-		// check the dependencies
-		deps := service.GetDependencies()
-		if len(deps) != 0 {
-			for _, depName := range deps {
-
-				// can't depend on itself
-				if depName == service.GetServiceName() {
-					panic("process can't depend on itself")
-				}
-
-				// dependency must already be registered
-				dependency, ok := ServiceRegister[depName]
-				if !ok {
-					panic(fmt.Sprintf("service dependency not registered, make sure to register %v first", depName))
-				}
-				// TODO: resolve the dependencies
-				_ = dependency
-			}
+		// dependency must already be registered
+		dependency, ok := serviceRegister[depName]
+		if !ok {
+			return fmt.Errorf("service dependency not registered, make sure to register %v first", depName)
 		}
+		// TODO: resolve the dependencies
+		_ = dependency
+	}
 
-		// register the service
-		ServiceRegister[service.GetServiceName()] = service
+	// register the service
+	serviceRegister[service.GetServiceName()] = service
+	return nil
+}
+
+// DeregisterService will remove a service from the
+// register, stopping it first if it is tracking jobs
+// and closing it if it can be closed (e.g. plugins). The
+// register isn't held while the service stops, as its jobs
+// may look up services as they report back.
+func DeregisterService(serviceName string) {
+	serviceRegisterLock.Lock()
+	service := serviceRegister[serviceName]
+	delete(serviceRegister, serviceName)
+	serviceRegisterLock.Unlock()
+	if watcher, ok := service.(Watcher); ok {
+		watcher.StopWatching()
+	}
+	if closer, ok := service.(io.Closer); ok {
+		closer.Close()
 	}
 }
 
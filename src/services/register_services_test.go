@@ -8,12 +8,13 @@ import (
 func Test_init(t *testing.T) {
 
 	// check known processes populated
-	if len(ServiceRegister) == 0 {
+	registered := GetServices()
+	if len(registered) == 0 {
 		t.Fatalf("init function did not register any processes")
 	}
 
 	// check that the services are offline
-	for name, service := range ServiceRegister {
+	for name, service := range registered {
 		if service.GetServiceName() == "" {
 			t.Fatal("nameless service found")
 		}
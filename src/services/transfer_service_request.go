@@ -0,0 +1,494 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+)
+
+var (
+	// TransferManifestName is the name of the checksum manifest (in sha256sum format) written to the destination once a transfer is verified.
+	TransferManifestName = "herald-manifest.sha256"
+
+	// TransferStateName is the name of the file that holds the state of a transfer at the destination, so it can be resumed.
+	TransferStateName = ".herald-transfer.json"
+)
+
+// transferState is written to the destination while a
+// transfer is in progress, so it can be resumed.
+type transferState struct {
+	Label   string   `json:"label"`   // the run being transferred
+	Sources []string `json:"sources"` // the run directories being transferred
+}
+
+// transferService is an adapter that copies the
+// output directories of a run to a local or SFTP
+// destination, checking each file with a checksum.
+type transferService struct {
+	name           string   // name of the service
+	dependsOn      []string // the other services that should have completed prior to this one being contacted
+	destination    *url.URL // where to copy the runs (a directory is made for each run)
+	keyFile        string   // the SSH private key for SFTP
+	knownHostsFile string   // the known hosts file for SFTP
+
+	sync.Mutex
+	ctx     context.Context               // cancelled to stop the transfers
+	cancel  context.CancelFunc            // cancels ctx
+	running map[string]context.CancelFunc // cancels each transfer, keyed by run label
+	jobs    sync.WaitGroup                // the transfers in progress
+}
+
+// NewTransferService will construct a new transfer service
+// adaptor and return the Service interface. The destination
+// is either a local directory or an SFTP location, given as
+// sftp://user@host[:port]/path. The key and known hosts
+// files are only used for SFTP and default to those in
+// ~/.ssh if empty.
+func NewTransferService(name string, dependsOn []string, destination, keyFile, knownHostsFile string) (Service, error) {
+	if len(destination) == 0 {
+		return nil, fmt.Errorf("no destination provided for %v", name)
+	}
+	u, err := url.Parse(destination)
+	if err != nil || len(u.Scheme) < 2 {
+		// not a URL (or a Windows drive letter), so treat it as a local path
+		u = &url.URL{Path: destination}
+	}
+	switch u.Scheme {
+	case "", "file":
+		if u.Path, err = filepath.Abs(u.Path); err != nil {
+			return nil, err
+		}
+		u.Scheme = "file"
+	case "sftp":
+		if len(u.Hostname()) == 0 || len(u.User.Username()) == 0 {
+			return nil, fmt.Errorf("SFTP destination for %v must include a user and host: %v", name, destination)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported destination for %v: %v", name, destination)
+	}
+	ts := &transferService{
+		name:           name,
+		dependsOn:      dependsOn,
+		destination:    u,
+		keyFile:        keyFile,
+		knownHostsFile: knownHostsFile,
+		running:        make(map[string]context.CancelFunc),
+	}
+	return ts, nil
+}
+
+// GetServiceName returns the name of the service.
+func (t *transferService) GetServiceName() string {
+	return t.name
+}
+
+// GetRecordType returns the record type (sample/run)
+// that the service accepts.
+func (t *transferService) GetRecordType() records.RecordType {
+	return records.RecordType_run
+}
+
+// GetAddress will return the SFTP location, or
+// an empty string for a local destination.
+func (t *transferService) GetAddress() string {
+	if t.destination.Scheme == "file" {
+		return ""
+	}
+	return t.destination.String()
+}
+
+// CheckAccess returns true if the SFTP server is
+// accessible, or if the local destination (or the
+// directory it will be made in) exists.
+func (t *transferService) CheckAccess(ctx context.Context) bool {
+	if t.destination.Scheme == "file" {
+		for _, dir := range []string{t.destination.Path, filepath.Dir(t.destination.Path)} {
+			if info, err := os.Stat(dir); err == nil && info.IsDir() {
+				return true
+			}
+		}
+		return false
+	}
+	return checkTCP(ctx, sftpHost(t.destination))
+}
+
+// GetDependencies will return a slice
+// of the dependency names.
+func (t *transferService) GetDependencies() []string {
+	return t.dependsOn
+}
+
+// SendRequest will connect to the destination and start
+// copying the output directories of a run in the
+// background. Files that were copied by an earlier
+// attempt are checked and skipped, and partly copied
+// files are resumed. The tag is marked complete, with
+// a manifest of the files, once they are all verified.
+func (t *transferService) SendRequest(ctx context.Context, record records.Record) (*Result, error) {
+
+	// assert we have a Run, not a Sample
+	run, ok := record.(*records.Run)
+	if !ok {
+		return nil, fmt.Errorf("can't submit %T in a transfer request, need a Run", record)
+	}
+	label := run.GetMetadata().GetLabel()
+	if err := checkPathLabel(label); err != nil {
+		return nil, err
+	}
+	state := &transferState{Label: label}
+	for _, dir := range []string{run.GetFast5OutputDirectory(), run.GetFastqOutputDirectory()} {
+		if len(dir) == 0 {
+			continue
+		}
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("run directory for %v not found: %v", label, dir)
+		}
+		state.Sources = append(state.Sources, dir)
+	}
+	if len(state.Sources) == 0 {
+		return nil, fmt.Errorf("no run directories to transfer for %v", label)
+	}
+
+	// connect and save the state, so the transfer can be resumed
+	target, err := t.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stateJSON, err := json.Marshal(state)
+	if err == nil {
+		err = writeTargetFile(target, target.join(label, TransferStateName), stateJSON)
+	}
+	if err != nil {
+		target.close()
+		return nil, err
+	}
+	result := &Result{
+		State:   callbacks.JobState_running,
+		JobID:   label,
+		Message: fmt.Sprintf("transferring to %v", t.getLocation(label)),
+		Output:  t.getLocation(label),
+	}
+	if err := report(&callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: t.name,
+		RecordType:  records.RecordType_run.String(),
+		State:       result.State,
+		Message:     result.Message,
+		JobID:       result.JobID,
+	}); err != nil {
+		target.close()
+		return nil, err
+	}
+	t.start(target, state)
+	return result, nil
+}
+
+// Cancel will stop a transfer, the job is then
+// reported as failed and won't be resumed.
+func (t *transferService) Cancel(ctx context.Context, label, jobID string) error {
+	t.Lock()
+	defer t.Unlock()
+	cancel, ok := t.running[label]
+	if !ok {
+		return fmt.Errorf("no transfer running for %v", label)
+	}
+	cancel()
+	return nil
+}
+
+// Watch will resume a transfer that was in progress when
+// Herald last stopped, using the state saved at the
+// destination. The job identifier is the run label.
+func (t *transferService) Watch(label, jobID string) {
+	t.jobs.Add(1)
+	go func() {
+		defer t.jobs.Done()
+		target, err := t.connect(context.Background())
+		if err == nil {
+			state := &transferState{}
+			if err = readTargetJSON(target, target.join(label, TransferStateName), state); err == nil {
+				t.start(target, state)
+				return
+			}
+			target.close()
+		}
+		t.reportJob(label, nil, fmt.Errorf("could not resume transfer: %v", err))
+	}()
+}
+
+// StopWatching will stop the transfers in progress and
+// wait for them to finish. The transfers are resumed by
+// Watch when Herald restarts.
+func (t *transferService) StopWatching() {
+	t.Lock()
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.ctx, t.cancel = nil, nil
+	t.Unlock()
+	t.jobs.Wait()
+}
+
+// start will run a transfer in the background, unless
+// the run is already being transferred.
+func (t *transferService) start(target transferTarget, state *transferState) {
+	t.Lock()
+	if _, ok := t.running[state.Label]; ok {
+		t.Unlock()
+		target.close()
+		return
+	}
+	if t.ctx == nil {
+		t.ctx, t.cancel = context.WithCancel(context.Background())
+	}
+	stopCtx := t.ctx
+	ctx, cancel := context.WithCancel(stopCtx)
+	t.running[state.Label] = cancel
+	t.jobs.Add(1)
+	t.Unlock()
+	go func() {
+		defer t.jobs.Done()
+		defer cancel()
+		files, err := transfer(ctx, target, state)
+		if err != nil && ctx.Err() != nil {
+			err = fmt.Errorf("cancelled")
+
+			// leave stopped transfers to be resumed, but not cancelled ones
+			if stopCtx.Err() == nil {
+				target.remove(target.join(state.Label, TransferStateName))
+			}
+		}
+		target.close()
+		t.Lock()
+		delete(t.running, state.Label)
+		t.Unlock()
+		if stopCtx.Err() != nil {
+			return
+		}
+		t.reportJob(state.Label, files, err)
+	}()
+}
+
+// reportJob will report a finished transfer back to Herald.
+func (t *transferService) reportJob(label string, files []*callbacks.ManifestFile, err error) {
+	request := &callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: t.name,
+		RecordType:  records.RecordType_run.String(),
+		State:       callbacks.JobState_complete,
+		Message:     fmt.Sprintf("transferred and verified %d files", len(files)),
+		Result:      t.getLocation(label),
+		JobID:       label,
+		Manifest:    files,
+	}
+	if err != nil {
+		request.State, request.Message, request.Manifest = callbacks.JobState_failed, fmt.Sprintf("transfer failed: %v", err), nil
+	}
+	if err := report(request); err != nil {
+		log.Printf("%v: %v", t.name, err)
+	}
+}
+
+// getLocation returns where a run is transferred to.
+func (t *transferService) getLocation(label string) string {
+	if t.destination.Scheme == "file" {
+		return filepath.Join(t.destination.Path, label)
+	}
+	u := *t.destination
+	u.User = url.User(u.User.Username())
+	u.Path = path.Join(u.Path, label)
+	return u.String()
+}
+
+// connect will open the destination.
+func (t *transferService) connect(ctx context.Context) (transferTarget, error) {
+	if t.destination.Scheme == "file" {
+		return &localTarget{root: t.destination.Path}, nil
+	}
+	return dialSFTP(ctx, t.destination, t.keyFile, t.knownHostsFile)
+}
+
+// transfer will copy the run directories to the target,
+// verifying each file and writing a checksum manifest.
+// The state file is removed once the transfer is complete.
+func transfer(ctx context.Context, target transferTarget, state *transferState) ([]*callbacks.ManifestFile, error) {
+	files := []*callbacks.ManifestFile{}
+	for _, source := range state.Sources {
+		base := filepath.Base(source)
+		err := filepath.Walk(source, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			rel, err := filepath.Rel(source, filePath)
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(filepath.Join(base, rel))
+			file, err := copyFile(ctx, target, filePath, target.join(state.Label, rel))
+			if err != nil {
+				return fmt.Errorf("could not transfer %v: %v", filePath, err)
+			}
+			file.Path = rel
+			files = append(files, file)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// write the manifest and clear the state
+	manifest := []byte{}
+	for _, file := range files {
+		manifest = append(manifest, fmt.Sprintf("%v  %v\n", file.GetChecksum(), file.GetPath())...)
+	}
+	if err := writeTargetFile(target, target.join(state.Label, TransferManifestName), manifest); err != nil {
+		return nil, err
+	}
+	if err := target.remove(target.join(state.Label, TransferStateName)); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// copyFile will copy a file to the target, skipping it if an
+// identical copy is already there and resuming it if it is
+// partly copied. The copy is checked against the checksum of
+// the source, and copied from scratch if a resumed copy
+// doesn't match.
+func copyFile(ctx context.Context, target transferTarget, src, dst string) (*callbacks.ManifestFile, error) {
+	checksum, size, err := getChecksum(os.Open(src))
+	if err != nil {
+		return nil, err
+	}
+	file := &callbacks.ManifestFile{Size: size, Checksum: checksum}
+
+	// check for an earlier copy
+	offset := int64(0)
+	if info, err := target.stat(dst); err == nil {
+		switch {
+		case info.Size() == size:
+			if existing, _, err := getChecksum(target.open(dst)); err == nil && existing == checksum {
+				return file, nil
+			}
+		case info.Size() < size:
+			offset = info.Size()
+		}
+	}
+
+	// copy the file, starting again if a resumed copy doesn't match
+	for {
+		if err := copyFrom(ctx, target, src, dst, offset); err != nil {
+			return nil, err
+		}
+		copied, _, err := getChecksum(target.open(dst))
+		if err != nil {
+			return nil, err
+		}
+		if copied == checksum {
+			return file, nil
+		}
+		if offset == 0 {
+			return nil, fmt.Errorf("checksum mismatch after copy")
+		}
+		offset = 0
+	}
+}
+
+// copyFrom will copy a file to the target, starting at an offset.
+func copyFrom(ctx context.Context, target transferTarget, src, dst string, offset int64) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if _, err := in.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	out, err := target.create(dst, offset)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, &contextReader{ctx: ctx, r: bufio.NewReader(in)}); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// getChecksum returns the hex encoded SHA-256 checksum
+// and size of a file, closing the file once read.
+func getChecksum(f io.ReadCloser, err error) (string, int64, error) {
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// writeTargetFile will write a small file to the target.
+func writeTargetFile(target transferTarget, name string, data []byte) error {
+	out, err := target.create(name, 0)
+	if err != nil {
+		return err
+	}
+	if _, err := out.Write(data); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// readTargetJSON will decode a JSON file from the target.
+func readTargetJSON(target transferTarget, name string, v interface{}) error {
+	in, err := target.open(name)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// contextReader stops a copy once its context is cancelled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// Read will read from the underlying reader, unless
+// the context has been cancelled.
+func (cr *contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+)
+
+// startSFTPServer starts an SFTP server that accepts a generated client key, it
+// returns the address along with the client key and known hosts files
+func startSFTPServer(t *testing.T, dir string) (string, string, string) {
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientPub, err := ssh.NewPublicKey(&clientKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if bytes.Equal(key.Marshal(), clientPub.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown key")
+		},
+	}
+	config.AddHostKey(hostSigner)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go serveSFTP(conn, config)
+		}
+	}()
+	t.Cleanup(func() { lis.Close() })
+
+	// write the client key and known hosts
+	keyFile, knownHostsFile := filepath.Join(dir, "id_rsa"), filepath.Join(dir, "known_hosts")
+	key := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(clientKey)})
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		t.Fatal(err)
+	}
+	line := knownhosts.Line([]string{knownhosts.Normalize(lis.Addr().String())}, hostSigner.PublicKey())
+	if err := ioutil.WriteFile(knownHostsFile, []byte(line+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return lis.Addr().String(), keyFile, knownHostsFile
+}
+
+// serveSFTP handles an SSH connection, serving the SFTP subsystem
+func serveSFTP(conn net.Conn, config *ssh.ServerConfig) {
+	_, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(requests)
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if ok {
+					server, err := sftp.NewServer(channel)
+					if err == nil {
+						server.Serve()
+					}
+					channel.Close()
+				}
+			}
+		}()
+	}
+}
+
+// checkTransfer checks that the files in a run have been copied to the destination
+func checkTransfer(t *testing.T, src, dst string, files []*callbacks.ManifestFile) {
+	if len(files) != 3 {
+		t.Fatalf("expected 3 files in the manifest, got %d", len(files))
+	}
+	for _, file := range files {
+		original, err := ioutil.ReadFile(filepath.Join(src, file.GetPath()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		copied, err := ioutil.ReadFile(filepath.Join(dst, file.GetPath()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(original, copied) || file.GetSize() != int64(len(original)) {
+			t.Fatalf("%v was not copied correctly", file.GetPath())
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dst, TransferManifestName)); err != nil {
+		t.Fatal("manifest was not written")
+	}
+	if _, err := os.Stat(filepath.Join(dst, TransferStateName)); err == nil {
+		t.Fatal("transfer state was not removed")
+	}
+}
+
+// TestTransferService checks run data is copied, verified and resumed
+func TestTransferService(t *testing.T) {
+	defer os.RemoveAll("./tmp_transfer")
+	tmpDir, err := filepath.Abs("./tmp_transfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports := make(chan *callbacks.ReportRequest, 10)
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		reports <- r
+		return "", nil
+	})
+	defer SetReporter(nil)
+
+	// set up a run with some data
+	src := filepath.Join(tmpDir, "run")
+	for name, content := range map[string]string{
+		"fast5_pass/barcode01/reads.fast5": "fast5 data",
+		"fastq_pass/barcode01/reads.fastq": "@r1\nACGT\n+\nIIII\n",
+		"fastq_pass/barcode02/reads.fastq": "@r2\nTTTT\n+\nIIII\n",
+	} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(src, name)), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(src, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	run := records.InitRun("transfer run", src, filepath.Join(src, "fast5_pass"), filepath.Join(src, "fastq_pass"), "scov2", 3, "")
+	service, err := NewTransferService("test transfer", nil, filepath.Join(tmpDir, "dest"), "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer service.(Watcher).StopWatching()
+	if !service.CheckAccess(context.Background()) {
+		t.Fatal("local destination is not accessible")
+	}
+	if _, err := service.SendRequest(context.Background(), records.InitSample("sample", "transfer run", 1)); err == nil {
+		t.Fatal("transfer accepted a sample")
+	}
+	escaping := records.InitRun("../transfer run", src, filepath.Join(src, "fast5_pass"), filepath.Join(src, "fastq_pass"), "scov2", 3, "")
+	if _, err := service.SendRequest(context.Background(), escaping); err == nil {
+		t.Fatal("transfer accepted a run label outside the destination")
+	}
+
+	// transfer the run
+	dst := filepath.Join(tmpDir, "dest", "transfer run")
+	if _, err := service.SendRequest(context.Background(), run); err != nil {
+		t.Fatal(err)
+	}
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_running || r.GetJobID() != "transfer run" {
+		t.Fatalf("unexpected start report: %v", r)
+	}
+	r := waitForReport(t, reports)
+	if r.GetState() != callbacks.JobState_complete || r.GetResult() != dst {
+		t.Fatalf("unexpected completion report: %v", r)
+	}
+	checkTransfer(t, src, dst, r.GetManifest())
+	if err := service.Cancel(context.Background(), "transfer run", "transfer run"); err == nil {
+		t.Fatal("cancelled a transfer that has finished")
+	}
+
+	// interrupt the transfer (a partial copy, a partial copy that doesn't match and a corrupt copy) and resume it
+	for name, content := range map[string]string{
+		"fast5_pass/barcode01/reads.fast5": "fast5",
+		"fastq_pass/barcode01/reads.fastq": "@r9",
+		"fastq_pass/barcode02/reads.fastq": "@r2\nAAAA\n+\nIIII\n",
+		TransferStateName:                  `{"label": "transfer run", "sources": ["` + filepath.Join(src, "fast5_pass") + `", "` + filepath.Join(src, "fastq_pass") + `"]}`,
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dst, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	service.(Watcher).Watch("transfer run", "transfer run")
+	r = waitForReport(t, reports)
+	if r.GetState() != callbacks.JobState_complete {
+		t.Fatalf("transfer was not resumed: %v", r)
+	}
+	checkTransfer(t, src, dst, r.GetManifest())
+
+	// transfer the run over SFTP
+	addr, keyFile, knownHostsFile := startSFTPServer(t, tmpDir)
+	sftpDest := filepath.ToSlash(filepath.Join(tmpDir, "sftp"))
+	service, err = NewTransferService("test sftp", nil, fmt.Sprintf("sftp://herald@%v%v", addr, sftpDest), keyFile, knownHostsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer service.(Watcher).StopWatching()
+	if !service.CheckAccess(context.Background()) {
+		t.Fatal("SFTP server is not accessible")
+	}
+	if _, err := service.SendRequest(context.Background(), run); err != nil {
+		t.Fatal(err)
+	}
+	waitForReport(t, reports)
+	r = waitForReport(t, reports)
+	if r.GetState() != callbacks.JobState_complete {
+		t.Fatalf("unexpected SFTP report: %v", r)
+	}
+	checkTransfer(t, src, filepath.Join(tmpDir, "sftp", "transfer run"), r.GetManifest())
+
+	// check unknown servers are rejected
+	if err := ioutil.WriteFile(knownHostsFile, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.SendRequest(context.Background(), run); err == nil {
+		t.Fatal("transfer to an unknown host was allowed")
+	}
+}
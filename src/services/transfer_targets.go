@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SFTPTimeout is how long to wait when connecting to an SFTP server.
+var SFTPTimeout = 10 * time.Second
+
+// transferTarget is a destination that
+// run data can be transferred to.
+type transferTarget interface {
+	join(elem ...string) string                               // returns a path at the destination (the elements are slash separated)
+	stat(name string) (os.FileInfo, error)                    // describes a file at the destination
+	open(name string) (io.ReadCloser, error)                  // opens a file at the destination for reading
+	create(name string, offset int64) (io.WriteCloser, error) // opens a file at the destination for writing from an offset, discarding anything after it
+	remove(name string) error                                 // removes a file at the destination
+	close() error                                             // closes the connection to the destination
+}
+
+// writableFile is satisfied by both local and SFTP files.
+type writableFile interface {
+	io.WriteCloser
+	io.Seeker
+	Truncate(size int64) error
+}
+
+// openAt will truncate a file to an offset and
+// move to the offset, ready to write.
+func openAt(f writableFile, offset int64) (io.WriteCloser, error) {
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// localTarget is a directory on the local filesystem.
+type localTarget struct {
+	root string
+}
+
+func (l *localTarget) join(elem ...string) string {
+	return filepath.Join(append([]string{l.root}, elem...)...)
+}
+
+func (l *localTarget) stat(name string) (os.FileInfo, error) {
+	return os.Stat(name)
+}
+
+func (l *localTarget) open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+func (l *localTarget) create(name string, offset int64) (io.WriteCloser, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0777); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+	return openAt(f, offset)
+}
+
+func (l *localTarget) remove(name string) error {
+	return os.Remove(name)
+}
+
+func (l *localTarget) close() error {
+	return nil
+}
+
+// sftpTarget is a directory on an SFTP server.
+type sftpTarget struct {
+	root   string
+	conn   *ssh.Client
+	client *sftp.Client
+}
+
+// dialSFTP will connect to an SFTP server, checking the
+// server against the known hosts file. A password in
+// the URL is used if given, along with the private key.
+func dialSFTP(ctx context.Context, u *url.URL, keyFile, knownHostsFile string) (*sftpTarget, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	// set up the authentication
+	auth := []ssh.AuthMethod{}
+	if password, ok := u.User.Password(); ok {
+		auth = append(auth, ssh.Password(password))
+	}
+	if len(keyFile) == 0 {
+		keyFile = filepath.Join(home, ".ssh", "id_rsa")
+	}
+	if keyFile, err = homedir.Expand(keyFile); err != nil {
+		return nil, err
+	}
+	if key, err := ioutil.ReadFile(keyFile); err == nil {
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("could not read SSH key %v: %v", keyFile, err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if len(auth) == 0 {
+		return nil, fmt.Errorf("no SSH key or password found for %v", u.Host)
+	}
+
+	// check the server is known
+	if len(knownHostsFile) == 0 {
+		knownHostsFile = filepath.Join(home, ".ssh", "known_hosts")
+	}
+	if knownHostsFile, err = homedir.Expand(knownHostsFile); err != nil {
+		return nil, err
+	}
+	hostKeyCallback, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read known hosts: %v", err)
+	}
+
+	// connect
+	ctx, cancel := context.WithTimeout(ctx, SFTPTimeout)
+	defer cancel()
+	netConn, err := (&net.Dialer{}).DialContext(ctx, "tcp", sftpHost(u))
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		netConn.SetDeadline(deadline)
+	}
+	sshConn, channels, requests, err := ssh.NewClientConn(netConn, sftpHost(u), &ssh.ClientConfig{
+		User:            u.User.Username(),
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	netConn.SetDeadline(time.Time{})
+	conn := ssh.NewClient(sshConn, channels, requests)
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &sftpTarget{root: u.Path, conn: conn, client: client}, nil
+}
+
+// sftpHost returns the host and port of an SFTP URL.
+func sftpHost(u *url.URL) string {
+	if len(u.Port()) == 0 {
+		return net.JoinHostPort(u.Hostname(), "22")
+	}
+	return u.Host
+}
+
+func (s *sftpTarget) join(elem ...string) string {
+	return path.Join(append([]string{s.root}, elem...)...)
+}
+
+func (s *sftpTarget) stat(name string) (os.FileInfo, error) {
+	return s.client.Stat(name)
+}
+
+func (s *sftpTarget) open(name string) (io.ReadCloser, error) {
+	return s.client.Open(name)
+}
+
+func (s *sftpTarget) create(name string, offset int64) (io.WriteCloser, error) {
+	if err := s.client.MkdirAll(path.Dir(name)); err != nil {
+		return nil, err
+	}
+	f, err := s.client.OpenFile(name, os.O_WRONLY|os.O_CREATE)
+	if err != nil {
+		return nil, err
+	}
+	return openAt(f, offset)
+}
+
+func (s *sftpTarget) remove(name string) error {
+	return s.client.Remove(name)
+}
+
+func (s *sftpTarget) close() error {
+	s.client.Close()
+	return s.conn.Close()
+}
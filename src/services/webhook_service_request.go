@@ -0,0 +1,254 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+)
+
+const (
+	// WebhookSignatureHeader is the header that holds the
+	// HMAC-SHA256 signature of the timestamp and request
+	// body, as "sha256=<hex digest>" (see SignWebhook).
+	WebhookSignatureHeader = "X-Herald-Signature"
+
+	// WebhookTimestampHeader is the header that holds the
+	// time the request was sent, in seconds since the Unix
+	// epoch. It is covered by the signature.
+	WebhookTimestampHeader = "X-Herald-Timestamp"
+
+	// WebhookSignatureWindow is how far the timestamp of a
+	// signed request may be from the time it is received
+	// for receivers to accept it, so that a captured
+	// request can't be replayed later.
+	WebhookSignatureWindow = 5 * time.Minute
+
+	// WebhookServiceHeader is the header that holds the
+	// name of the service sending the request.
+	WebhookServiceHeader = "X-Herald-Service"
+
+	// WebhookRecordTypeHeader is the header that holds the
+	// type of record (run or sample) in the request body.
+	WebhookRecordTypeHeader = "X-Herald-Record-Type"
+)
+
+var (
+	// DefaultWebhookTimeout is how long to wait for a webhook to respond, unless set in the config.
+	DefaultWebhookTimeout = 30 * time.Second
+
+	// WebhookRetryWait is how long to wait before the first retry of a webhook, the wait doubles for each retry.
+	WebhookRetryWait = time.Second
+)
+
+// webhookService is an adapter that POSTs
+// the records from Herald to a URL as JSON.
+type webhookService struct {
+	name       string             // name of the service
+	recordType records.RecordType // the type of Herald record this service operates on (run or sample)
+	dependsOn  []string           // the other services that should have completed prior to this one being contacted
+	url        *url.URL           // where to POST the records
+	headers    map[string]string  // extra headers to send with each request
+	secret     []byte             // the key used to sign the requests (nil to not sign)
+	retries    int                // the number of times to retry a request that gets a 5xx response or no response
+	client     *http.Client       // the client, with the timeout set
+}
+
+// NewWebhookService will construct a new webhook service
+// adaptor and return the Service interface. A timeout of
+// 0 uses the DefaultWebhookTimeout.
+func NewWebhookService(name string, recordType records.RecordType, dependsOn []string, webhookURL string, headers map[string]string, secret string, timeout time.Duration, retries int) (Service, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook URL for %v: %v", name, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return nil, fmt.Errorf("webhook URL for %v must be http or https: %v", name, webhookURL)
+	}
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	ws := &webhookService{
+		name:       name,
+		recordType: recordType,
+		dependsOn:  dependsOn,
+		url:        u,
+		headers:    headers,
+		retries:    retries,
+		client:     &http.Client{Timeout: timeout},
+	}
+	if len(secret) != 0 {
+		ws.secret = []byte(secret)
+	}
+	return ws, nil
+}
+
+// GetServiceName returns the name of the service.
+func (w *webhookService) GetServiceName() string {
+	return w.name
+}
+
+// GetRecordType returns the record type (sample/run)
+// that the service accepts.
+func (w *webhookService) GetRecordType() records.RecordType {
+	return w.recordType
+}
+
+// GetAddress will return the webhook URL.
+func (w *webhookService) GetAddress() string {
+	return w.url.String()
+}
+
+// CheckAccess returns true if the webhook
+// host is accessible.
+func (w *webhookService) CheckAccess(ctx context.Context) bool {
+	host := w.url.Host
+	if len(w.url.Port()) == 0 {
+		port := "80"
+		if w.url.Scheme == "https" {
+			port = "443"
+		}
+		host = net.JoinHostPort(w.url.Hostname(), port)
+	}
+	return checkTCP(ctx, host)
+}
+
+// GetDependencies will return a slice
+// of the dependency names.
+func (w *webhookService) GetDependencies() []string {
+	return w.dependsOn
+}
+
+// SendRequest will POST the record to the webhook as JSON,
+// retrying if the webhook doesn't respond or responds with
+// a 5xx status. A 2xx response marks the tag complete,
+// unless it is 202 (Accepted), in which case the receiver
+// is expected to report back via the callback server.
+func (w *webhookService) SendRequest(ctx context.Context, record records.Record) (*Result, error) {
+
+	// render the record
+	label := record.GetMetadata().GetLabel()
+	body, err := records.DumpRecord(record, records.DumpJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// send the request, retrying with a backoff
+	var resp *webhookResponse
+	wait := WebhookRetryWait
+	for attempt := 0; ; attempt++ {
+		resp, err = w.post(ctx, []byte(body))
+		if err == nil && resp.status < 500 {
+			break
+		}
+		if attempt >= w.retries || ctx.Err() != nil {
+			if err == nil {
+				err = fmt.Errorf("webhook returned %v: %v", resp.statusText, resp.body)
+			}
+			return nil, fmt.Errorf("webhook request for %v failed after %d attempts: %v", label, attempt+1, err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+	if resp.status < 200 || resp.status > 299 {
+		return nil, fmt.Errorf("webhook rejected %v with %v: %v", label, resp.statusText, resp.body)
+	}
+	result := &Result{
+		State:   callbacks.JobState_complete,
+		Message: fmt.Sprintf("webhook returned %v", resp.statusText),
+		Output:  resp.body,
+	}
+	if resp.status == http.StatusAccepted {
+		result.State = callbacks.JobState_running
+		return result, nil
+	}
+
+	// the record has been delivered, so the result is returned even if the report fails
+	if err := report(&callbacks.ReportRequest{
+		Label:       label,
+		ServiceName: w.name,
+		RecordType:  w.recordType.String(),
+		State:       result.State,
+		Message:     result.Message,
+		Result:      result.Output,
+	}); err != nil {
+		log.Printf("%v: %v", w.name, err)
+	}
+	return result, nil
+}
+
+// Cancel is not supported by webhooks, as the receiver
+// has the record once the request has been sent.
+func (w *webhookService) Cancel(ctx context.Context, label, jobID string) error {
+	return ErrCancelNotSupported
+}
+
+// webhookResponse holds the parts of a webhook response that Herald uses.
+type webhookResponse struct {
+	status     int    // the status code
+	statusText string // the status, e.g. "200 OK"
+	body       string // the start of the response body
+}
+
+// post will make a single POST request to the webhook.
+func (w *webhookService) post(ctx context.Context, body []byte) (*webhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range w.headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookServiceHeader, w.name)
+	req.Header.Set(WebhookRecordTypeHeader, w.recordType.String())
+	if w.secret != nil {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, SignWebhook(w.secret, timestamp, body))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err != nil {
+		return nil, err
+	}
+	return &webhookResponse{
+		status:     resp.StatusCode,
+		statusText: resp.Status,
+		body:       strings.TrimSpace(string(respBody)),
+	}, nil
+}
+
+// SignWebhook returns the signature of a webhook request, as
+// sent in the WebhookSignatureHeader. The HMAC covers the
+// timestamp and body, joined as "<timestamp>.<body>".
+// Receivers can use it to check a request came from Herald,
+// and should refuse requests whose timestamp is more than
+// WebhookSignatureWindow from their own clock.
+func SignWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
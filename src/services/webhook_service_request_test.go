@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/will-rowe/herald/src/callbacks"
+	"github.com/will-rowe/herald/src/records"
+)
+
+// testWebhook is a webhook receiver that fails a set number of requests before responding with a status
+type testWebhook struct {
+	sync.Mutex
+	failures int           // the number of requests to fail with a 503
+	status   int           // the status to respond with once the failures are used up
+	delay    time.Duration // how long to wait before responding
+	requests int           // the number of requests received
+	headers  http.Header   // the headers of the last request
+	body     string        // the body of the last request
+}
+
+func (s *testWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.Lock()
+	s.requests++
+	s.headers, s.body = r.Header, string(body)
+	fail := s.requests <= s.failures
+	s.Unlock()
+	time.Sleep(s.delay)
+	if fail {
+		http.Error(w, "try again", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(s.status)
+	w.Write([]byte("ticket 42"))
+}
+
+// TestWebhookService checks records are POSTed and signed, and that 5xx responses are retried
+func TestWebhookService(t *testing.T) {
+	WebhookRetryWait = time.Millisecond
+	receiver := &testWebhook{failures: 2, status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+	reports := make(chan *callbacks.ReportRequest, 10)
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		reports <- r
+		return "", nil
+	})
+	defer SetReporter(nil)
+	if _, err := NewWebhookService("test webhook", records.RecordType_sample, nil, "ftp://example.com", nil, "", 0, 0); err == nil {
+		t.Fatal("accepted a non-http webhook")
+	}
+	service, err := NewWebhookService("test webhook", records.RecordType_sample, nil, server.URL+"/herald", map[string]string{"x-api-key": "abc"}, "secret", time.Second, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !service.CheckAccess(context.Background()) {
+		t.Fatal("could not access the test server")
+	}
+
+	// check the request succeeds after the retries and the tag is marked complete
+	if _, err := service.SendRequest(context.Background(), records.InitSample("sample 1", "run", 1)); err != nil {
+		t.Fatal(err)
+	}
+	receiver.Lock()
+	if receiver.requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", receiver.requests)
+	}
+	if !strings.Contains(receiver.body, `"label": "sample 1"`) || receiver.headers.Get("X-Api-Key") != "abc" {
+		t.Fatalf("unexpected request: %v %v", receiver.headers, receiver.body)
+	}
+	timestamp := receiver.headers.Get(WebhookTimestampHeader)
+	if sent, err := strconv.ParseInt(timestamp, 10, 64); err != nil || time.Since(time.Unix(sent, 0)) > WebhookSignatureWindow {
+		t.Fatalf("unexpected request timestamp: %q", timestamp)
+	}
+	if receiver.headers.Get(WebhookSignatureHeader) != SignWebhook([]byte("secret"), timestamp, []byte(receiver.body)) {
+		t.Fatal("request was not signed")
+	}
+	if receiver.headers.Get(WebhookSignatureHeader) == SignWebhook([]byte("secret"), "0", []byte(receiver.body)) {
+		t.Fatal("signature does not cover the timestamp")
+	}
+	if receiver.headers.Get(WebhookRecordTypeHeader) != "sample" || receiver.headers.Get(WebhookServiceHeader) != "test webhook" {
+		t.Fatalf("unexpected Herald headers: %v", receiver.headers)
+	}
+	receiver.Unlock()
+	if r := waitForReport(t, reports); r.GetState() != callbacks.JobState_complete || r.GetResult() != "ticket 42" {
+		t.Fatalf("unexpected report: %v", r)
+	}
+
+	// check requests fail once the retries are used up
+	receiver.Lock()
+	receiver.requests, receiver.failures = 0, 5
+	receiver.Unlock()
+	if _, err := service.SendRequest(context.Background(), records.InitSample("sample 2", "run", 2)); err == nil {
+		t.Fatal("request did not fail after the retries")
+	}
+
+	// check 4xx responses are not retried and 202 responses leave the tag for the receiver to report on
+	for status, fail := range map[int]bool{http.StatusBadRequest: true, http.StatusAccepted: false} {
+		receiver.Lock()
+		receiver.requests, receiver.failures, receiver.status = 0, 0, status
+		receiver.Unlock()
+		_, err := service.SendRequest(context.Background(), records.InitSample("sample 3", "run", 3))
+		if fail != (err != nil) || receiver.requests != 1 {
+			t.Fatalf("unexpected result for %d response: %v (%d requests)", status, err, receiver.requests)
+		}
+	}
+	select {
+	case r := <-reports:
+		t.Fatalf("unexpected report: %v", r)
+	default:
+	}
+
+	// check a delivered request isn't failed (and sent again) if it can't be reported
+	SetReporter(func(r *callbacks.ReportRequest) (string, error) {
+		return "", fmt.Errorf("store is unavailable")
+	})
+	receiver.Lock()
+	receiver.requests, receiver.failures, receiver.status = 0, 0, http.StatusOK
+	receiver.Unlock()
+	if result, err := service.SendRequest(context.Background(), records.InitSample("sample 6", "run", 6)); err != nil || result.State != callbacks.JobState_complete {
+		t.Fatalf("delivered request was not returned: %v %v", result, err)
+	}
+	receiver.Lock()
+	if receiver.requests != 1 {
+		t.Fatalf("expected 1 request, got %d", receiver.requests)
+	}
+	receiver.Unlock()
+
+	// check slow webhooks time out
+	slow := httptest.NewServer(&testWebhook{status: http.StatusOK, delay: 200 * time.Millisecond})
+	defer slow.Close()
+	service, err = NewWebhookService("slow webhook", records.RecordType_sample, nil, slow.URL, nil, "", 50*time.Millisecond, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.SendRequest(context.Background(), records.InitSample("sample 4", "run", 4)); err == nil {
+		t.Fatal("slow webhook did not time out")
+	}
+
+	// check the request deadline is used and that webhooks can't be cancelled
+	service, err = NewWebhookService("slow webhook", records.RecordType_sample, nil, slow.URL, nil, "", time.Second, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := service.SendRequest(ctx, records.InitSample("sample 5", "run", 5)); err == nil {
+		t.Fatal("webhook request did not stop at the deadline")
+	}
+	if err := service.Cancel(context.Background(), "sample 5", ""); err != ErrCancelNotSupported {
+		t.Fatal("webhook job was cancelled")
+	}
+}
@@ -1,13 +1,11 @@
-// Package storage wraps two bit casks as the disk-backed key-value store for sample information and run information
+// Package storage wraps bit casks as the disk-backed key-value store for sample information, run information and the announcement queue
 package storage
 
 import (
-	"bytes"
 	"fmt"
 
-	"github.com/golang/protobuf/jsonpb"
-	"github.com/golang/protobuf/proto"
 	"git.mills.io/prologic/bitcask"
+	"github.com/golang/protobuf/proto"
 
 	"github.com/will-rowe/herald/src/records"
 )
@@ -22,6 +20,7 @@ const useSync = true
 type Storage struct {
 	sampleDB   *bitcask.Bitcask // the key-value store for samples
 	runDB      *bitcask.Bitcask // the key-value store for runs
+	queueDB    *bitcask.Bitcask // the key-value store for queued announcements
 	dbLocation string           // where the store is stored
 }
 
@@ -31,6 +30,7 @@ func OpenStorage(dbLocation string) (*Storage, error) {
 	// get the names for both databases
 	sampleDBname := fmt.Sprintf("%s/sampleCask", dbLocation)
 	runDBname := fmt.Sprintf("%s/runCask", dbLocation)
+	queueDBname := fmt.Sprintf("%s/queueCask", dbLocation)
 
 	// open the databases
 	sdb, err := bitcask.Open(sampleDBname, bitcask.WithSync(useSync))
@@ -41,11 +41,16 @@ func OpenStorage(dbLocation string) (*Storage, error) {
 	if err != nil {
 		return nil, err
 	}
+	qdb, err := bitcask.Open(queueDBname, bitcask.WithSync(useSync))
+	if err != nil {
+		return nil, err
+	}
 
 	// create the storage struct
 	store := &Storage{
 		sampleDB:   sdb,
 		runDB:      edb,
+		queueDB:    qdb,
 		dbLocation: dbLocation,
 	}
 	return store, nil
@@ -65,11 +70,20 @@ func (storage *Storage) CloseStorage() error {
 	if err := storage.runDB.Close(); err != nil {
 		return err
 	}
+	if err := storage.queueDB.Sync(); err != nil {
+		return err
+	}
+	if err := storage.queueDB.Close(); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Wipe clears all entries from the samples and runs databases
+// Wipe clears all entries from the samples, runs and announcement queue databases
 func (storage *Storage) Wipe() error {
+	if err := storage.queueDB.DeleteAll(); err != nil {
+		return err
+	}
 	if err := storage.runDB.DeleteAll(); err != nil {
 		return err
 	}
@@ -96,6 +110,11 @@ func (storage *Storage) GetRunLabels() chan []byte {
 	return storage.runDB.Keys()
 }
 
+// HasRun returns true if a run is held in storage
+func (storage *Storage) HasRun(runName string) bool {
+	return storage.runDB.Has([]byte(runName))
+}
+
 // DeleteSample is a method to remove a sample from storage
 func (storage *Storage) DeleteSample(sampleLabel string) error {
 	return storage.sampleDB.Delete([]byte(sampleLabel))
@@ -192,48 +211,98 @@ func (storage *Storage) GetRun(runName string) (*records.Run, error) {
 	return exp, nil
 }
 
-// GetSampleProtoDump is a method to retrieve a sample from storage and return a string dump of the protobuf message
-func (storage *Storage) GetSampleProtoDump(sampleLabel string) (string, error) {
-
-	// get the sample from the bit cask
-	dbData, err := storage.sampleDB.Get([]byte(sampleLabel))
+// GetSampleDump is a method to retrieve a sample from storage and return a string dump of the protobuf message in the requested format
+func (storage *Storage) GetSampleDump(sampleLabel, format string) (string, error) {
+	sample, err := storage.GetSample(sampleLabel)
 	if err != nil {
 		return "", err
 	}
+	return records.DumpRecord(sample, format)
+}
 
-	// unmarshal the sample
-	sample := &records.Sample{}
-	if err := proto.Unmarshal(dbData, sample); err != nil {
+// GetRunDump is a method to retrieve a run from storage and return a string dump of the protobuf message in the requested format
+func (storage *Storage) GetRunDump(runName, format string) (string, error) {
+	run, err := storage.GetRun(runName)
+	if err != nil {
 		return "", err
 	}
+	return records.DumpRecord(run, format)
+}
 
-	return proto.MarshalTextString(sample), nil
+// announcementKey returns the key for an announcement in the queue database
+func announcementKey(recordType records.RecordType, label string) []byte {
+	return []byte(fmt.Sprintf("%v/%v", recordType, label))
 }
 
-// GetSampleJSONDump is a method to retrieve a sample from storage and return a string dump of the protobuf message in JSON
-func (storage *Storage) GetSampleJSONDump(sampleLabel string) (string, error) {
+// GetNumAnnouncements returns the current number of announcements in the queue database
+func (storage *Storage) GetNumAnnouncements() int {
+	return storage.queueDB.Len()
+}
 
-	// get the sample from the bit cask
-	dbData, err := storage.sampleDB.Get([]byte(sampleLabel))
+// PutAnnouncement is a method to marshal an announcement and store it, replacing any existing announcement for the same record
+func (storage *Storage) PutAnnouncement(announcement *records.Announcement) error {
+
+	// check the DB limit hasn't been reached
+	key := announcementKey(announcement.GetRecordType(), announcement.GetLabel())
+	if !storage.queueDB.Has(key) && storage.queueDB.Len() == dbMaxEntries {
+		return fmt.Errorf("database entry limit reached (%d)", dbMaxEntries)
+	}
+
+	// marshal the announcement
+	data, err := proto.Marshal(announcement)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	// unmarshal the sample
-	sample := &records.Sample{}
-	if err := proto.Unmarshal(dbData, sample); err != nil {
-		return "", err
+	// add the announcement
+	return storage.queueDB.Put(key, data)
+}
+
+// GetAnnouncement is a method to retrieve the announcement for a record from storage and unmarshal it to a struct
+func (storage *Storage) GetAnnouncement(recordType records.RecordType, label string) (*records.Announcement, error) {
+
+	// get the announcement from the bit cask
+	dbData, err := storage.queueDB.Get(announcementKey(recordType, label))
+	if err != nil {
+		return nil, err
+	}
+
+	// unmarshal the announcement
+	announcement := &records.Announcement{}
+	if err := proto.Unmarshal(dbData, announcement); err != nil {
+		return nil, err
+	}
+	return announcement, nil
+}
+
+// GetAnnouncements is a method to retrieve all the announcements from storage, in no particular order
+func (storage *Storage) GetAnnouncements() ([]*records.Announcement, error) {
+	// collect the keys first, as the bit cask is locked during a fold
+	keys := [][]byte{}
+	if err := storage.queueDB.Fold(func(key []byte) error {
+		keys = append(keys, key)
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	// convert to JSON
-	buf := &bytes.Buffer{}
-	jsonMarshaller := jsonpb.Marshaler{
-		EnumsAsInts:  false, // Whether to render enum values as integers, as opposed to string values.
-		EmitDefaults: false, // Whether to render fields with zero values
-		Indent:       "\t",  // A string to indent each level by
-		OrigName:     false, // Whether to use the original (.proto) name for fields
+	// get and unmarshal the announcements
+	announcements := make([]*records.Announcement, 0, len(keys))
+	for _, key := range keys {
+		dbData, err := storage.queueDB.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		announcement := &records.Announcement{}
+		if err := proto.Unmarshal(dbData, announcement); err != nil {
+			return nil, err
+		}
+		announcements = append(announcements, announcement)
 	}
-	jsonMarshaller.Marshal(buf, sample)
+	return announcements, nil
+}
 
-	return string(buf.Bytes()), nil
+// DeleteAnnouncement is a method to remove the announcement for a record from storage
+func (storage *Storage) DeleteAnnouncement(recordType records.RecordType, label string) error {
+	return storage.queueDB.Delete(announcementKey(recordType, label))
 }
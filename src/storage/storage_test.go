@@ -71,12 +71,17 @@ func TestStorageAdd(t *testing.T) {
 	}
 
 	// test a JSON dump
-	jsonDump, err := sampleStore.GetSampleJSONDump(sample.Metadata.GetLabel())
+	jsonDump, err := sampleStore.GetSampleDump(sample.Metadata.GetLabel(), records.DumpJSON)
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(jsonDump)
 
+	// check a missing sample can't be dumped
+	if _, err := sampleStore.GetSampleDump("missing sample", records.DumpJSON); err == nil {
+		t.Fatal("GetSampleDump did not return error for a missing sample")
+	}
+
 	// check you can delete a sample
 	if err := sampleStore.DeleteSample(sample.Metadata.GetLabel()); err != nil {
 		t.Fatal(err)
@@ -101,3 +106,70 @@ func TestStorageAdd(t *testing.T) {
 	// clean up
 	os.RemoveAll("./tmp/")
 }
+
+// TestStorageAnnouncements
+func TestStorageAnnouncements(t *testing.T) {
+
+	// setup the storage
+	store, err := OpenStorage("./tmp")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// add an announcement for a run and a sample with the same label
+	for _, recordType := range []records.RecordType{records.RecordType_run, records.RecordType_sample} {
+		announcement := &records.Announcement{
+			RecordType: recordType,
+			Label:      "test",
+			Pending:    []string{"service a", "service b"},
+		}
+		if err := store.PutAnnouncement(announcement); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if store.GetNumAnnouncements() != 2 {
+		t.Fatalf("incorrect number of announcements added to db: %d", store.GetNumAnnouncements())
+	}
+
+	// check an announcement can be replaced
+	announcement, err := store.GetAnnouncement(records.RecordType_sample, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	announcement.Pending = announcement.Pending[1:]
+	if err := store.PutAnnouncement(announcement); err != nil {
+		t.Fatal(err)
+	}
+	announcements, err := store.GetAnnouncements()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(announcements) != 2 {
+		t.Fatalf("replacing an announcement changed the number in the db: %d", len(announcements))
+	}
+	for _, announcement := range announcements {
+		if announcement.GetRecordType() == records.RecordType_sample && len(announcement.GetPending()) != 1 {
+			t.Fatal("announcement was not replaced")
+		}
+	}
+
+	// check an announcement can be deleted
+	if err := store.DeleteAnnouncement(records.RecordType_run, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetAnnouncement(records.RecordType_run, "test"); err == nil {
+		t.Fatal("deleted announcement was retrieved")
+	}
+
+	// check the wipe clears the queue
+	if err := store.Wipe(); err != nil {
+		t.Fatal(err)
+	}
+	if store.GetNumAnnouncements() != 0 {
+		t.Fatalf("queue db was not wiped (%d keys left)", store.GetNumAnnouncements())
+	}
+	if err := store.CloseStorage(); err != nil {
+		t.Fatal(err)
+	}
+	os.RemoveAll("./tmp/")
+}